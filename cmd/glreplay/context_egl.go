@@ -0,0 +1,90 @@
+//go:build linux && !android && !nogl && !softgl
+// +build linux,!android,!nogl,!softgl
+
+package main
+
+/*
+#cgo LDFLAGS: -lEGL
+#include <EGL/egl.h>
+#include <EGL/eglext.h>
+
+// platformDisplay returns the surfaceless display of Mesa, which needs no
+// window system, or the default display if the driver doesn't have one.
+static EGLDisplay platformDisplay() {
+	PFNEGLGETPLATFORMDISPLAYEXTPROC getPlatformDisplay =
+		(PFNEGLGETPLATFORMDISPLAYEXTPROC)eglGetProcAddress("eglGetPlatformDisplayEXT");
+	if (getPlatformDisplay != NULL) {
+		EGLDisplay display = getPlatformDisplay(EGL_PLATFORM_SURFACELESS_MESA, EGL_DEFAULT_DISPLAY, NULL);
+		if (display != EGL_NO_DISPLAY) {
+			return display;
+		}
+	}
+	return eglGetDisplay(EGL_DEFAULT_DISPLAY);
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"runtime"
+
+	"github.com/EngoEngine/gl"
+)
+
+// newContext makes an OpenGL context drawing to an offscreen EGL surface of
+// the given size current, and returns a Context using it.
+func newContext(width, height int) (*gl.Context, error) {
+	// The context is current on the thread that created it.
+	runtime.LockOSThread()
+
+	display := C.platformDisplay()
+	if display == C.EGLDisplay(C.EGL_NO_DISPLAY) {
+		return nil, fmt.Errorf("no EGL display")
+	}
+	if C.eglInitialize(display, nil, nil) == C.EGL_FALSE {
+		return nil, eglError("initializing EGL")
+	}
+	if C.eglBindAPI(C.EGL_OPENGL_API) == C.EGL_FALSE {
+		return nil, eglError("selecting OpenGL")
+	}
+
+	configAttribs := []C.EGLint{
+		C.EGL_SURFACE_TYPE, C.EGL_PBUFFER_BIT,
+		C.EGL_RENDERABLE_TYPE, C.EGL_OPENGL_BIT,
+		C.EGL_RED_SIZE, 8,
+		C.EGL_GREEN_SIZE, 8,
+		C.EGL_BLUE_SIZE, 8,
+		C.EGL_ALPHA_SIZE, 8,
+		C.EGL_DEPTH_SIZE, 24,
+		C.EGL_STENCIL_SIZE, 8,
+		C.EGL_NONE,
+	}
+	var config C.EGLConfig
+	var n C.EGLint
+	if C.eglChooseConfig(display, &configAttribs[0], &config, 1, &n) == C.EGL_FALSE || n == 0 {
+		return nil, eglError("choosing an offscreen RGBA config")
+	}
+
+	surfaceAttribs := []C.EGLint{
+		C.EGL_WIDTH, C.EGLint(width),
+		C.EGL_HEIGHT, C.EGLint(height),
+		C.EGL_NONE,
+	}
+	surface := C.eglCreatePbufferSurface(display, config, &surfaceAttribs[0])
+	if surface == C.EGLSurface(C.EGL_NO_SURFACE) {
+		return nil, eglError("creating a surface")
+	}
+	context := C.eglCreateContext(display, config, C.EGLContext(C.EGL_NO_CONTEXT), nil)
+	if context == C.EGLContext(C.EGL_NO_CONTEXT) {
+		return nil, eglError("creating a context")
+	}
+	if C.eglMakeCurrent(display, surface, surface, context) == C.EGL_FALSE {
+		return nil, eglError("making the context current")
+	}
+	return gl.NewContext(), nil
+}
+
+// eglError returns an error for the failure of the EGL call doing what.
+func eglError(what string) error {
+	return fmt.Errorf("%s: EGL error 0x%X", what, int(C.eglGetError()))
+}
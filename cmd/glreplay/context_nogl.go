@@ -0,0 +1,11 @@
+//go:build nogl
+// +build nogl
+
+package main
+
+import "github.com/EngoEngine/gl"
+
+// newContext returns the headless nogl Context, which ignores the size.
+func newContext(width, height int) (*gl.Context, error) {
+	return gl.NewContext(), nil
+}
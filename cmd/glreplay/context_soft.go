@@ -0,0 +1,12 @@
+//go:build softgl && !nogl
+// +build softgl,!nogl
+
+package main
+
+import "github.com/EngoEngine/gl"
+
+// newContext returns a software Context with a default framebuffer of the
+// given size.
+func newContext(width, height int) (*gl.Context, error) {
+	return gl.NewContext(width, height), nil
+}
@@ -0,0 +1,20 @@
+//go:build (!linux || android) && !js && !nogl && !softgl
+// +build !linux android
+// +build !js
+// +build !nogl
+// +build !softgl
+
+package main
+
+import (
+	"errors"
+
+	"github.com/EngoEngine/gl"
+)
+
+// newContext fails: glreplay only creates offscreen contexts through EGL on
+// Linux, while macOS and Windows need a window and the mobile backend the
+// surface of an app. Replay against those with gl.ReplayTrace instead.
+func newContext(width, height int) (*gl.Context, error) {
+	return nil, errors.New("replaying needs -tags nogl or -tags softgl on this platform")
+}
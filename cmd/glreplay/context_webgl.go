@@ -0,0 +1,26 @@
+//go:build js && !nogl && !softgl
+// +build js,!nogl,!softgl
+
+package main
+
+import (
+	"errors"
+	"syscall/js"
+
+	"github.com/EngoEngine/gl"
+)
+
+// newContext returns a WebGL Context drawing to a new canvas of the given
+// size, which isn't added to the page.
+func newContext(width, height int) (*gl.Context, error) {
+	document := js.Global().Get("document")
+	if document.IsUndefined() {
+		return nil, errors.New("replaying with WebGL needs a browser page")
+	}
+	canvas := document.Call("createElement", "canvas")
+	canvas.Set("width", width)
+	canvas.Set("height", height)
+	attrs := gl.DefaultAttributes()
+	attrs.PreserveDrawingBuffer = true
+	return gl.NewContext(canvas, attrs)
+}
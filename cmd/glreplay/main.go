@@ -0,0 +1,119 @@
+// Command glreplay replays a trace recorded through a gl.TraceContext and
+// reports the number of calls of each frame.
+//
+// Usage:
+//
+//	glreplay [flags] trace
+//
+// The trace is replayed against the backend glreplay is built for. Built with
+// -tags nogl it checks that a trace is well-formed without a GPU, and with
+// -tags softgl it renders the trace in software. The default build renders it
+// with OpenGL in an offscreen EGL context on Linux, and the js build with
+// WebGL in a canvas it creates, which needs a browser page. The -png flag
+// saves the default framebuffer after replaying. The -json flag dumps the
+// trace instead of replaying it.
+//
+// On macOS and Windows, and on mobile, glreplay can't create a context of its
+// own, so only the nogl and softgl builds replay there. Call gl.ReplayTrace
+// from an application that created a window to replay a trace on its GPU.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"image/png"
+	"log"
+	"os"
+	"sort"
+
+	"github.com/EngoEngine/gl"
+)
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("glreplay: ")
+
+	dumpJSON := flag.Bool("json", false, "write the calls of the trace as JSON instead of replaying it")
+	methods := flag.Bool("methods", false, "report the number of calls of each method per frame")
+	width := flag.Int("width", 640, "width of the default framebuffer")
+	height := flag.Int("height", 480, "height of the default framebuffer")
+	pngFile := flag.String("png", "", "write the default framebuffer to this PNG file after replaying")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: glreplay [flags] trace\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	f, err := os.Open(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+	r, err := gl.NewTraceReader(f)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *dumpJSON {
+		if err := gl.WriteTraceJSON(os.Stdout, r); err != nil {
+			log.Fatal(err)
+		}
+		return
+	}
+
+	ctx, err := newContext(*width, *height)
+	if err != nil {
+		log.Fatal(err)
+	}
+	frames, err := gl.ReplayTrace(ctx, r)
+	report(frames, *methods)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if *pngFile != "" {
+		img, err := ctx.ReadPixels(0, 0, *width, *height)
+		if err != nil {
+			log.Fatal(err)
+		}
+		out, err := os.Create(*pngFile)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := png.Encode(out, img); err != nil {
+			log.Fatal(err)
+		}
+		if err := out.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// report prints the number of calls of each frame, and of each method in the
+// frame if methods is set.
+func report(frames []gl.FrameStats, methods bool) {
+	total := 0
+	for i, frame := range frames {
+		total += frame.Calls
+		fmt.Printf("frame %d: %d calls\n", i+1, frame.Calls)
+		if !methods {
+			continue
+		}
+		names := make([]string, 0, len(frame.Methods))
+		for name := range frame.Methods {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			a, b := frame.Methods[names[i]], frame.Methods[names[j]]
+			return a > b || a == b && names[i] < names[j]
+		})
+		for _, name := range names {
+			fmt.Printf("\t%s %d\n", name, frame.Methods[name])
+		}
+	}
+	fmt.Printf("%d frames, %d calls\n", len(frames), total)
+}
@@ -0,0 +1,621 @@
+// Code generated by glgen from constants.txt; DO NOT EDIT.
+
+package gl
+
+// Constants holds the OpenGL constants of a Context, whose fields it promotes.
+// Constants the backend doesn't support are 0.
+type Constants struct {
+	ACTIVE_ATTRIBUTES                            Enum
+	ACTIVE_TEXTURE                               Enum
+	ACTIVE_UNIFORMS                              Enum
+	ALIASED_LINE_WIDTH_RANGE                     Enum
+	ALIASED_POINT_SIZE_RANGE                     Enum
+	ALPHA                                        Enum
+	ALWAYS                                       Enum
+	ARRAY_BUFFER                                 Enum
+	ARRAY_BUFFER_BINDING                         Enum
+	ATTACHED_SHADERS                             Enum
+	BACK                                         Enum
+	BLEND                                        Enum
+	BLEND_COLOR                                  Enum
+	BLEND_DST_ALPHA                              Enum
+	BLEND_DST_RGB                                Enum
+	BLEND_EQUATION                               Enum
+	BLEND_EQUATION_ALPHA                         Enum
+	BLEND_EQUATION_RGB                           Enum
+	BLEND_SRC_ALPHA                              Enum
+	BLEND_SRC_RGB                                Enum
+	BLUE_BITS                                    Enum
+	BOOL                                         Enum
+	BOOL_VEC2                                    Enum
+	BOOL_VEC3                                    Enum
+	BOOL_VEC4                                    Enum
+	BROWSER_DEFAULT_WEBGL                        Enum
+	BUFFER_SIZE                                  Enum
+	BUFFER_USAGE                                 Enum
+	BYTE                                         Enum
+	CCW                                          Enum
+	CLAMP_TO_EDGE                                Enum
+	CLAMP_TO_BORDER                              Enum
+	COLOR_ATTACHMENT0                            Enum
+	COLOR_BUFFER_BIT                             Enum
+	COLOR_CLEAR_VALUE                            Enum
+	COLOR_WRITEMASK                              Enum
+	COMPILE_STATUS                               Enum
+	COMPRESSED_TEXTURE_FORMATS                   Enum
+	CONSTANT_ALPHA                               Enum
+	CONSTANT_COLOR                               Enum
+	CONTEXT_LOST_WEBGL                           Enum
+	CULL_FACE                                    Enum
+	CULL_FACE_MODE                               Enum
+	CURRENT_PROGRAM                              Enum
+	CURRENT_VERTEX_ATTRIB                        Enum
+	CW                                           Enum
+	DECR                                         Enum
+	DECR_WRAP                                    Enum
+	DELETE_STATUS                                Enum
+	DEPTH_ATTACHMENT                             Enum
+	DEPTH_BITS                                   Enum
+	DEPTH_BUFFER_BIT                             Enum
+	DEPTH_CLEAR_VALUE                            Enum
+	DEPTH_COMPONENT                              Enum
+	DEPTH_COMPONENT16                            Enum
+	DEPTH_FUNC                                   Enum
+	DEPTH_RANGE                                  Enum
+	DEPTH_STENCIL                                Enum
+	DEPTH_STENCIL_ATTACHMENT                     Enum
+	DEPTH_TEST                                   Enum
+	DEPTH_WRITEMASK                              Enum
+	DITHER                                       Enum
+	DONT_CARE                                    Enum
+	DST_ALPHA                                    Enum
+	DST_COLOR                                    Enum
+	DYNAMIC_DRAW                                 Enum
+	ELEMENT_ARRAY_BUFFER                         Enum
+	ELEMENT_ARRAY_BUFFER_BINDING                 Enum
+	EQUAL                                        Enum
+	EXTENSIONS                                   Enum
+	FASTEST                                      Enum
+	FLOAT                                        Enum
+	FLOAT_MAT2                                   Enum
+	FLOAT_MAT3                                   Enum
+	FLOAT_MAT4                                   Enum
+	FLOAT_VEC2                                   Enum
+	FLOAT_VEC3                                   Enum
+	FLOAT_VEC4                                   Enum
+	FRAGMENT_SHADER                              Enum
+	FRAMEBUFFER                                  Enum
+	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME           Enum
+	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE           Enum
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE Enum
+	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL         Enum
+	FRAMEBUFFER_BINDING                          Enum
+	FRAMEBUFFER_COMPLETE                         Enum
+	FRAMEBUFFER_INCOMPLETE_ATTACHMENT            Enum
+	FRAMEBUFFER_INCOMPLETE_DIMENSIONS            Enum
+	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT    Enum
+	FRAMEBUFFER_UNSUPPORTED                      Enum
+	FRONT                                        Enum
+	FRONT_AND_BACK                               Enum
+	FRONT_FACE                                   Enum
+	FUNC_ADD                                     Enum
+	FUNC_REVERSE_SUBTRACT                        Enum
+	FUNC_SUBTRACT                                Enum
+	GENERATE_MIPMAP_HINT                         Enum
+	GEQUAL                                       Enum
+	GREATER                                      Enum
+	GREEN_BITS                                   Enum
+	HIGH_FLOAT                                   Enum
+	HIGH_INT                                     Enum
+	INCR                                         Enum
+	INCR_WRAP                                    Enum
+	INFO_LOG_LENGTH                              Enum
+	INT                                          Enum
+	INT_VEC2                                     Enum
+	INT_VEC3                                     Enum
+	INT_VEC4                                     Enum
+	INVALID_ENUM                                 Enum
+	INVALID_FRAMEBUFFER_OPERATION                Enum
+	INVALID_OPERATION                            Enum
+	INVALID_VALUE                                Enum
+	INVERT                                       Enum
+	KEEP                                         Enum
+	LEQUAL                                       Enum
+	LESS                                         Enum
+	LINEAR                                       Enum
+	LINEAR_MIPMAP_LINEAR                         Enum
+	LINEAR_MIPMAP_NEAREST                        Enum
+	LINES                                        Enum
+	LINE_LOOP                                    Enum
+	LINE_STRIP                                   Enum
+	LINE_STIPPLE                                 Enum
+	LINE_WIDTH                                   Enum
+	LINK_STATUS                                  Enum
+	LOW_FLOAT                                    Enum
+	LOW_INT                                      Enum
+	LUMINANCE                                    Enum
+	LUMINANCE_ALPHA                              Enum
+	MAX_COMBINED_TEXTURE_IMAGE_UNITS             Enum
+	MAX_CUBE_MAP_TEXTURE_SIZE                    Enum
+	MAX_FRAGMENT_UNIFORM_VECTORS                 Enum
+	MAX_RENDERBUFFER_SIZE                        Enum
+	MAX_TEXTURE_IMAGE_UNITS                      Enum
+	MAX_TEXTURE_SIZE                             Enum
+	MAX_VARYING_VECTORS                          Enum
+	MAX_VERTEX_ATTRIBS                           Enum
+	MAX_VERTEX_TEXTURE_IMAGE_UNITS               Enum
+	MAX_VERTEX_UNIFORM_VECTORS                   Enum
+	MAX_VIEWPORT_DIMS                            Enum
+	MEDIUM_FLOAT                                 Enum
+	MEDIUM_INT                                   Enum
+	MIRRORED_REPEAT                              Enum
+	MULTISAMPLE                                  Enum
+	NEAREST                                      Enum
+	NEAREST_MIPMAP_LINEAR                        Enum
+	NEAREST_MIPMAP_NEAREST                       Enum
+	NEVER                                        Enum
+	NICEST                                       Enum
+	NONE                                         Enum
+	NOTEQUAL                                     Enum
+	NO_ERROR                                     Enum
+	NUM_COMPRESSED_TEXTURE_FORMATS               Enum
+	ONE                                          Enum
+	ONE_MINUS_CONSTANT_ALPHA                     Enum
+	ONE_MINUS_CONSTANT_COLOR                     Enum
+	ONE_MINUS_DST_ALPHA                          Enum
+	ONE_MINUS_DST_COLOR                          Enum
+	ONE_MINUS_SRC_ALPHA                          Enum
+	ONE_MINUS_SRC_COLOR                          Enum
+	OUT_OF_MEMORY                                Enum
+	PACK_ALIGNMENT                               Enum
+	POINTS                                       Enum
+	POLYGON_OFFSET_FACTOR                        Enum
+	POLYGON_OFFSET_FILL                          Enum
+	POLYGON_OFFSET_UNITS                         Enum
+	RED_BITS                                     Enum
+	RENDERBUFFER                                 Enum
+	RENDERBUFFER_ALPHA_SIZE                      Enum
+	RENDERBUFFER_BINDING                         Enum
+	RENDERBUFFER_BLUE_SIZE                       Enum
+	RENDERBUFFER_DEPTH_SIZE                      Enum
+	RENDERBUFFER_GREEN_SIZE                      Enum
+	RENDERBUFFER_HEIGHT                          Enum
+	RENDERBUFFER_INTERNAL_FORMAT                 Enum
+	RENDERBUFFER_RED_SIZE                        Enum
+	RENDERBUFFER_STENCIL_SIZE                    Enum
+	RENDERBUFFER_WIDTH                           Enum
+	RENDERER                                     Enum
+	REPEAT                                       Enum
+	REPLACE                                      Enum
+	RGB                                          Enum
+	RGB5_A1                                      Enum
+	RGB565                                       Enum
+	RGBA                                         Enum
+	RGBA4                                        Enum
+	RGBA8                                        Enum // WebGL 1 has no RGBA8, RGBA4 is used instead.
+	SAMPLER_2D                                   Enum
+	SAMPLER_CUBE                                 Enum
+	SAMPLES                                      Enum
+	SAMPLE_ALPHA_TO_COVERAGE                     Enum
+	SAMPLE_BUFFERS                               Enum
+	SAMPLE_COVERAGE                              Enum
+	SAMPLE_COVERAGE_INVERT                       Enum
+	SAMPLE_COVERAGE_VALUE                        Enum
+	SCISSOR_BOX                                  Enum
+	SCISSOR_TEST                                 Enum
+	SHADER_COMPILER                              Enum
+	SHADER_SOURCE_LENGTH                         Enum
+	SHADER_TYPE                                  Enum
+	SHADING_LANGUAGE_VERSION                     Enum
+	SHORT                                        Enum
+	SRC_ALPHA                                    Enum
+	SRC_ALPHA_SATURATE                           Enum
+	SRC_COLOR                                    Enum
+	STATIC_DRAW                                  Enum
+	STENCIL_ATTACHMENT                           Enum
+	STENCIL_BACK_FAIL                            Enum
+	STENCIL_BACK_FUNC                            Enum
+	STENCIL_BACK_PASS_DEPTH_FAIL                 Enum
+	STENCIL_BACK_PASS_DEPTH_PASS                 Enum
+	STENCIL_BACK_REF                             Enum
+	STENCIL_BACK_VALUE_MASK                      Enum
+	STENCIL_BACK_WRITEMASK                       Enum
+	STENCIL_BITS                                 Enum
+	STENCIL_BUFFER_BIT                           Enum
+	STENCIL_CLEAR_VALUE                          Enum
+	STENCIL_FAIL                                 Enum
+	STENCIL_FUNC                                 Enum
+	STENCIL_INDEX                                Enum
+	STENCIL_INDEX8                               Enum
+	STENCIL_PASS_DEPTH_FAIL                      Enum
+	STENCIL_PASS_DEPTH_PASS                      Enum
+	STENCIL_REF                                  Enum
+	STENCIL_TEST                                 Enum
+	STENCIL_VALUE_MASK                           Enum
+	STENCIL_WRITEMASK                            Enum
+	STREAM_DRAW                                  Enum
+	SUBPIXEL_BITS                                Enum
+	TEXTURE                                      Enum
+	TEXTURE0                                     Enum
+	TEXTURE1                                     Enum
+	TEXTURE2                                     Enum
+	TEXTURE3                                     Enum
+	TEXTURE4                                     Enum
+	TEXTURE5                                     Enum
+	TEXTURE6                                     Enum
+	TEXTURE7                                     Enum
+	TEXTURE8                                     Enum
+	TEXTURE9                                     Enum
+	TEXTURE10                                    Enum
+	TEXTURE11                                    Enum
+	TEXTURE12                                    Enum
+	TEXTURE13                                    Enum
+	TEXTURE14                                    Enum
+	TEXTURE15                                    Enum
+	TEXTURE16                                    Enum
+	TEXTURE17                                    Enum
+	TEXTURE18                                    Enum
+	TEXTURE19                                    Enum
+	TEXTURE20                                    Enum
+	TEXTURE21                                    Enum
+	TEXTURE22                                    Enum
+	TEXTURE23                                    Enum
+	TEXTURE24                                    Enum
+	TEXTURE25                                    Enum
+	TEXTURE26                                    Enum
+	TEXTURE27                                    Enum
+	TEXTURE28                                    Enum
+	TEXTURE29                                    Enum
+	TEXTURE30                                    Enum
+	TEXTURE31                                    Enum
+	TEXTURE_2D                                   Enum
+	TEXTURE_BINDING_2D                           Enum
+	TEXTURE_BINDING_CUBE_MAP                     Enum
+	TEXTURE_CUBE_MAP                             Enum
+	TEXTURE_CUBE_MAP_NEGATIVE_X                  Enum
+	TEXTURE_CUBE_MAP_NEGATIVE_Y                  Enum
+	TEXTURE_CUBE_MAP_NEGATIVE_Z                  Enum
+	TEXTURE_CUBE_MAP_POSITIVE_X                  Enum
+	TEXTURE_CUBE_MAP_POSITIVE_Y                  Enum
+	TEXTURE_CUBE_MAP_POSITIVE_Z                  Enum
+	TEXTURE_MAG_FILTER                           Enum
+	TEXTURE_MIN_FILTER                           Enum
+	TEXTURE_WRAP_S                               Enum
+	TEXTURE_WRAP_T                               Enum
+	TRIANGLES                                    Enum
+	TRIANGLE_FAN                                 Enum
+	TRIANGLE_STRIP                               Enum
+	UNPACK_ALIGNMENT                             Enum
+	UNPACK_COLORSPACE_CONVERSION_WEBGL           Enum
+	UNPACK_FLIP_Y_WEBGL                          Enum
+	UNPACK_PREMULTIPLY_ALPHA_WEBGL               Enum
+	UNSIGNED_BYTE                                Enum
+	UNSIGNED_INT                                 Enum
+	UNSIGNED_SHORT                               Enum
+	UNSIGNED_SHORT_4_4_4_4                       Enum
+	UNSIGNED_SHORT_5_5_5_1                       Enum
+	UNSIGNED_SHORT_5_6_5                         Enum
+	VALIDATE_STATUS                              Enum
+	VENDOR                                       Enum
+	VERSION                                      Enum
+	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING           Enum
+	VERTEX_ATTRIB_ARRAY_ENABLED                  Enum
+	VERTEX_ATTRIB_ARRAY_NORMALIZED               Enum
+	VERTEX_ATTRIB_ARRAY_POINTER                  Enum
+	VERTEX_ATTRIB_ARRAY_SIZE                     Enum
+	VERTEX_ATTRIB_ARRAY_STRIDE                   Enum
+	VERTEX_ATTRIB_ARRAY_TYPE                     Enum
+	VERTEX_SHADER                                Enum
+	VIEWPORT                                     Enum
+	ZERO                                         Enum
+	TRUE                                         Enum // WebGL has no TRUE constant.
+}
+
+// each calls fn with the name and value of each constant, in declaration
+// order.
+func (c *Constants) each(fn func(name string, value Enum)) {
+	fn("ACTIVE_ATTRIBUTES", c.ACTIVE_ATTRIBUTES)
+	fn("ACTIVE_TEXTURE", c.ACTIVE_TEXTURE)
+	fn("ACTIVE_UNIFORMS", c.ACTIVE_UNIFORMS)
+	fn("ALIASED_LINE_WIDTH_RANGE", c.ALIASED_LINE_WIDTH_RANGE)
+	fn("ALIASED_POINT_SIZE_RANGE", c.ALIASED_POINT_SIZE_RANGE)
+	fn("ALPHA", c.ALPHA)
+	fn("ALWAYS", c.ALWAYS)
+	fn("ARRAY_BUFFER", c.ARRAY_BUFFER)
+	fn("ARRAY_BUFFER_BINDING", c.ARRAY_BUFFER_BINDING)
+	fn("ATTACHED_SHADERS", c.ATTACHED_SHADERS)
+	fn("BACK", c.BACK)
+	fn("BLEND", c.BLEND)
+	fn("BLEND_COLOR", c.BLEND_COLOR)
+	fn("BLEND_DST_ALPHA", c.BLEND_DST_ALPHA)
+	fn("BLEND_DST_RGB", c.BLEND_DST_RGB)
+	fn("BLEND_EQUATION", c.BLEND_EQUATION)
+	fn("BLEND_EQUATION_ALPHA", c.BLEND_EQUATION_ALPHA)
+	fn("BLEND_EQUATION_RGB", c.BLEND_EQUATION_RGB)
+	fn("BLEND_SRC_ALPHA", c.BLEND_SRC_ALPHA)
+	fn("BLEND_SRC_RGB", c.BLEND_SRC_RGB)
+	fn("BLUE_BITS", c.BLUE_BITS)
+	fn("BOOL", c.BOOL)
+	fn("BOOL_VEC2", c.BOOL_VEC2)
+	fn("BOOL_VEC3", c.BOOL_VEC3)
+	fn("BOOL_VEC4", c.BOOL_VEC4)
+	fn("BROWSER_DEFAULT_WEBGL", c.BROWSER_DEFAULT_WEBGL)
+	fn("BUFFER_SIZE", c.BUFFER_SIZE)
+	fn("BUFFER_USAGE", c.BUFFER_USAGE)
+	fn("BYTE", c.BYTE)
+	fn("CCW", c.CCW)
+	fn("CLAMP_TO_EDGE", c.CLAMP_TO_EDGE)
+	fn("CLAMP_TO_BORDER", c.CLAMP_TO_BORDER)
+	fn("COLOR_ATTACHMENT0", c.COLOR_ATTACHMENT0)
+	fn("COLOR_BUFFER_BIT", c.COLOR_BUFFER_BIT)
+	fn("COLOR_CLEAR_VALUE", c.COLOR_CLEAR_VALUE)
+	fn("COLOR_WRITEMASK", c.COLOR_WRITEMASK)
+	fn("COMPILE_STATUS", c.COMPILE_STATUS)
+	fn("COMPRESSED_TEXTURE_FORMATS", c.COMPRESSED_TEXTURE_FORMATS)
+	fn("CONSTANT_ALPHA", c.CONSTANT_ALPHA)
+	fn("CONSTANT_COLOR", c.CONSTANT_COLOR)
+	fn("CONTEXT_LOST_WEBGL", c.CONTEXT_LOST_WEBGL)
+	fn("CULL_FACE", c.CULL_FACE)
+	fn("CULL_FACE_MODE", c.CULL_FACE_MODE)
+	fn("CURRENT_PROGRAM", c.CURRENT_PROGRAM)
+	fn("CURRENT_VERTEX_ATTRIB", c.CURRENT_VERTEX_ATTRIB)
+	fn("CW", c.CW)
+	fn("DECR", c.DECR)
+	fn("DECR_WRAP", c.DECR_WRAP)
+	fn("DELETE_STATUS", c.DELETE_STATUS)
+	fn("DEPTH_ATTACHMENT", c.DEPTH_ATTACHMENT)
+	fn("DEPTH_BITS", c.DEPTH_BITS)
+	fn("DEPTH_BUFFER_BIT", c.DEPTH_BUFFER_BIT)
+	fn("DEPTH_CLEAR_VALUE", c.DEPTH_CLEAR_VALUE)
+	fn("DEPTH_COMPONENT", c.DEPTH_COMPONENT)
+	fn("DEPTH_COMPONENT16", c.DEPTH_COMPONENT16)
+	fn("DEPTH_FUNC", c.DEPTH_FUNC)
+	fn("DEPTH_RANGE", c.DEPTH_RANGE)
+	fn("DEPTH_STENCIL", c.DEPTH_STENCIL)
+	fn("DEPTH_STENCIL_ATTACHMENT", c.DEPTH_STENCIL_ATTACHMENT)
+	fn("DEPTH_TEST", c.DEPTH_TEST)
+	fn("DEPTH_WRITEMASK", c.DEPTH_WRITEMASK)
+	fn("DITHER", c.DITHER)
+	fn("DONT_CARE", c.DONT_CARE)
+	fn("DST_ALPHA", c.DST_ALPHA)
+	fn("DST_COLOR", c.DST_COLOR)
+	fn("DYNAMIC_DRAW", c.DYNAMIC_DRAW)
+	fn("ELEMENT_ARRAY_BUFFER", c.ELEMENT_ARRAY_BUFFER)
+	fn("ELEMENT_ARRAY_BUFFER_BINDING", c.ELEMENT_ARRAY_BUFFER_BINDING)
+	fn("EQUAL", c.EQUAL)
+	fn("EXTENSIONS", c.EXTENSIONS)
+	fn("FASTEST", c.FASTEST)
+	fn("FLOAT", c.FLOAT)
+	fn("FLOAT_MAT2", c.FLOAT_MAT2)
+	fn("FLOAT_MAT3", c.FLOAT_MAT3)
+	fn("FLOAT_MAT4", c.FLOAT_MAT4)
+	fn("FLOAT_VEC2", c.FLOAT_VEC2)
+	fn("FLOAT_VEC3", c.FLOAT_VEC3)
+	fn("FLOAT_VEC4", c.FLOAT_VEC4)
+	fn("FRAGMENT_SHADER", c.FRAGMENT_SHADER)
+	fn("FRAMEBUFFER", c.FRAMEBUFFER)
+	fn("FRAMEBUFFER_ATTACHMENT_OBJECT_NAME", c.FRAMEBUFFER_ATTACHMENT_OBJECT_NAME)
+	fn("FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE", c.FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE)
+	fn("FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE", c.FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE)
+	fn("FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL", c.FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL)
+	fn("FRAMEBUFFER_BINDING", c.FRAMEBUFFER_BINDING)
+	fn("FRAMEBUFFER_COMPLETE", c.FRAMEBUFFER_COMPLETE)
+	fn("FRAMEBUFFER_INCOMPLETE_ATTACHMENT", c.FRAMEBUFFER_INCOMPLETE_ATTACHMENT)
+	fn("FRAMEBUFFER_INCOMPLETE_DIMENSIONS", c.FRAMEBUFFER_INCOMPLETE_DIMENSIONS)
+	fn("FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT", c.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT)
+	fn("FRAMEBUFFER_UNSUPPORTED", c.FRAMEBUFFER_UNSUPPORTED)
+	fn("FRONT", c.FRONT)
+	fn("FRONT_AND_BACK", c.FRONT_AND_BACK)
+	fn("FRONT_FACE", c.FRONT_FACE)
+	fn("FUNC_ADD", c.FUNC_ADD)
+	fn("FUNC_REVERSE_SUBTRACT", c.FUNC_REVERSE_SUBTRACT)
+	fn("FUNC_SUBTRACT", c.FUNC_SUBTRACT)
+	fn("GENERATE_MIPMAP_HINT", c.GENERATE_MIPMAP_HINT)
+	fn("GEQUAL", c.GEQUAL)
+	fn("GREATER", c.GREATER)
+	fn("GREEN_BITS", c.GREEN_BITS)
+	fn("HIGH_FLOAT", c.HIGH_FLOAT)
+	fn("HIGH_INT", c.HIGH_INT)
+	fn("INCR", c.INCR)
+	fn("INCR_WRAP", c.INCR_WRAP)
+	fn("INFO_LOG_LENGTH", c.INFO_LOG_LENGTH)
+	fn("INT", c.INT)
+	fn("INT_VEC2", c.INT_VEC2)
+	fn("INT_VEC3", c.INT_VEC3)
+	fn("INT_VEC4", c.INT_VEC4)
+	fn("INVALID_ENUM", c.INVALID_ENUM)
+	fn("INVALID_FRAMEBUFFER_OPERATION", c.INVALID_FRAMEBUFFER_OPERATION)
+	fn("INVALID_OPERATION", c.INVALID_OPERATION)
+	fn("INVALID_VALUE", c.INVALID_VALUE)
+	fn("INVERT", c.INVERT)
+	fn("KEEP", c.KEEP)
+	fn("LEQUAL", c.LEQUAL)
+	fn("LESS", c.LESS)
+	fn("LINEAR", c.LINEAR)
+	fn("LINEAR_MIPMAP_LINEAR", c.LINEAR_MIPMAP_LINEAR)
+	fn("LINEAR_MIPMAP_NEAREST", c.LINEAR_MIPMAP_NEAREST)
+	fn("LINES", c.LINES)
+	fn("LINE_LOOP", c.LINE_LOOP)
+	fn("LINE_STRIP", c.LINE_STRIP)
+	fn("LINE_STIPPLE", c.LINE_STIPPLE)
+	fn("LINE_WIDTH", c.LINE_WIDTH)
+	fn("LINK_STATUS", c.LINK_STATUS)
+	fn("LOW_FLOAT", c.LOW_FLOAT)
+	fn("LOW_INT", c.LOW_INT)
+	fn("LUMINANCE", c.LUMINANCE)
+	fn("LUMINANCE_ALPHA", c.LUMINANCE_ALPHA)
+	fn("MAX_COMBINED_TEXTURE_IMAGE_UNITS", c.MAX_COMBINED_TEXTURE_IMAGE_UNITS)
+	fn("MAX_CUBE_MAP_TEXTURE_SIZE", c.MAX_CUBE_MAP_TEXTURE_SIZE)
+	fn("MAX_FRAGMENT_UNIFORM_VECTORS", c.MAX_FRAGMENT_UNIFORM_VECTORS)
+	fn("MAX_RENDERBUFFER_SIZE", c.MAX_RENDERBUFFER_SIZE)
+	fn("MAX_TEXTURE_IMAGE_UNITS", c.MAX_TEXTURE_IMAGE_UNITS)
+	fn("MAX_TEXTURE_SIZE", c.MAX_TEXTURE_SIZE)
+	fn("MAX_VARYING_VECTORS", c.MAX_VARYING_VECTORS)
+	fn("MAX_VERTEX_ATTRIBS", c.MAX_VERTEX_ATTRIBS)
+	fn("MAX_VERTEX_TEXTURE_IMAGE_UNITS", c.MAX_VERTEX_TEXTURE_IMAGE_UNITS)
+	fn("MAX_VERTEX_UNIFORM_VECTORS", c.MAX_VERTEX_UNIFORM_VECTORS)
+	fn("MAX_VIEWPORT_DIMS", c.MAX_VIEWPORT_DIMS)
+	fn("MEDIUM_FLOAT", c.MEDIUM_FLOAT)
+	fn("MEDIUM_INT", c.MEDIUM_INT)
+	fn("MIRRORED_REPEAT", c.MIRRORED_REPEAT)
+	fn("MULTISAMPLE", c.MULTISAMPLE)
+	fn("NEAREST", c.NEAREST)
+	fn("NEAREST_MIPMAP_LINEAR", c.NEAREST_MIPMAP_LINEAR)
+	fn("NEAREST_MIPMAP_NEAREST", c.NEAREST_MIPMAP_NEAREST)
+	fn("NEVER", c.NEVER)
+	fn("NICEST", c.NICEST)
+	fn("NONE", c.NONE)
+	fn("NOTEQUAL", c.NOTEQUAL)
+	fn("NO_ERROR", c.NO_ERROR)
+	fn("NUM_COMPRESSED_TEXTURE_FORMATS", c.NUM_COMPRESSED_TEXTURE_FORMATS)
+	fn("ONE", c.ONE)
+	fn("ONE_MINUS_CONSTANT_ALPHA", c.ONE_MINUS_CONSTANT_ALPHA)
+	fn("ONE_MINUS_CONSTANT_COLOR", c.ONE_MINUS_CONSTANT_COLOR)
+	fn("ONE_MINUS_DST_ALPHA", c.ONE_MINUS_DST_ALPHA)
+	fn("ONE_MINUS_DST_COLOR", c.ONE_MINUS_DST_COLOR)
+	fn("ONE_MINUS_SRC_ALPHA", c.ONE_MINUS_SRC_ALPHA)
+	fn("ONE_MINUS_SRC_COLOR", c.ONE_MINUS_SRC_COLOR)
+	fn("OUT_OF_MEMORY", c.OUT_OF_MEMORY)
+	fn("PACK_ALIGNMENT", c.PACK_ALIGNMENT)
+	fn("POINTS", c.POINTS)
+	fn("POLYGON_OFFSET_FACTOR", c.POLYGON_OFFSET_FACTOR)
+	fn("POLYGON_OFFSET_FILL", c.POLYGON_OFFSET_FILL)
+	fn("POLYGON_OFFSET_UNITS", c.POLYGON_OFFSET_UNITS)
+	fn("RED_BITS", c.RED_BITS)
+	fn("RENDERBUFFER", c.RENDERBUFFER)
+	fn("RENDERBUFFER_ALPHA_SIZE", c.RENDERBUFFER_ALPHA_SIZE)
+	fn("RENDERBUFFER_BINDING", c.RENDERBUFFER_BINDING)
+	fn("RENDERBUFFER_BLUE_SIZE", c.RENDERBUFFER_BLUE_SIZE)
+	fn("RENDERBUFFER_DEPTH_SIZE", c.RENDERBUFFER_DEPTH_SIZE)
+	fn("RENDERBUFFER_GREEN_SIZE", c.RENDERBUFFER_GREEN_SIZE)
+	fn("RENDERBUFFER_HEIGHT", c.RENDERBUFFER_HEIGHT)
+	fn("RENDERBUFFER_INTERNAL_FORMAT", c.RENDERBUFFER_INTERNAL_FORMAT)
+	fn("RENDERBUFFER_RED_SIZE", c.RENDERBUFFER_RED_SIZE)
+	fn("RENDERBUFFER_STENCIL_SIZE", c.RENDERBUFFER_STENCIL_SIZE)
+	fn("RENDERBUFFER_WIDTH", c.RENDERBUFFER_WIDTH)
+	fn("RENDERER", c.RENDERER)
+	fn("REPEAT", c.REPEAT)
+	fn("REPLACE", c.REPLACE)
+	fn("RGB", c.RGB)
+	fn("RGB5_A1", c.RGB5_A1)
+	fn("RGB565", c.RGB565)
+	fn("RGBA", c.RGBA)
+	fn("RGBA4", c.RGBA4)
+	fn("RGBA8", c.RGBA8)
+	fn("SAMPLER_2D", c.SAMPLER_2D)
+	fn("SAMPLER_CUBE", c.SAMPLER_CUBE)
+	fn("SAMPLES", c.SAMPLES)
+	fn("SAMPLE_ALPHA_TO_COVERAGE", c.SAMPLE_ALPHA_TO_COVERAGE)
+	fn("SAMPLE_BUFFERS", c.SAMPLE_BUFFERS)
+	fn("SAMPLE_COVERAGE", c.SAMPLE_COVERAGE)
+	fn("SAMPLE_COVERAGE_INVERT", c.SAMPLE_COVERAGE_INVERT)
+	fn("SAMPLE_COVERAGE_VALUE", c.SAMPLE_COVERAGE_VALUE)
+	fn("SCISSOR_BOX", c.SCISSOR_BOX)
+	fn("SCISSOR_TEST", c.SCISSOR_TEST)
+	fn("SHADER_COMPILER", c.SHADER_COMPILER)
+	fn("SHADER_SOURCE_LENGTH", c.SHADER_SOURCE_LENGTH)
+	fn("SHADER_TYPE", c.SHADER_TYPE)
+	fn("SHADING_LANGUAGE_VERSION", c.SHADING_LANGUAGE_VERSION)
+	fn("SHORT", c.SHORT)
+	fn("SRC_ALPHA", c.SRC_ALPHA)
+	fn("SRC_ALPHA_SATURATE", c.SRC_ALPHA_SATURATE)
+	fn("SRC_COLOR", c.SRC_COLOR)
+	fn("STATIC_DRAW", c.STATIC_DRAW)
+	fn("STENCIL_ATTACHMENT", c.STENCIL_ATTACHMENT)
+	fn("STENCIL_BACK_FAIL", c.STENCIL_BACK_FAIL)
+	fn("STENCIL_BACK_FUNC", c.STENCIL_BACK_FUNC)
+	fn("STENCIL_BACK_PASS_DEPTH_FAIL", c.STENCIL_BACK_PASS_DEPTH_FAIL)
+	fn("STENCIL_BACK_PASS_DEPTH_PASS", c.STENCIL_BACK_PASS_DEPTH_PASS)
+	fn("STENCIL_BACK_REF", c.STENCIL_BACK_REF)
+	fn("STENCIL_BACK_VALUE_MASK", c.STENCIL_BACK_VALUE_MASK)
+	fn("STENCIL_BACK_WRITEMASK", c.STENCIL_BACK_WRITEMASK)
+	fn("STENCIL_BITS", c.STENCIL_BITS)
+	fn("STENCIL_BUFFER_BIT", c.STENCIL_BUFFER_BIT)
+	fn("STENCIL_CLEAR_VALUE", c.STENCIL_CLEAR_VALUE)
+	fn("STENCIL_FAIL", c.STENCIL_FAIL)
+	fn("STENCIL_FUNC", c.STENCIL_FUNC)
+	fn("STENCIL_INDEX", c.STENCIL_INDEX)
+	fn("STENCIL_INDEX8", c.STENCIL_INDEX8)
+	fn("STENCIL_PASS_DEPTH_FAIL", c.STENCIL_PASS_DEPTH_FAIL)
+	fn("STENCIL_PASS_DEPTH_PASS", c.STENCIL_PASS_DEPTH_PASS)
+	fn("STENCIL_REF", c.STENCIL_REF)
+	fn("STENCIL_TEST", c.STENCIL_TEST)
+	fn("STENCIL_VALUE_MASK", c.STENCIL_VALUE_MASK)
+	fn("STENCIL_WRITEMASK", c.STENCIL_WRITEMASK)
+	fn("STREAM_DRAW", c.STREAM_DRAW)
+	fn("SUBPIXEL_BITS", c.SUBPIXEL_BITS)
+	fn("TEXTURE", c.TEXTURE)
+	fn("TEXTURE0", c.TEXTURE0)
+	fn("TEXTURE1", c.TEXTURE1)
+	fn("TEXTURE2", c.TEXTURE2)
+	fn("TEXTURE3", c.TEXTURE3)
+	fn("TEXTURE4", c.TEXTURE4)
+	fn("TEXTURE5", c.TEXTURE5)
+	fn("TEXTURE6", c.TEXTURE6)
+	fn("TEXTURE7", c.TEXTURE7)
+	fn("TEXTURE8", c.TEXTURE8)
+	fn("TEXTURE9", c.TEXTURE9)
+	fn("TEXTURE10", c.TEXTURE10)
+	fn("TEXTURE11", c.TEXTURE11)
+	fn("TEXTURE12", c.TEXTURE12)
+	fn("TEXTURE13", c.TEXTURE13)
+	fn("TEXTURE14", c.TEXTURE14)
+	fn("TEXTURE15", c.TEXTURE15)
+	fn("TEXTURE16", c.TEXTURE16)
+	fn("TEXTURE17", c.TEXTURE17)
+	fn("TEXTURE18", c.TEXTURE18)
+	fn("TEXTURE19", c.TEXTURE19)
+	fn("TEXTURE20", c.TEXTURE20)
+	fn("TEXTURE21", c.TEXTURE21)
+	fn("TEXTURE22", c.TEXTURE22)
+	fn("TEXTURE23", c.TEXTURE23)
+	fn("TEXTURE24", c.TEXTURE24)
+	fn("TEXTURE25", c.TEXTURE25)
+	fn("TEXTURE26", c.TEXTURE26)
+	fn("TEXTURE27", c.TEXTURE27)
+	fn("TEXTURE28", c.TEXTURE28)
+	fn("TEXTURE29", c.TEXTURE29)
+	fn("TEXTURE30", c.TEXTURE30)
+	fn("TEXTURE31", c.TEXTURE31)
+	fn("TEXTURE_2D", c.TEXTURE_2D)
+	fn("TEXTURE_BINDING_2D", c.TEXTURE_BINDING_2D)
+	fn("TEXTURE_BINDING_CUBE_MAP", c.TEXTURE_BINDING_CUBE_MAP)
+	fn("TEXTURE_CUBE_MAP", c.TEXTURE_CUBE_MAP)
+	fn("TEXTURE_CUBE_MAP_NEGATIVE_X", c.TEXTURE_CUBE_MAP_NEGATIVE_X)
+	fn("TEXTURE_CUBE_MAP_NEGATIVE_Y", c.TEXTURE_CUBE_MAP_NEGATIVE_Y)
+	fn("TEXTURE_CUBE_MAP_NEGATIVE_Z", c.TEXTURE_CUBE_MAP_NEGATIVE_Z)
+	fn("TEXTURE_CUBE_MAP_POSITIVE_X", c.TEXTURE_CUBE_MAP_POSITIVE_X)
+	fn("TEXTURE_CUBE_MAP_POSITIVE_Y", c.TEXTURE_CUBE_MAP_POSITIVE_Y)
+	fn("TEXTURE_CUBE_MAP_POSITIVE_Z", c.TEXTURE_CUBE_MAP_POSITIVE_Z)
+	fn("TEXTURE_MAG_FILTER", c.TEXTURE_MAG_FILTER)
+	fn("TEXTURE_MIN_FILTER", c.TEXTURE_MIN_FILTER)
+	fn("TEXTURE_WRAP_S", c.TEXTURE_WRAP_S)
+	fn("TEXTURE_WRAP_T", c.TEXTURE_WRAP_T)
+	fn("TRIANGLES", c.TRIANGLES)
+	fn("TRIANGLE_FAN", c.TRIANGLE_FAN)
+	fn("TRIANGLE_STRIP", c.TRIANGLE_STRIP)
+	fn("UNPACK_ALIGNMENT", c.UNPACK_ALIGNMENT)
+	fn("UNPACK_COLORSPACE_CONVERSION_WEBGL", c.UNPACK_COLORSPACE_CONVERSION_WEBGL)
+	fn("UNPACK_FLIP_Y_WEBGL", c.UNPACK_FLIP_Y_WEBGL)
+	fn("UNPACK_PREMULTIPLY_ALPHA_WEBGL", c.UNPACK_PREMULTIPLY_ALPHA_WEBGL)
+	fn("UNSIGNED_BYTE", c.UNSIGNED_BYTE)
+	fn("UNSIGNED_INT", c.UNSIGNED_INT)
+	fn("UNSIGNED_SHORT", c.UNSIGNED_SHORT)
+	fn("UNSIGNED_SHORT_4_4_4_4", c.UNSIGNED_SHORT_4_4_4_4)
+	fn("UNSIGNED_SHORT_5_5_5_1", c.UNSIGNED_SHORT_5_5_5_1)
+	fn("UNSIGNED_SHORT_5_6_5", c.UNSIGNED_SHORT_5_6_5)
+	fn("VALIDATE_STATUS", c.VALIDATE_STATUS)
+	fn("VENDOR", c.VENDOR)
+	fn("VERSION", c.VERSION)
+	fn("VERTEX_ATTRIB_ARRAY_BUFFER_BINDING", c.VERTEX_ATTRIB_ARRAY_BUFFER_BINDING)
+	fn("VERTEX_ATTRIB_ARRAY_ENABLED", c.VERTEX_ATTRIB_ARRAY_ENABLED)
+	fn("VERTEX_ATTRIB_ARRAY_NORMALIZED", c.VERTEX_ATTRIB_ARRAY_NORMALIZED)
+	fn("VERTEX_ATTRIB_ARRAY_POINTER", c.VERTEX_ATTRIB_ARRAY_POINTER)
+	fn("VERTEX_ATTRIB_ARRAY_SIZE", c.VERTEX_ATTRIB_ARRAY_SIZE)
+	fn("VERTEX_ATTRIB_ARRAY_STRIDE", c.VERTEX_ATTRIB_ARRAY_STRIDE)
+	fn("VERTEX_ATTRIB_ARRAY_TYPE", c.VERTEX_ATTRIB_ARRAY_TYPE)
+	fn("VERTEX_SHADER", c.VERTEX_SHADER)
+	fn("VIEWPORT", c.VIEWPORT)
+	fn("ZERO", c.ZERO)
+	fn("TRUE", c.TRUE)
+}
@@ -0,0 +1,319 @@
+// Code generated by glgen from constants.txt; DO NOT EDIT.
+
+//go:build (darwin || linux || windows) && !ios && !android && !js && !nogl && !softgl
+// +build darwin linux windows
+// +build !ios
+// +build !android
+// +build !js
+// +build !nogl
+// +build !softgl
+
+package gl
+
+import (
+	"github.com/go-gl/gl/v2.1/gl"
+)
+
+// newConstants returns the constants of the go-gl binding.
+func newConstants() Constants {
+	return Constants{
+		ACTIVE_ATTRIBUTES:                  gl.ACTIVE_ATTRIBUTES,
+		ACTIVE_TEXTURE:                     gl.ACTIVE_TEXTURE,
+		ACTIVE_UNIFORMS:                    gl.ACTIVE_UNIFORMS,
+		ALIASED_LINE_WIDTH_RANGE:           gl.ALIASED_LINE_WIDTH_RANGE,
+		ALIASED_POINT_SIZE_RANGE:           gl.ALIASED_POINT_SIZE_RANGE,
+		ALPHA:                              gl.ALPHA,
+		ALWAYS:                             gl.ALWAYS,
+		ARRAY_BUFFER:                       gl.ARRAY_BUFFER,
+		ARRAY_BUFFER_BINDING:               gl.ARRAY_BUFFER_BINDING,
+		ATTACHED_SHADERS:                   gl.ATTACHED_SHADERS,
+		BACK:                               gl.BACK,
+		BLEND:                              gl.BLEND,
+		BLEND_COLOR:                        gl.BLEND_COLOR,
+		BLEND_DST_ALPHA:                    gl.BLEND_DST_ALPHA,
+		BLEND_DST_RGB:                      gl.BLEND_DST_RGB,
+		BLEND_EQUATION:                     gl.BLEND_EQUATION,
+		BLEND_EQUATION_ALPHA:               gl.BLEND_EQUATION_ALPHA,
+		BLEND_EQUATION_RGB:                 gl.BLEND_EQUATION_RGB,
+		BLEND_SRC_ALPHA:                    gl.BLEND_SRC_ALPHA,
+		BLEND_SRC_RGB:                      gl.BLEND_SRC_RGB,
+		BLUE_BITS:                          gl.BLUE_BITS,
+		BOOL:                               gl.BOOL,
+		BOOL_VEC2:                          gl.BOOL_VEC2,
+		BOOL_VEC3:                          gl.BOOL_VEC3,
+		BOOL_VEC4:                          gl.BOOL_VEC4,
+		BUFFER_SIZE:                        gl.BUFFER_SIZE,
+		BUFFER_USAGE:                       gl.BUFFER_USAGE,
+		BYTE:                               gl.BYTE,
+		CCW:                                gl.CCW,
+		CLAMP_TO_EDGE:                      gl.CLAMP_TO_EDGE,
+		CLAMP_TO_BORDER:                    gl.CLAMP_TO_BORDER,
+		COLOR_ATTACHMENT0:                  gl.COLOR_ATTACHMENT0,
+		COLOR_BUFFER_BIT:                   gl.COLOR_BUFFER_BIT,
+		COLOR_CLEAR_VALUE:                  gl.COLOR_CLEAR_VALUE,
+		COLOR_WRITEMASK:                    gl.COLOR_WRITEMASK,
+		COMPILE_STATUS:                     gl.COMPILE_STATUS,
+		COMPRESSED_TEXTURE_FORMATS:         gl.COMPRESSED_TEXTURE_FORMATS,
+		CONSTANT_ALPHA:                     gl.CONSTANT_ALPHA,
+		CONSTANT_COLOR:                     gl.CONSTANT_COLOR,
+		CULL_FACE:                          gl.CULL_FACE,
+		CULL_FACE_MODE:                     gl.CULL_FACE_MODE,
+		CURRENT_PROGRAM:                    gl.CURRENT_PROGRAM,
+		CURRENT_VERTEX_ATTRIB:              gl.CURRENT_VERTEX_ATTRIB,
+		CW:                                 gl.CW,
+		DECR:                               gl.DECR,
+		DECR_WRAP:                          gl.DECR_WRAP,
+		DELETE_STATUS:                      gl.DELETE_STATUS,
+		DEPTH_ATTACHMENT:                   gl.DEPTH_ATTACHMENT,
+		DEPTH_BITS:                         gl.DEPTH_BITS,
+		DEPTH_BUFFER_BIT:                   gl.DEPTH_BUFFER_BIT,
+		DEPTH_CLEAR_VALUE:                  gl.DEPTH_CLEAR_VALUE,
+		DEPTH_COMPONENT:                    gl.DEPTH_COMPONENT,
+		DEPTH_COMPONENT16:                  gl.DEPTH_COMPONENT16,
+		DEPTH_FUNC:                         gl.DEPTH_FUNC,
+		DEPTH_RANGE:                        gl.DEPTH_RANGE,
+		DEPTH_STENCIL:                      gl.DEPTH_STENCIL,
+		DEPTH_STENCIL_ATTACHMENT:           gl.DEPTH_STENCIL_ATTACHMENT,
+		DEPTH_TEST:                         gl.DEPTH_TEST,
+		DEPTH_WRITEMASK:                    gl.DEPTH_WRITEMASK,
+		DITHER:                             gl.DITHER,
+		DONT_CARE:                          gl.DONT_CARE,
+		DST_ALPHA:                          gl.DST_ALPHA,
+		DST_COLOR:                          gl.DST_COLOR,
+		DYNAMIC_DRAW:                       gl.DYNAMIC_DRAW,
+		ELEMENT_ARRAY_BUFFER:               gl.ELEMENT_ARRAY_BUFFER,
+		ELEMENT_ARRAY_BUFFER_BINDING:       gl.ELEMENT_ARRAY_BUFFER_BINDING,
+		EQUAL:                              gl.EQUAL,
+		EXTENSIONS:                         gl.EXTENSIONS,
+		FASTEST:                            gl.FASTEST,
+		FLOAT:                              gl.FLOAT,
+		FLOAT_MAT2:                         gl.FLOAT_MAT2,
+		FLOAT_MAT3:                         gl.FLOAT_MAT3,
+		FLOAT_MAT4:                         gl.FLOAT_MAT4,
+		FLOAT_VEC2:                         gl.FLOAT_VEC2,
+		FLOAT_VEC3:                         gl.FLOAT_VEC3,
+		FLOAT_VEC4:                         gl.FLOAT_VEC4,
+		FRAGMENT_SHADER:                    gl.FRAGMENT_SHADER,
+		FRAMEBUFFER:                        gl.FRAMEBUFFER,
+		FRAMEBUFFER_ATTACHMENT_OBJECT_NAME: gl.FRAMEBUFFER_ATTACHMENT_OBJECT_NAME,
+		FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE: gl.FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE,
+		FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE: gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE,
+		FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL:         gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL,
+		FRAMEBUFFER_BINDING:                          gl.FRAMEBUFFER_BINDING,
+		FRAMEBUFFER_COMPLETE:                         gl.FRAMEBUFFER_COMPLETE,
+		FRAMEBUFFER_INCOMPLETE_ATTACHMENT:            gl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT,
+		FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT:    gl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT,
+		FRAMEBUFFER_UNSUPPORTED:                      gl.FRAMEBUFFER_UNSUPPORTED,
+		FRONT:                                        gl.FRONT,
+		FRONT_AND_BACK:                               gl.FRONT_AND_BACK,
+		FRONT_FACE:                                   gl.FRONT_FACE,
+		FUNC_ADD:                                     gl.FUNC_ADD,
+		FUNC_REVERSE_SUBTRACT:                        gl.FUNC_REVERSE_SUBTRACT,
+		FUNC_SUBTRACT:                                gl.FUNC_SUBTRACT,
+		GENERATE_MIPMAP_HINT:                         gl.GENERATE_MIPMAP_HINT,
+		GEQUAL:                                       gl.GEQUAL,
+		GREATER:                                      gl.GREATER,
+		GREEN_BITS:                                   gl.GREEN_BITS,
+		HIGH_FLOAT:                                   gl.HIGH_FLOAT,
+		HIGH_INT:                                     gl.HIGH_INT,
+		INCR:                                         gl.INCR,
+		INCR_WRAP:                                    gl.INCR_WRAP,
+		INFO_LOG_LENGTH:                              gl.INFO_LOG_LENGTH,
+		INT:                                          gl.INT,
+		INT_VEC2:                                     gl.INT_VEC2,
+		INT_VEC3:                                     gl.INT_VEC3,
+		INT_VEC4:                                     gl.INT_VEC4,
+		INVALID_ENUM:                                 gl.INVALID_ENUM,
+		INVALID_FRAMEBUFFER_OPERATION:                gl.INVALID_FRAMEBUFFER_OPERATION,
+		INVALID_OPERATION:                            gl.INVALID_OPERATION,
+		INVALID_VALUE:                                gl.INVALID_VALUE,
+		INVERT:                                       gl.INVERT,
+		KEEP:                                         gl.KEEP,
+		LEQUAL:                                       gl.LEQUAL,
+		LESS:                                         gl.LESS,
+		LINEAR:                                       gl.LINEAR,
+		LINEAR_MIPMAP_LINEAR:                         gl.LINEAR_MIPMAP_LINEAR,
+		LINEAR_MIPMAP_NEAREST:                        gl.LINEAR_MIPMAP_NEAREST,
+		LINES:                                        gl.LINES,
+		LINE_LOOP:                                    gl.LINE_LOOP,
+		LINE_STRIP:                                   gl.LINE_STRIP,
+		LINE_STIPPLE:                                 gl.LINE_STIPPLE,
+		LINE_WIDTH:                                   gl.LINE_WIDTH,
+		LINK_STATUS:                                  gl.LINK_STATUS,
+		LOW_FLOAT:                                    gl.LOW_FLOAT,
+		LOW_INT:                                      gl.LOW_INT,
+		LUMINANCE:                                    gl.LUMINANCE,
+		LUMINANCE_ALPHA:                              gl.LUMINANCE_ALPHA,
+		MAX_COMBINED_TEXTURE_IMAGE_UNITS:             gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS,
+		MAX_CUBE_MAP_TEXTURE_SIZE:                    gl.MAX_CUBE_MAP_TEXTURE_SIZE,
+		MAX_FRAGMENT_UNIFORM_VECTORS:                 gl.MAX_FRAGMENT_UNIFORM_VECTORS,
+		MAX_RENDERBUFFER_SIZE:                        gl.MAX_RENDERBUFFER_SIZE,
+		MAX_TEXTURE_IMAGE_UNITS:                      gl.MAX_TEXTURE_IMAGE_UNITS,
+		MAX_TEXTURE_SIZE:                             gl.MAX_TEXTURE_SIZE,
+		MAX_VARYING_VECTORS:                          gl.MAX_VARYING_VECTORS,
+		MAX_VERTEX_ATTRIBS:                           gl.MAX_VERTEX_ATTRIBS,
+		MAX_VERTEX_TEXTURE_IMAGE_UNITS:               gl.MAX_VERTEX_TEXTURE_IMAGE_UNITS,
+		MAX_VERTEX_UNIFORM_VECTORS:                   gl.MAX_VERTEX_UNIFORM_VECTORS,
+		MAX_VIEWPORT_DIMS:                            gl.MAX_VIEWPORT_DIMS,
+		MEDIUM_FLOAT:                                 gl.MEDIUM_FLOAT,
+		MEDIUM_INT:                                   gl.MEDIUM_INT,
+		MIRRORED_REPEAT:                              gl.MIRRORED_REPEAT,
+		MULTISAMPLE:                                  gl.MULTISAMPLE,
+		NEAREST:                                      gl.NEAREST,
+		NEAREST_MIPMAP_LINEAR:                        gl.NEAREST_MIPMAP_LINEAR,
+		NEAREST_MIPMAP_NEAREST:                       gl.NEAREST_MIPMAP_NEAREST,
+		NEVER:                                        gl.NEVER,
+		NICEST:                                       gl.NICEST,
+		NONE:                                         gl.NONE,
+		NOTEQUAL:                                     gl.NOTEQUAL,
+		NO_ERROR:                                     gl.NO_ERROR,
+		NUM_COMPRESSED_TEXTURE_FORMATS:               gl.NUM_COMPRESSED_TEXTURE_FORMATS,
+		ONE:                                          gl.ONE,
+		ONE_MINUS_CONSTANT_ALPHA:                     gl.ONE_MINUS_CONSTANT_ALPHA,
+		ONE_MINUS_CONSTANT_COLOR:                     gl.ONE_MINUS_CONSTANT_COLOR,
+		ONE_MINUS_DST_ALPHA:                          gl.ONE_MINUS_DST_ALPHA,
+		ONE_MINUS_DST_COLOR:                          gl.ONE_MINUS_DST_COLOR,
+		ONE_MINUS_SRC_ALPHA:                          gl.ONE_MINUS_SRC_ALPHA,
+		ONE_MINUS_SRC_COLOR:                          gl.ONE_MINUS_SRC_COLOR,
+		OUT_OF_MEMORY:                                gl.OUT_OF_MEMORY,
+		PACK_ALIGNMENT:                               gl.PACK_ALIGNMENT,
+		POINTS:                                       gl.POINTS,
+		POLYGON_OFFSET_FACTOR:                        gl.POLYGON_OFFSET_FACTOR,
+		POLYGON_OFFSET_FILL:                          gl.POLYGON_OFFSET_FILL,
+		POLYGON_OFFSET_UNITS:                         gl.POLYGON_OFFSET_UNITS,
+		RED_BITS:                                     gl.RED_BITS,
+		RENDERBUFFER:                                 gl.RENDERBUFFER,
+		RENDERBUFFER_ALPHA_SIZE:                      gl.RENDERBUFFER_ALPHA_SIZE,
+		RENDERBUFFER_BINDING:                         gl.RENDERBUFFER_BINDING,
+		RENDERBUFFER_BLUE_SIZE:                       gl.RENDERBUFFER_BLUE_SIZE,
+		RENDERBUFFER_DEPTH_SIZE:                      gl.RENDERBUFFER_DEPTH_SIZE,
+		RENDERBUFFER_GREEN_SIZE:                      gl.RENDERBUFFER_GREEN_SIZE,
+		RENDERBUFFER_HEIGHT:                          gl.RENDERBUFFER_HEIGHT,
+		RENDERBUFFER_INTERNAL_FORMAT:                 gl.RENDERBUFFER_INTERNAL_FORMAT,
+		RENDERBUFFER_RED_SIZE:                        gl.RENDERBUFFER_RED_SIZE,
+		RENDERBUFFER_STENCIL_SIZE:                    gl.RENDERBUFFER_STENCIL_SIZE,
+		RENDERBUFFER_WIDTH:                           gl.RENDERBUFFER_WIDTH,
+		RENDERER:                                     gl.RENDERER,
+		REPEAT:                                       gl.REPEAT,
+		REPLACE:                                      gl.REPLACE,
+		RGB:                                          gl.RGB,
+		RGB5_A1:                                      gl.RGB5_A1,
+		RGB565:                                       gl.RGB565,
+		RGBA:                                         gl.RGBA,
+		RGBA4:                                        gl.RGBA4,
+		RGBA8:                                        gl.RGBA8,
+		SAMPLER_2D:                                   gl.SAMPLER_2D,
+		SAMPLER_CUBE:                                 gl.SAMPLER_CUBE,
+		SAMPLES:                                      gl.SAMPLES,
+		SAMPLE_ALPHA_TO_COVERAGE:                     gl.SAMPLE_ALPHA_TO_COVERAGE,
+		SAMPLE_BUFFERS:                               gl.SAMPLE_BUFFERS,
+		SAMPLE_COVERAGE:                              gl.SAMPLE_COVERAGE,
+		SAMPLE_COVERAGE_INVERT:                       gl.SAMPLE_COVERAGE_INVERT,
+		SAMPLE_COVERAGE_VALUE:                        gl.SAMPLE_COVERAGE_VALUE,
+		SCISSOR_BOX:                                  gl.SCISSOR_BOX,
+		SCISSOR_TEST:                                 gl.SCISSOR_TEST,
+		SHADER_COMPILER:                              gl.SHADER_COMPILER,
+		SHADER_SOURCE_LENGTH:                         gl.SHADER_SOURCE_LENGTH,
+		SHADER_TYPE:                                  gl.SHADER_TYPE,
+		SHADING_LANGUAGE_VERSION:                     gl.SHADING_LANGUAGE_VERSION,
+		SHORT:                                        gl.SHORT,
+		SRC_ALPHA:                                    gl.SRC_ALPHA,
+		SRC_ALPHA_SATURATE:                           gl.SRC_ALPHA_SATURATE,
+		SRC_COLOR:                                    gl.SRC_COLOR,
+		STATIC_DRAW:                                  gl.STATIC_DRAW,
+		STENCIL_ATTACHMENT:                           gl.STENCIL_ATTACHMENT,
+		STENCIL_BACK_FAIL:                            gl.STENCIL_BACK_FAIL,
+		STENCIL_BACK_FUNC:                            gl.STENCIL_BACK_FUNC,
+		STENCIL_BACK_PASS_DEPTH_FAIL:                 gl.STENCIL_BACK_PASS_DEPTH_FAIL,
+		STENCIL_BACK_PASS_DEPTH_PASS:                 gl.STENCIL_BACK_PASS_DEPTH_PASS,
+		STENCIL_BACK_REF:                             gl.STENCIL_BACK_REF,
+		STENCIL_BACK_VALUE_MASK:                      gl.STENCIL_BACK_VALUE_MASK,
+		STENCIL_BACK_WRITEMASK:                       gl.STENCIL_BACK_WRITEMASK,
+		STENCIL_BITS:                                 gl.STENCIL_BITS,
+		STENCIL_BUFFER_BIT:                           gl.STENCIL_BUFFER_BIT,
+		STENCIL_CLEAR_VALUE:                          gl.STENCIL_CLEAR_VALUE,
+		STENCIL_FAIL:                                 gl.STENCIL_FAIL,
+		STENCIL_FUNC:                                 gl.STENCIL_FUNC,
+		STENCIL_INDEX:                                gl.STENCIL_INDEX,
+		STENCIL_INDEX8:                               gl.STENCIL_INDEX8,
+		STENCIL_PASS_DEPTH_FAIL:                      gl.STENCIL_PASS_DEPTH_FAIL,
+		STENCIL_PASS_DEPTH_PASS:                      gl.STENCIL_PASS_DEPTH_PASS,
+		STENCIL_REF:                                  gl.STENCIL_REF,
+		STENCIL_TEST:                                 gl.STENCIL_TEST,
+		STENCIL_VALUE_MASK:                           gl.STENCIL_VALUE_MASK,
+		STENCIL_WRITEMASK:                            gl.STENCIL_WRITEMASK,
+		STREAM_DRAW:                                  gl.STREAM_DRAW,
+		SUBPIXEL_BITS:                                gl.SUBPIXEL_BITS,
+		TEXTURE:                                      gl.TEXTURE,
+		TEXTURE0:                                     gl.TEXTURE0,
+		TEXTURE1:                                     gl.TEXTURE1,
+		TEXTURE2:                                     gl.TEXTURE2,
+		TEXTURE3:                                     gl.TEXTURE3,
+		TEXTURE4:                                     gl.TEXTURE4,
+		TEXTURE5:                                     gl.TEXTURE5,
+		TEXTURE6:                                     gl.TEXTURE6,
+		TEXTURE7:                                     gl.TEXTURE7,
+		TEXTURE8:                                     gl.TEXTURE8,
+		TEXTURE9:                                     gl.TEXTURE9,
+		TEXTURE10:                                    gl.TEXTURE10,
+		TEXTURE11:                                    gl.TEXTURE11,
+		TEXTURE12:                                    gl.TEXTURE12,
+		TEXTURE13:                                    gl.TEXTURE13,
+		TEXTURE14:                                    gl.TEXTURE14,
+		TEXTURE15:                                    gl.TEXTURE15,
+		TEXTURE16:                                    gl.TEXTURE16,
+		TEXTURE17:                                    gl.TEXTURE17,
+		TEXTURE18:                                    gl.TEXTURE18,
+		TEXTURE19:                                    gl.TEXTURE19,
+		TEXTURE20:                                    gl.TEXTURE20,
+		TEXTURE21:                                    gl.TEXTURE21,
+		TEXTURE22:                                    gl.TEXTURE22,
+		TEXTURE23:                                    gl.TEXTURE23,
+		TEXTURE24:                                    gl.TEXTURE24,
+		TEXTURE25:                                    gl.TEXTURE25,
+		TEXTURE26:                                    gl.TEXTURE26,
+		TEXTURE27:                                    gl.TEXTURE27,
+		TEXTURE28:                                    gl.TEXTURE28,
+		TEXTURE29:                                    gl.TEXTURE29,
+		TEXTURE30:                                    gl.TEXTURE30,
+		TEXTURE31:                                    gl.TEXTURE31,
+		TEXTURE_2D:                                   gl.TEXTURE_2D,
+		TEXTURE_BINDING_2D:                           gl.TEXTURE_BINDING_2D,
+		TEXTURE_BINDING_CUBE_MAP:                     gl.TEXTURE_BINDING_CUBE_MAP,
+		TEXTURE_CUBE_MAP:                             gl.TEXTURE_CUBE_MAP,
+		TEXTURE_CUBE_MAP_NEGATIVE_X:                  gl.TEXTURE_CUBE_MAP_NEGATIVE_X,
+		TEXTURE_CUBE_MAP_NEGATIVE_Y:                  gl.TEXTURE_CUBE_MAP_NEGATIVE_Y,
+		TEXTURE_CUBE_MAP_NEGATIVE_Z:                  gl.TEXTURE_CUBE_MAP_NEGATIVE_Z,
+		TEXTURE_CUBE_MAP_POSITIVE_X:                  gl.TEXTURE_CUBE_MAP_POSITIVE_X,
+		TEXTURE_CUBE_MAP_POSITIVE_Y:                  gl.TEXTURE_CUBE_MAP_POSITIVE_Y,
+		TEXTURE_CUBE_MAP_POSITIVE_Z:                  gl.TEXTURE_CUBE_MAP_POSITIVE_Z,
+		TEXTURE_MAG_FILTER:                           gl.TEXTURE_MAG_FILTER,
+		TEXTURE_MIN_FILTER:                           gl.TEXTURE_MIN_FILTER,
+		TEXTURE_WRAP_S:                               gl.TEXTURE_WRAP_S,
+		TEXTURE_WRAP_T:                               gl.TEXTURE_WRAP_T,
+		TRIANGLES:                                    gl.TRIANGLES,
+		TRIANGLE_FAN:                                 gl.TRIANGLE_FAN,
+		TRIANGLE_STRIP:                               gl.TRIANGLE_STRIP,
+		UNPACK_ALIGNMENT:                             gl.UNPACK_ALIGNMENT,
+		UNSIGNED_BYTE:                                gl.UNSIGNED_BYTE,
+		UNSIGNED_INT:                                 gl.UNSIGNED_INT,
+		UNSIGNED_SHORT:                               gl.UNSIGNED_SHORT,
+		UNSIGNED_SHORT_4_4_4_4:                       gl.UNSIGNED_SHORT_4_4_4_4,
+		UNSIGNED_SHORT_5_5_5_1:                       gl.UNSIGNED_SHORT_5_5_5_1,
+		UNSIGNED_SHORT_5_6_5:                         gl.UNSIGNED_SHORT_5_6_5,
+		VALIDATE_STATUS:                              gl.VALIDATE_STATUS,
+		VENDOR:                                       gl.VENDOR,
+		VERSION:                                      gl.VERSION,
+		VERTEX_ATTRIB_ARRAY_BUFFER_BINDING:           gl.VERTEX_ATTRIB_ARRAY_BUFFER_BINDING,
+		VERTEX_ATTRIB_ARRAY_ENABLED:                  gl.VERTEX_ATTRIB_ARRAY_ENABLED,
+		VERTEX_ATTRIB_ARRAY_NORMALIZED:               gl.VERTEX_ATTRIB_ARRAY_NORMALIZED,
+		VERTEX_ATTRIB_ARRAY_POINTER:                  gl.VERTEX_ATTRIB_ARRAY_POINTER,
+		VERTEX_ATTRIB_ARRAY_SIZE:                     gl.VERTEX_ATTRIB_ARRAY_SIZE,
+		VERTEX_ATTRIB_ARRAY_STRIDE:                   gl.VERTEX_ATTRIB_ARRAY_STRIDE,
+		VERTEX_ATTRIB_ARRAY_TYPE:                     gl.VERTEX_ATTRIB_ARRAY_TYPE,
+		VERTEX_SHADER:                                gl.VERTEX_SHADER,
+		VIEWPORT:                                     gl.VIEWPORT,
+		ZERO:                                         gl.ZERO,
+		TRUE:                                         gl.TRUE,
+	}
+}
@@ -0,0 +1,312 @@
+// Code generated by glgen from constants.txt; DO NOT EDIT.
+
+//go:build js && !nogl && !softgl
+// +build js,!nogl,!softgl
+
+package gl
+
+import (
+	"syscall/js"
+)
+
+// newConstants reads the constants from the WebGLRenderingContext prototype.
+func newConstants() Constants {
+	webCtx := js.Global().Get("WebGLRenderingContext").Get("prototype")
+	return Constants{
+		ACTIVE_ATTRIBUTES:                  Enum(webCtx.Get("ACTIVE_ATTRIBUTES").Int()),
+		ACTIVE_TEXTURE:                     Enum(webCtx.Get("ACTIVE_TEXTURE").Int()),
+		ACTIVE_UNIFORMS:                    Enum(webCtx.Get("ACTIVE_UNIFORMS").Int()),
+		ALIASED_LINE_WIDTH_RANGE:           Enum(webCtx.Get("ALIASED_LINE_WIDTH_RANGE").Int()),
+		ALIASED_POINT_SIZE_RANGE:           Enum(webCtx.Get("ALIASED_POINT_SIZE_RANGE").Int()),
+		ALPHA:                              Enum(webCtx.Get("ALPHA").Int()),
+		ALWAYS:                             Enum(webCtx.Get("ALWAYS").Int()),
+		ARRAY_BUFFER:                       Enum(webCtx.Get("ARRAY_BUFFER").Int()),
+		ARRAY_BUFFER_BINDING:               Enum(webCtx.Get("ARRAY_BUFFER_BINDING").Int()),
+		ATTACHED_SHADERS:                   Enum(webCtx.Get("ATTACHED_SHADERS").Int()),
+		BACK:                               Enum(webCtx.Get("BACK").Int()),
+		BLEND:                              Enum(webCtx.Get("BLEND").Int()),
+		BLEND_COLOR:                        Enum(webCtx.Get("BLEND_COLOR").Int()),
+		BLEND_DST_ALPHA:                    Enum(webCtx.Get("BLEND_DST_ALPHA").Int()),
+		BLEND_DST_RGB:                      Enum(webCtx.Get("BLEND_DST_RGB").Int()),
+		BLEND_EQUATION:                     Enum(webCtx.Get("BLEND_EQUATION").Int()),
+		BLEND_EQUATION_ALPHA:               Enum(webCtx.Get("BLEND_EQUATION_ALPHA").Int()),
+		BLEND_EQUATION_RGB:                 Enum(webCtx.Get("BLEND_EQUATION_RGB").Int()),
+		BLEND_SRC_ALPHA:                    Enum(webCtx.Get("BLEND_SRC_ALPHA").Int()),
+		BLEND_SRC_RGB:                      Enum(webCtx.Get("BLEND_SRC_RGB").Int()),
+		BLUE_BITS:                          Enum(webCtx.Get("BLUE_BITS").Int()),
+		BOOL:                               Enum(webCtx.Get("BOOL").Int()),
+		BOOL_VEC2:                          Enum(webCtx.Get("BOOL_VEC2").Int()),
+		BOOL_VEC3:                          Enum(webCtx.Get("BOOL_VEC3").Int()),
+		BOOL_VEC4:                          Enum(webCtx.Get("BOOL_VEC4").Int()),
+		BROWSER_DEFAULT_WEBGL:              Enum(webCtx.Get("BROWSER_DEFAULT_WEBGL").Int()),
+		BUFFER_SIZE:                        Enum(webCtx.Get("BUFFER_SIZE").Int()),
+		BUFFER_USAGE:                       Enum(webCtx.Get("BUFFER_USAGE").Int()),
+		BYTE:                               Enum(webCtx.Get("BYTE").Int()),
+		CCW:                                Enum(webCtx.Get("CCW").Int()),
+		CLAMP_TO_EDGE:                      Enum(webCtx.Get("CLAMP_TO_EDGE").Int()),
+		COLOR_ATTACHMENT0:                  Enum(webCtx.Get("COLOR_ATTACHMENT0").Int()),
+		COLOR_BUFFER_BIT:                   Enum(webCtx.Get("COLOR_BUFFER_BIT").Int()),
+		COLOR_CLEAR_VALUE:                  Enum(webCtx.Get("COLOR_CLEAR_VALUE").Int()),
+		COLOR_WRITEMASK:                    Enum(webCtx.Get("COLOR_WRITEMASK").Int()),
+		COMPILE_STATUS:                     Enum(webCtx.Get("COMPILE_STATUS").Int()),
+		COMPRESSED_TEXTURE_FORMATS:         Enum(webCtx.Get("COMPRESSED_TEXTURE_FORMATS").Int()),
+		CONSTANT_ALPHA:                     Enum(webCtx.Get("CONSTANT_ALPHA").Int()),
+		CONSTANT_COLOR:                     Enum(webCtx.Get("CONSTANT_COLOR").Int()),
+		CONTEXT_LOST_WEBGL:                 Enum(webCtx.Get("CONTEXT_LOST_WEBGL").Int()),
+		CULL_FACE:                          Enum(webCtx.Get("CULL_FACE").Int()),
+		CULL_FACE_MODE:                     Enum(webCtx.Get("CULL_FACE_MODE").Int()),
+		CURRENT_PROGRAM:                    Enum(webCtx.Get("CURRENT_PROGRAM").Int()),
+		CURRENT_VERTEX_ATTRIB:              Enum(webCtx.Get("CURRENT_VERTEX_ATTRIB").Int()),
+		CW:                                 Enum(webCtx.Get("CW").Int()),
+		DECR:                               Enum(webCtx.Get("DECR").Int()),
+		DECR_WRAP:                          Enum(webCtx.Get("DECR_WRAP").Int()),
+		DELETE_STATUS:                      Enum(webCtx.Get("DELETE_STATUS").Int()),
+		DEPTH_ATTACHMENT:                   Enum(webCtx.Get("DEPTH_ATTACHMENT").Int()),
+		DEPTH_BITS:                         Enum(webCtx.Get("DEPTH_BITS").Int()),
+		DEPTH_BUFFER_BIT:                   Enum(webCtx.Get("DEPTH_BUFFER_BIT").Int()),
+		DEPTH_CLEAR_VALUE:                  Enum(webCtx.Get("DEPTH_CLEAR_VALUE").Int()),
+		DEPTH_COMPONENT:                    Enum(webCtx.Get("DEPTH_COMPONENT").Int()),
+		DEPTH_COMPONENT16:                  Enum(webCtx.Get("DEPTH_COMPONENT16").Int()),
+		DEPTH_FUNC:                         Enum(webCtx.Get("DEPTH_FUNC").Int()),
+		DEPTH_RANGE:                        Enum(webCtx.Get("DEPTH_RANGE").Int()),
+		DEPTH_STENCIL:                      Enum(webCtx.Get("DEPTH_STENCIL").Int()),
+		DEPTH_STENCIL_ATTACHMENT:           Enum(webCtx.Get("DEPTH_STENCIL_ATTACHMENT").Int()),
+		DEPTH_TEST:                         Enum(webCtx.Get("DEPTH_TEST").Int()),
+		DEPTH_WRITEMASK:                    Enum(webCtx.Get("DEPTH_WRITEMASK").Int()),
+		DITHER:                             Enum(webCtx.Get("DITHER").Int()),
+		DONT_CARE:                          Enum(webCtx.Get("DONT_CARE").Int()),
+		DST_ALPHA:                          Enum(webCtx.Get("DST_ALPHA").Int()),
+		DST_COLOR:                          Enum(webCtx.Get("DST_COLOR").Int()),
+		DYNAMIC_DRAW:                       Enum(webCtx.Get("DYNAMIC_DRAW").Int()),
+		ELEMENT_ARRAY_BUFFER:               Enum(webCtx.Get("ELEMENT_ARRAY_BUFFER").Int()),
+		ELEMENT_ARRAY_BUFFER_BINDING:       Enum(webCtx.Get("ELEMENT_ARRAY_BUFFER_BINDING").Int()),
+		EQUAL:                              Enum(webCtx.Get("EQUAL").Int()),
+		FASTEST:                            Enum(webCtx.Get("FASTEST").Int()),
+		FLOAT:                              Enum(webCtx.Get("FLOAT").Int()),
+		FLOAT_MAT2:                         Enum(webCtx.Get("FLOAT_MAT2").Int()),
+		FLOAT_MAT3:                         Enum(webCtx.Get("FLOAT_MAT3").Int()),
+		FLOAT_MAT4:                         Enum(webCtx.Get("FLOAT_MAT4").Int()),
+		FLOAT_VEC2:                         Enum(webCtx.Get("FLOAT_VEC2").Int()),
+		FLOAT_VEC3:                         Enum(webCtx.Get("FLOAT_VEC3").Int()),
+		FLOAT_VEC4:                         Enum(webCtx.Get("FLOAT_VEC4").Int()),
+		FRAGMENT_SHADER:                    Enum(webCtx.Get("FRAGMENT_SHADER").Int()),
+		FRAMEBUFFER:                        Enum(webCtx.Get("FRAMEBUFFER").Int()),
+		FRAMEBUFFER_ATTACHMENT_OBJECT_NAME: Enum(webCtx.Get("FRAMEBUFFER_ATTACHMENT_OBJECT_NAME").Int()),
+		FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE: Enum(webCtx.Get("FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE").Int()),
+		FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE: Enum(webCtx.Get("FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE").Int()),
+		FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL:         Enum(webCtx.Get("FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL").Int()),
+		FRAMEBUFFER_BINDING:                          Enum(webCtx.Get("FRAMEBUFFER_BINDING").Int()),
+		FRAMEBUFFER_COMPLETE:                         Enum(webCtx.Get("FRAMEBUFFER_COMPLETE").Int()),
+		FRAMEBUFFER_INCOMPLETE_ATTACHMENT:            Enum(webCtx.Get("FRAMEBUFFER_INCOMPLETE_ATTACHMENT").Int()),
+		FRAMEBUFFER_INCOMPLETE_DIMENSIONS:            Enum(webCtx.Get("FRAMEBUFFER_INCOMPLETE_DIMENSIONS").Int()),
+		FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT:    Enum(webCtx.Get("FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT").Int()),
+		FRAMEBUFFER_UNSUPPORTED:                      Enum(webCtx.Get("FRAMEBUFFER_UNSUPPORTED").Int()),
+		FRONT:                                        Enum(webCtx.Get("FRONT").Int()),
+		FRONT_AND_BACK:                               Enum(webCtx.Get("FRONT_AND_BACK").Int()),
+		FRONT_FACE:                                   Enum(webCtx.Get("FRONT_FACE").Int()),
+		FUNC_ADD:                                     Enum(webCtx.Get("FUNC_ADD").Int()),
+		FUNC_REVERSE_SUBTRACT:                        Enum(webCtx.Get("FUNC_REVERSE_SUBTRACT").Int()),
+		FUNC_SUBTRACT:                                Enum(webCtx.Get("FUNC_SUBTRACT").Int()),
+		GENERATE_MIPMAP_HINT:                         Enum(webCtx.Get("GENERATE_MIPMAP_HINT").Int()),
+		GEQUAL:                                       Enum(webCtx.Get("GEQUAL").Int()),
+		GREATER:                                      Enum(webCtx.Get("GREATER").Int()),
+		GREEN_BITS:                                   Enum(webCtx.Get("GREEN_BITS").Int()),
+		HIGH_FLOAT:                                   Enum(webCtx.Get("HIGH_FLOAT").Int()),
+		HIGH_INT:                                     Enum(webCtx.Get("HIGH_INT").Int()),
+		INCR:                                         Enum(webCtx.Get("INCR").Int()),
+		INCR_WRAP:                                    Enum(webCtx.Get("INCR_WRAP").Int()),
+		INT:                                          Enum(webCtx.Get("INT").Int()),
+		INT_VEC2:                                     Enum(webCtx.Get("INT_VEC2").Int()),
+		INT_VEC3:                                     Enum(webCtx.Get("INT_VEC3").Int()),
+		INT_VEC4:                                     Enum(webCtx.Get("INT_VEC4").Int()),
+		INVALID_ENUM:                                 Enum(webCtx.Get("INVALID_ENUM").Int()),
+		INVALID_FRAMEBUFFER_OPERATION:                Enum(webCtx.Get("INVALID_FRAMEBUFFER_OPERATION").Int()),
+		INVALID_OPERATION:                            Enum(webCtx.Get("INVALID_OPERATION").Int()),
+		INVALID_VALUE:                                Enum(webCtx.Get("INVALID_VALUE").Int()),
+		INVERT:                                       Enum(webCtx.Get("INVERT").Int()),
+		KEEP:                                         Enum(webCtx.Get("KEEP").Int()),
+		LEQUAL:                                       Enum(webCtx.Get("LEQUAL").Int()),
+		LESS:                                         Enum(webCtx.Get("LESS").Int()),
+		LINEAR:                                       Enum(webCtx.Get("LINEAR").Int()),
+		LINEAR_MIPMAP_LINEAR:                         Enum(webCtx.Get("LINEAR_MIPMAP_LINEAR").Int()),
+		LINEAR_MIPMAP_NEAREST:                        Enum(webCtx.Get("LINEAR_MIPMAP_NEAREST").Int()),
+		LINES:                                        Enum(webCtx.Get("LINES").Int()),
+		LINE_LOOP:                                    Enum(webCtx.Get("LINE_LOOP").Int()),
+		LINE_STRIP:                                   Enum(webCtx.Get("LINE_STRIP").Int()),
+		LINE_WIDTH:                                   Enum(webCtx.Get("LINE_WIDTH").Int()),
+		LINK_STATUS:                                  Enum(webCtx.Get("LINK_STATUS").Int()),
+		LOW_FLOAT:                                    Enum(webCtx.Get("LOW_FLOAT").Int()),
+		LOW_INT:                                      Enum(webCtx.Get("LOW_INT").Int()),
+		LUMINANCE:                                    Enum(webCtx.Get("LUMINANCE").Int()),
+		LUMINANCE_ALPHA:                              Enum(webCtx.Get("LUMINANCE_ALPHA").Int()),
+		MAX_COMBINED_TEXTURE_IMAGE_UNITS:             Enum(webCtx.Get("MAX_COMBINED_TEXTURE_IMAGE_UNITS").Int()),
+		MAX_CUBE_MAP_TEXTURE_SIZE:                    Enum(webCtx.Get("MAX_CUBE_MAP_TEXTURE_SIZE").Int()),
+		MAX_FRAGMENT_UNIFORM_VECTORS:                 Enum(webCtx.Get("MAX_FRAGMENT_UNIFORM_VECTORS").Int()),
+		MAX_RENDERBUFFER_SIZE:                        Enum(webCtx.Get("MAX_RENDERBUFFER_SIZE").Int()),
+		MAX_TEXTURE_IMAGE_UNITS:                      Enum(webCtx.Get("MAX_TEXTURE_IMAGE_UNITS").Int()),
+		MAX_TEXTURE_SIZE:                             Enum(webCtx.Get("MAX_TEXTURE_SIZE").Int()),
+		MAX_VARYING_VECTORS:                          Enum(webCtx.Get("MAX_VARYING_VECTORS").Int()),
+		MAX_VERTEX_ATTRIBS:                           Enum(webCtx.Get("MAX_VERTEX_ATTRIBS").Int()),
+		MAX_VERTEX_TEXTURE_IMAGE_UNITS:               Enum(webCtx.Get("MAX_VERTEX_TEXTURE_IMAGE_UNITS").Int()),
+		MAX_VERTEX_UNIFORM_VECTORS:                   Enum(webCtx.Get("MAX_VERTEX_UNIFORM_VECTORS").Int()),
+		MAX_VIEWPORT_DIMS:                            Enum(webCtx.Get("MAX_VIEWPORT_DIMS").Int()),
+		MEDIUM_FLOAT:                                 Enum(webCtx.Get("MEDIUM_FLOAT").Int()),
+		MEDIUM_INT:                                   Enum(webCtx.Get("MEDIUM_INT").Int()),
+		MIRRORED_REPEAT:                              Enum(webCtx.Get("MIRRORED_REPEAT").Int()),
+		NEAREST:                                      Enum(webCtx.Get("NEAREST").Int()),
+		NEAREST_MIPMAP_LINEAR:                        Enum(webCtx.Get("NEAREST_MIPMAP_LINEAR").Int()),
+		NEAREST_MIPMAP_NEAREST:                       Enum(webCtx.Get("NEAREST_MIPMAP_NEAREST").Int()),
+		NEVER:                                        Enum(webCtx.Get("NEVER").Int()),
+		NICEST:                                       Enum(webCtx.Get("NICEST").Int()),
+		NONE:                                         Enum(webCtx.Get("NONE").Int()),
+		NOTEQUAL:                                     Enum(webCtx.Get("NOTEQUAL").Int()),
+		NO_ERROR:                                     Enum(webCtx.Get("NO_ERROR").Int()),
+		ONE:                                          Enum(webCtx.Get("ONE").Int()),
+		ONE_MINUS_CONSTANT_ALPHA:                     Enum(webCtx.Get("ONE_MINUS_CONSTANT_ALPHA").Int()),
+		ONE_MINUS_CONSTANT_COLOR:                     Enum(webCtx.Get("ONE_MINUS_CONSTANT_COLOR").Int()),
+		ONE_MINUS_DST_ALPHA:                          Enum(webCtx.Get("ONE_MINUS_DST_ALPHA").Int()),
+		ONE_MINUS_DST_COLOR:                          Enum(webCtx.Get("ONE_MINUS_DST_COLOR").Int()),
+		ONE_MINUS_SRC_ALPHA:                          Enum(webCtx.Get("ONE_MINUS_SRC_ALPHA").Int()),
+		ONE_MINUS_SRC_COLOR:                          Enum(webCtx.Get("ONE_MINUS_SRC_COLOR").Int()),
+		OUT_OF_MEMORY:                                Enum(webCtx.Get("OUT_OF_MEMORY").Int()),
+		PACK_ALIGNMENT:                               Enum(webCtx.Get("PACK_ALIGNMENT").Int()),
+		POINTS:                                       Enum(webCtx.Get("POINTS").Int()),
+		POLYGON_OFFSET_FACTOR:                        Enum(webCtx.Get("POLYGON_OFFSET_FACTOR").Int()),
+		POLYGON_OFFSET_FILL:                          Enum(webCtx.Get("POLYGON_OFFSET_FILL").Int()),
+		POLYGON_OFFSET_UNITS:                         Enum(webCtx.Get("POLYGON_OFFSET_UNITS").Int()),
+		RED_BITS:                                     Enum(webCtx.Get("RED_BITS").Int()),
+		RENDERBUFFER:                                 Enum(webCtx.Get("RENDERBUFFER").Int()),
+		RENDERBUFFER_ALPHA_SIZE:                      Enum(webCtx.Get("RENDERBUFFER_ALPHA_SIZE").Int()),
+		RENDERBUFFER_BINDING:                         Enum(webCtx.Get("RENDERBUFFER_BINDING").Int()),
+		RENDERBUFFER_BLUE_SIZE:                       Enum(webCtx.Get("RENDERBUFFER_BLUE_SIZE").Int()),
+		RENDERBUFFER_DEPTH_SIZE:                      Enum(webCtx.Get("RENDERBUFFER_DEPTH_SIZE").Int()),
+		RENDERBUFFER_GREEN_SIZE:                      Enum(webCtx.Get("RENDERBUFFER_GREEN_SIZE").Int()),
+		RENDERBUFFER_HEIGHT:                          Enum(webCtx.Get("RENDERBUFFER_HEIGHT").Int()),
+		RENDERBUFFER_INTERNAL_FORMAT:                 Enum(webCtx.Get("RENDERBUFFER_INTERNAL_FORMAT").Int()),
+		RENDERBUFFER_RED_SIZE:                        Enum(webCtx.Get("RENDERBUFFER_RED_SIZE").Int()),
+		RENDERBUFFER_STENCIL_SIZE:                    Enum(webCtx.Get("RENDERBUFFER_STENCIL_SIZE").Int()),
+		RENDERBUFFER_WIDTH:                           Enum(webCtx.Get("RENDERBUFFER_WIDTH").Int()),
+		RENDERER:                                     Enum(webCtx.Get("RENDERER").Int()),
+		REPEAT:                                       Enum(webCtx.Get("REPEAT").Int()),
+		REPLACE:                                      Enum(webCtx.Get("REPLACE").Int()),
+		RGB:                                          Enum(webCtx.Get("RGB").Int()),
+		RGB5_A1:                                      Enum(webCtx.Get("RGB5_A1").Int()),
+		RGB565:                                       Enum(webCtx.Get("RGB565").Int()),
+		RGBA:                                         Enum(webCtx.Get("RGBA").Int()),
+		RGBA4:                                        Enum(webCtx.Get("RGBA4").Int()),
+		RGBA8:                                        Enum(webCtx.Get("RGBA4").Int()),
+		SAMPLER_2D:                                   Enum(webCtx.Get("SAMPLER_2D").Int()),
+		SAMPLER_CUBE:                                 Enum(webCtx.Get("SAMPLER_CUBE").Int()),
+		SAMPLES:                                      Enum(webCtx.Get("SAMPLES").Int()),
+		SAMPLE_ALPHA_TO_COVERAGE:                     Enum(webCtx.Get("SAMPLE_ALPHA_TO_COVERAGE").Int()),
+		SAMPLE_BUFFERS:                               Enum(webCtx.Get("SAMPLE_BUFFERS").Int()),
+		SAMPLE_COVERAGE:                              Enum(webCtx.Get("SAMPLE_COVERAGE").Int()),
+		SAMPLE_COVERAGE_INVERT:                       Enum(webCtx.Get("SAMPLE_COVERAGE_INVERT").Int()),
+		SAMPLE_COVERAGE_VALUE:                        Enum(webCtx.Get("SAMPLE_COVERAGE_VALUE").Int()),
+		SCISSOR_BOX:                                  Enum(webCtx.Get("SCISSOR_BOX").Int()),
+		SCISSOR_TEST:                                 Enum(webCtx.Get("SCISSOR_TEST").Int()),
+		SHADER_TYPE:                                  Enum(webCtx.Get("SHADER_TYPE").Int()),
+		SHADING_LANGUAGE_VERSION:                     Enum(webCtx.Get("SHADING_LANGUAGE_VERSION").Int()),
+		SHORT:                                        Enum(webCtx.Get("SHORT").Int()),
+		SRC_ALPHA:                                    Enum(webCtx.Get("SRC_ALPHA").Int()),
+		SRC_ALPHA_SATURATE:                           Enum(webCtx.Get("SRC_ALPHA_SATURATE").Int()),
+		SRC_COLOR:                                    Enum(webCtx.Get("SRC_COLOR").Int()),
+		STATIC_DRAW:                                  Enum(webCtx.Get("STATIC_DRAW").Int()),
+		STENCIL_ATTACHMENT:                           Enum(webCtx.Get("STENCIL_ATTACHMENT").Int()),
+		STENCIL_BACK_FAIL:                            Enum(webCtx.Get("STENCIL_BACK_FAIL").Int()),
+		STENCIL_BACK_FUNC:                            Enum(webCtx.Get("STENCIL_BACK_FUNC").Int()),
+		STENCIL_BACK_PASS_DEPTH_FAIL:                 Enum(webCtx.Get("STENCIL_BACK_PASS_DEPTH_FAIL").Int()),
+		STENCIL_BACK_PASS_DEPTH_PASS:                 Enum(webCtx.Get("STENCIL_BACK_PASS_DEPTH_PASS").Int()),
+		STENCIL_BACK_REF:                             Enum(webCtx.Get("STENCIL_BACK_REF").Int()),
+		STENCIL_BACK_VALUE_MASK:                      Enum(webCtx.Get("STENCIL_BACK_VALUE_MASK").Int()),
+		STENCIL_BACK_WRITEMASK:                       Enum(webCtx.Get("STENCIL_BACK_WRITEMASK").Int()),
+		STENCIL_BITS:                                 Enum(webCtx.Get("STENCIL_BITS").Int()),
+		STENCIL_BUFFER_BIT:                           Enum(webCtx.Get("STENCIL_BUFFER_BIT").Int()),
+		STENCIL_CLEAR_VALUE:                          Enum(webCtx.Get("STENCIL_CLEAR_VALUE").Int()),
+		STENCIL_FAIL:                                 Enum(webCtx.Get("STENCIL_FAIL").Int()),
+		STENCIL_FUNC:                                 Enum(webCtx.Get("STENCIL_FUNC").Int()),
+		STENCIL_INDEX8:                               Enum(webCtx.Get("STENCIL_INDEX8").Int()),
+		STENCIL_PASS_DEPTH_FAIL:                      Enum(webCtx.Get("STENCIL_PASS_DEPTH_FAIL").Int()),
+		STENCIL_PASS_DEPTH_PASS:                      Enum(webCtx.Get("STENCIL_PASS_DEPTH_PASS").Int()),
+		STENCIL_REF:                                  Enum(webCtx.Get("STENCIL_REF").Int()),
+		STENCIL_TEST:                                 Enum(webCtx.Get("STENCIL_TEST").Int()),
+		STENCIL_VALUE_MASK:                           Enum(webCtx.Get("STENCIL_VALUE_MASK").Int()),
+		STENCIL_WRITEMASK:                            Enum(webCtx.Get("STENCIL_WRITEMASK").Int()),
+		STREAM_DRAW:                                  Enum(webCtx.Get("STREAM_DRAW").Int()),
+		SUBPIXEL_BITS:                                Enum(webCtx.Get("SUBPIXEL_BITS").Int()),
+		TEXTURE:                                      Enum(webCtx.Get("TEXTURE").Int()),
+		TEXTURE0:                                     Enum(webCtx.Get("TEXTURE0").Int()),
+		TEXTURE1:                                     Enum(webCtx.Get("TEXTURE1").Int()),
+		TEXTURE2:                                     Enum(webCtx.Get("TEXTURE2").Int()),
+		TEXTURE3:                                     Enum(webCtx.Get("TEXTURE3").Int()),
+		TEXTURE4:                                     Enum(webCtx.Get("TEXTURE4").Int()),
+		TEXTURE5:                                     Enum(webCtx.Get("TEXTURE5").Int()),
+		TEXTURE6:                                     Enum(webCtx.Get("TEXTURE6").Int()),
+		TEXTURE7:                                     Enum(webCtx.Get("TEXTURE7").Int()),
+		TEXTURE8:                                     Enum(webCtx.Get("TEXTURE8").Int()),
+		TEXTURE9:                                     Enum(webCtx.Get("TEXTURE9").Int()),
+		TEXTURE10:                                    Enum(webCtx.Get("TEXTURE10").Int()),
+		TEXTURE11:                                    Enum(webCtx.Get("TEXTURE11").Int()),
+		TEXTURE12:                                    Enum(webCtx.Get("TEXTURE12").Int()),
+		TEXTURE13:                                    Enum(webCtx.Get("TEXTURE13").Int()),
+		TEXTURE14:                                    Enum(webCtx.Get("TEXTURE14").Int()),
+		TEXTURE15:                                    Enum(webCtx.Get("TEXTURE15").Int()),
+		TEXTURE16:                                    Enum(webCtx.Get("TEXTURE16").Int()),
+		TEXTURE17:                                    Enum(webCtx.Get("TEXTURE17").Int()),
+		TEXTURE18:                                    Enum(webCtx.Get("TEXTURE18").Int()),
+		TEXTURE19:                                    Enum(webCtx.Get("TEXTURE19").Int()),
+		TEXTURE20:                                    Enum(webCtx.Get("TEXTURE20").Int()),
+		TEXTURE21:                                    Enum(webCtx.Get("TEXTURE21").Int()),
+		TEXTURE22:                                    Enum(webCtx.Get("TEXTURE22").Int()),
+		TEXTURE23:                                    Enum(webCtx.Get("TEXTURE23").Int()),
+		TEXTURE24:                                    Enum(webCtx.Get("TEXTURE24").Int()),
+		TEXTURE25:                                    Enum(webCtx.Get("TEXTURE25").Int()),
+		TEXTURE26:                                    Enum(webCtx.Get("TEXTURE26").Int()),
+		TEXTURE27:                                    Enum(webCtx.Get("TEXTURE27").Int()),
+		TEXTURE28:                                    Enum(webCtx.Get("TEXTURE28").Int()),
+		TEXTURE29:                                    Enum(webCtx.Get("TEXTURE29").Int()),
+		TEXTURE30:                                    Enum(webCtx.Get("TEXTURE30").Int()),
+		TEXTURE31:                                    Enum(webCtx.Get("TEXTURE31").Int()),
+		TEXTURE_2D:                                   Enum(webCtx.Get("TEXTURE_2D").Int()),
+		TEXTURE_BINDING_2D:                           Enum(webCtx.Get("TEXTURE_BINDING_2D").Int()),
+		TEXTURE_BINDING_CUBE_MAP:                     Enum(webCtx.Get("TEXTURE_BINDING_CUBE_MAP").Int()),
+		TEXTURE_CUBE_MAP:                             Enum(webCtx.Get("TEXTURE_CUBE_MAP").Int()),
+		TEXTURE_CUBE_MAP_NEGATIVE_X:                  Enum(webCtx.Get("TEXTURE_CUBE_MAP_NEGATIVE_X").Int()),
+		TEXTURE_CUBE_MAP_NEGATIVE_Y:                  Enum(webCtx.Get("TEXTURE_CUBE_MAP_NEGATIVE_Y").Int()),
+		TEXTURE_CUBE_MAP_NEGATIVE_Z:                  Enum(webCtx.Get("TEXTURE_CUBE_MAP_NEGATIVE_Z").Int()),
+		TEXTURE_CUBE_MAP_POSITIVE_X:                  Enum(webCtx.Get("TEXTURE_CUBE_MAP_POSITIVE_X").Int()),
+		TEXTURE_CUBE_MAP_POSITIVE_Y:                  Enum(webCtx.Get("TEXTURE_CUBE_MAP_POSITIVE_Y").Int()),
+		TEXTURE_CUBE_MAP_POSITIVE_Z:                  Enum(webCtx.Get("TEXTURE_CUBE_MAP_POSITIVE_Z").Int()),
+		TEXTURE_MAG_FILTER:                           Enum(webCtx.Get("TEXTURE_MAG_FILTER").Int()),
+		TEXTURE_MIN_FILTER:                           Enum(webCtx.Get("TEXTURE_MIN_FILTER").Int()),
+		TEXTURE_WRAP_S:                               Enum(webCtx.Get("TEXTURE_WRAP_S").Int()),
+		TEXTURE_WRAP_T:                               Enum(webCtx.Get("TEXTURE_WRAP_T").Int()),
+		TRIANGLES:                                    Enum(webCtx.Get("TRIANGLES").Int()),
+		TRIANGLE_FAN:                                 Enum(webCtx.Get("TRIANGLE_FAN").Int()),
+		TRIANGLE_STRIP:                               Enum(webCtx.Get("TRIANGLE_STRIP").Int()),
+		UNPACK_ALIGNMENT:                             Enum(webCtx.Get("UNPACK_ALIGNMENT").Int()),
+		UNPACK_COLORSPACE_CONVERSION_WEBGL:           Enum(webCtx.Get("UNPACK_COLORSPACE_CONVERSION_WEBGL").Int()),
+		UNPACK_FLIP_Y_WEBGL:                          Enum(webCtx.Get("UNPACK_FLIP_Y_WEBGL").Int()),
+		UNPACK_PREMULTIPLY_ALPHA_WEBGL:               Enum(webCtx.Get("UNPACK_PREMULTIPLY_ALPHA_WEBGL").Int()),
+		UNSIGNED_BYTE:                                Enum(webCtx.Get("UNSIGNED_BYTE").Int()),
+		UNSIGNED_INT:                                 Enum(webCtx.Get("UNSIGNED_INT").Int()),
+		UNSIGNED_SHORT:                               Enum(webCtx.Get("UNSIGNED_SHORT").Int()),
+		UNSIGNED_SHORT_4_4_4_4:                       Enum(webCtx.Get("UNSIGNED_SHORT_4_4_4_4").Int()),
+		UNSIGNED_SHORT_5_5_5_1:                       Enum(webCtx.Get("UNSIGNED_SHORT_5_5_5_1").Int()),
+		UNSIGNED_SHORT_5_6_5:                         Enum(webCtx.Get("UNSIGNED_SHORT_5_6_5").Int()),
+		VALIDATE_STATUS:                              Enum(webCtx.Get("VALIDATE_STATUS").Int()),
+		VENDOR:                                       Enum(webCtx.Get("VENDOR").Int()),
+		VERSION:                                      Enum(webCtx.Get("VERSION").Int()),
+		VERTEX_ATTRIB_ARRAY_BUFFER_BINDING:           Enum(webCtx.Get("VERTEX_ATTRIB_ARRAY_BUFFER_BINDING").Int()),
+		VERTEX_ATTRIB_ARRAY_ENABLED:                  Enum(webCtx.Get("VERTEX_ATTRIB_ARRAY_ENABLED").Int()),
+		VERTEX_ATTRIB_ARRAY_NORMALIZED:               Enum(webCtx.Get("VERTEX_ATTRIB_ARRAY_NORMALIZED").Int()),
+		VERTEX_ATTRIB_ARRAY_POINTER:                  Enum(webCtx.Get("VERTEX_ATTRIB_ARRAY_POINTER").Int()),
+		VERTEX_ATTRIB_ARRAY_SIZE:                     Enum(webCtx.Get("VERTEX_ATTRIB_ARRAY_SIZE").Int()),
+		VERTEX_ATTRIB_ARRAY_STRIDE:                   Enum(webCtx.Get("VERTEX_ATTRIB_ARRAY_STRIDE").Int()),
+		VERTEX_ATTRIB_ARRAY_TYPE:                     Enum(webCtx.Get("VERTEX_ATTRIB_ARRAY_TYPE").Int()),
+		VERTEX_SHADER:                                Enum(webCtx.Get("VERTEX_SHADER").Int()),
+		VIEWPORT:                                     Enum(webCtx.Get("VIEWPORT").Int()),
+		ZERO:                                         Enum(webCtx.Get("ZERO").Int()),
+		TRUE:                                         1,
+	}
+}
@@ -0,0 +1,867 @@
+package gl
+
+import (
+	"fmt"
+	"image"
+	"log"
+	"runtime"
+	"strings"
+)
+
+// CallError describes an error flagged by OpenGL after a call made through a
+// DebugContext.
+type CallError struct {
+	// Code is the value returned by GetError, e.g. INVALID_ENUM, and Name
+	// its ErrorName.
+	Code Enum
+	Name string
+
+	// Method and Args are the name and arguments of the failing call.
+	Method string
+	Args   []interface{}
+
+	// File and Line locate the Go code that made the call.
+	File string
+	Line int
+}
+
+func (e *CallError) Error() string {
+	args := make([]string, len(e.Args))
+	for i, arg := range e.Args {
+		args[i] = formatArg(arg)
+	}
+	return fmt.Sprintf("gl: %s from %s(%s) at %s:%d", e.Name, e.Method, strings.Join(args, ", "), e.File, e.Line)
+}
+
+// formatArg formats a call argument, summarizing data slices by their length.
+func formatArg(arg interface{}) string {
+	switch a := arg.(type) {
+	case []byte:
+		return fmt.Sprintf("[]byte(len %d)", len(a))
+	case []float32:
+		return fmt.Sprintf("[]float32(len %d)", len(a))
+	case []int32:
+		return fmt.Sprintf("[]int32(len %d)", len(a))
+	case string:
+		return fmt.Sprintf("%q", a)
+	case nil:
+		return "nil"
+	}
+	return fmt.Sprintf("%v", arg)
+}
+
+// DebugContext wraps a Context and checks GetError after every call of the
+// Renderer interface, reporting each error raised to a handler. The wrapped
+// Context is untouched, so code that doesn't use a DebugContext pays nothing
+// for it.
+//
+// Errors are drained after every call, so GetError on a DebugContext only
+// reports errors raised by calls made directly on the wrapped Context.
+type DebugContext struct {
+	*Context
+	handler func(*CallError)
+}
+
+var _ Renderer = (*DebugContext)(nil)
+
+// NewDebugContext returns a DebugContext wrapping c that passes every error
+// to handler. A nil handler logs the errors.
+func NewDebugContext(c *Context, handler func(*CallError)) *DebugContext {
+	if handler == nil {
+		handler = func(err *CallError) {
+			log.Println(err)
+		}
+	}
+	return &DebugContext{Context: c, handler: handler}
+}
+
+// maxDrainedErrors bounds the GetError loop, in case a lost context keeps
+// reporting an error.
+const maxDrainedErrors = 16
+
+// check reports the errors flagged by the call method(args...). It must be
+// called directly from the wrapping method, so the caller location is found.
+func (d *DebugContext) check(method string, args ...interface{}) {
+	code := d.Context.GetError()
+	if code == d.NO_ERROR {
+		return
+	}
+
+	_, file, line, _ := runtime.Caller(2)
+	for i := 0; i < maxDrainedErrors && code != d.NO_ERROR; i++ {
+		d.handler(&CallError{
+			Code:   code,
+			Name:   d.ErrorName(code),
+			Method: method,
+			Args:   args,
+			File:   file,
+			Line:   line,
+		})
+		code = d.Context.GetError()
+	}
+}
+
+func (d *DebugContext) BlendColor(r, g, b, a float32) {
+	d.Context.BlendColor(r, g, b, a)
+	d.check("BlendColor", r, g, b, a)
+}
+
+func (d *DebugContext) BlendEquation(mode Enum) {
+	d.Context.BlendEquation(mode)
+	d.check("BlendEquation", mode)
+}
+
+func (d *DebugContext) BlendEquationSeparate(modeRGB, modeAlpha Enum) {
+	d.Context.BlendEquationSeparate(modeRGB, modeAlpha)
+	d.check("BlendEquationSeparate", modeRGB, modeAlpha)
+}
+
+func (d *DebugContext) BlendFunc(sfactor, dfactor Enum) {
+	d.Context.BlendFunc(sfactor, dfactor)
+	d.check("BlendFunc", sfactor, dfactor)
+}
+
+func (d *DebugContext) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha Enum) {
+	d.Context.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+	d.check("BlendFuncSeparate", srcRGB, dstRGB, srcAlpha, dstAlpha)
+}
+
+func (d *DebugContext) DepthFunc(fun Enum) {
+	d.Context.DepthFunc(fun)
+	d.check("DepthFunc", fun)
+}
+
+func (d *DebugContext) SampleCoverage(value float32, invert bool) {
+	d.Context.SampleCoverage(value, invert)
+	d.check("SampleCoverage", value, invert)
+}
+
+func (d *DebugContext) StencilFunc(function Enum, ref, mask int) {
+	d.Context.StencilFunc(function, ref, mask)
+	d.check("StencilFunc", function, ref, mask)
+}
+
+func (d *DebugContext) StencilFuncSeparate(face, function Enum, ref, mask int) {
+	d.Context.StencilFuncSeparate(face, function, ref, mask)
+	d.check("StencilFuncSeparate", face, function, ref, mask)
+}
+
+func (d *DebugContext) StencilOp(fail, zfail, zpass Enum) {
+	d.Context.StencilOp(fail, zfail, zpass)
+	d.check("StencilOp", fail, zfail, zpass)
+}
+
+func (d *DebugContext) StencilOpSeparate(face, fail, zfail, zpass Enum) {
+	d.Context.StencilOpSeparate(face, fail, zfail, zpass)
+	d.check("StencilOpSeparate", face, fail, zfail, zpass)
+}
+
+func (d *DebugContext) Clear(flags Enum) {
+	d.Context.Clear(flags)
+	d.check("Clear", flags)
+}
+
+func (d *DebugContext) ClearColor(r, g, b, a float32) {
+	d.Context.ClearColor(r, g, b, a)
+	d.check("ClearColor", r, g, b, a)
+}
+
+func (d *DebugContext) ClearDepth(depth float32) {
+	d.Context.ClearDepth(depth)
+	d.check("ClearDepth", depth)
+}
+
+func (d *DebugContext) ClearStencil(s int) {
+	d.Context.ClearStencil(s)
+	d.check("ClearStencil", s)
+}
+
+func (d *DebugContext) ColorMask(r, g, b, a bool) {
+	d.Context.ColorMask(r, g, b, a)
+	d.check("ColorMask", r, g, b, a)
+}
+
+func (d *DebugContext) DepthMask(flag bool) {
+	d.Context.DepthMask(flag)
+	d.check("DepthMask", flag)
+}
+
+func (d *DebugContext) StencilMask(mask int) {
+	d.Context.StencilMask(mask)
+	d.check("StencilMask", mask)
+}
+
+func (d *DebugContext) StencilMaskSeparate(face Enum, mask int) {
+	d.Context.StencilMaskSeparate(face, mask)
+	d.check("StencilMaskSeparate", face, mask)
+}
+
+func (d *DebugContext) BindFrameBuffer(fb *FrameBuffer) {
+	d.Context.BindFrameBuffer(fb)
+	d.check("BindFrameBuffer", fb)
+}
+
+func (d *DebugContext) CheckFramebufferStatus(target Enum) Enum {
+	r := d.Context.CheckFramebufferStatus(target)
+	d.check("CheckFramebufferStatus", target)
+	return r
+}
+
+func (d *DebugContext) CreateFrameBuffer() *FrameBuffer {
+	r := d.Context.CreateFrameBuffer()
+	d.check("CreateFrameBuffer")
+	return r
+}
+
+func (d *DebugContext) DeleteFrameBuffer(fb *FrameBuffer) {
+	d.Context.DeleteFrameBuffer(fb)
+	d.check("DeleteFrameBuffer", fb)
+}
+
+func (d *DebugContext) FrameBufferRenderBuffer(target, attachment Enum, rb *RenderBuffer) {
+	d.Context.FrameBufferRenderBuffer(target, attachment, rb)
+	d.check("FrameBufferRenderBuffer", target, attachment, rb)
+}
+
+func (d *DebugContext) FrameBufferTexture2D(target, attachment, texTarget Enum, t *Texture, level int) {
+	d.Context.FrameBufferTexture2D(target, attachment, texTarget, t, level)
+	d.check("FrameBufferTexture2D", target, attachment, texTarget, t, level)
+}
+
+func (d *DebugContext) IsFramebuffer(fb *FrameBuffer) bool {
+	r := d.Context.IsFramebuffer(fb)
+	d.check("IsFramebuffer", fb)
+	return r
+}
+
+func (d *DebugContext) BindBuffer(target Enum, buffer *Buffer) {
+	d.Context.BindBuffer(target, buffer)
+	d.check("BindBuffer", target, buffer)
+}
+
+func (d *DebugContext) BufferData(target Enum, data interface{}, usage Enum) {
+	d.Context.BufferData(target, data, usage)
+	d.check("BufferData", target, data, usage)
+}
+
+func (d *DebugContext) BufferSubData(target Enum, offset int, data interface{}) {
+	d.Context.BufferSubData(target, offset, data)
+	d.check("BufferSubData", target, offset, data)
+}
+
+func (d *DebugContext) CreateBuffer() *Buffer {
+	r := d.Context.CreateBuffer()
+	d.check("CreateBuffer")
+	return r
+}
+
+func (d *DebugContext) DeleteBuffer(buffer *Buffer) {
+	d.Context.DeleteBuffer(buffer)
+	d.check("DeleteBuffer", buffer)
+}
+
+func (d *DebugContext) GetBufferParameter(target, pname Enum) int {
+	r := d.Context.GetBufferParameter(target, pname)
+	d.check("GetBufferParameter", target, pname)
+	return r
+}
+
+func (d *DebugContext) IsBuffer(buffer *Buffer) bool {
+	r := d.Context.IsBuffer(buffer)
+	d.check("IsBuffer", buffer)
+	return r
+}
+
+func (d *DebugContext) DepthRange(zNear, zFar float32) {
+	d.Context.DepthRange(zNear, zFar)
+	d.check("DepthRange", zNear, zFar)
+}
+
+func (d *DebugContext) Scissor(x, y, width, height int) {
+	d.Context.Scissor(x, y, width, height)
+	d.check("Scissor", x, y, width, height)
+}
+
+func (d *DebugContext) Viewport(x, y, width, height int) {
+	d.Context.Viewport(x, y, width, height)
+	d.check("Viewport", x, y, width, height)
+}
+
+func (d *DebugContext) GetViewport() [4]int32 {
+	r := d.Context.GetViewport()
+	d.check("GetViewport")
+	return r
+}
+
+func (d *DebugContext) CullFace(mode Enum) {
+	d.Context.CullFace(mode)
+	d.check("CullFace", mode)
+}
+
+func (d *DebugContext) FrontFace(mode Enum) {
+	d.Context.FrontFace(mode)
+	d.check("FrontFace", mode)
+}
+
+func (d *DebugContext) LineWidth(width float32) {
+	d.Context.LineWidth(width)
+	d.check("LineWidth", width)
+}
+
+func (d *DebugContext) PolygonOffset(factor, units float32) {
+	d.Context.PolygonOffset(factor, units)
+	d.check("PolygonOffset", factor, units)
+}
+
+func (d *DebugContext) AttachShader(program *Program, shader *Shader) {
+	d.Context.AttachShader(program, shader)
+	d.check("AttachShader", program, shader)
+}
+
+func (d *DebugContext) BindAttribLocation(program *Program, index int, name string) {
+	d.Context.BindAttribLocation(program, index, name)
+	d.check("BindAttribLocation", program, index, name)
+}
+
+func (d *DebugContext) BuildProgram(vertexSrc, fragmentSrc string) (*Program, error) {
+	r, err := d.Context.BuildProgram(vertexSrc, fragmentSrc)
+	d.check("BuildProgram", vertexSrc, fragmentSrc)
+	return r, err
+}
+
+func (d *DebugContext) CompileShader(shader *Shader) {
+	d.Context.CompileShader(shader)
+	d.check("CompileShader", shader)
+}
+
+func (d *DebugContext) CompileShaderErr(shader *Shader) error {
+	r := d.Context.CompileShaderErr(shader)
+	d.check("CompileShaderErr", shader)
+	return r
+}
+
+func (d *DebugContext) CreateProgram() *Program {
+	r := d.Context.CreateProgram()
+	d.check("CreateProgram")
+	return r
+}
+
+func (d *DebugContext) CreateShader(typ Enum) *Shader {
+	r := d.Context.CreateShader(typ)
+	d.check("CreateShader", typ)
+	return r
+}
+
+func (d *DebugContext) DeleteProgram(program *Program) {
+	d.Context.DeleteProgram(program)
+	d.check("DeleteProgram", program)
+}
+
+func (d *DebugContext) DeleteShader(shader *Shader) {
+	d.Context.DeleteShader(shader)
+	d.check("DeleteShader", shader)
+}
+
+func (d *DebugContext) DetachShader(program *Program, shader *Shader) {
+	d.Context.DetachShader(program, shader)
+	d.check("DetachShader", program, shader)
+}
+
+func (d *DebugContext) GetAttachedShaders(program *Program) []*Shader {
+	r := d.Context.GetAttachedShaders(program)
+	d.check("GetAttachedShaders", program)
+	return r
+}
+
+func (d *DebugContext) GetProgramParameteri(program *Program, pname Enum) int {
+	r := d.Context.GetProgramParameteri(program, pname)
+	d.check("GetProgramParameteri", program, pname)
+	return r
+}
+
+func (d *DebugContext) GetProgramParameterb(program *Program, pname Enum) bool {
+	r := d.Context.GetProgramParameterb(program, pname)
+	d.check("GetProgramParameterb", program, pname)
+	return r
+}
+
+func (d *DebugContext) GetProgramInfoLog(program *Program) string {
+	r := d.Context.GetProgramInfoLog(program)
+	d.check("GetProgramInfoLog", program)
+	return r
+}
+
+func (d *DebugContext) GetShaderiv(shader *Shader, pname Enum) bool {
+	r := d.Context.GetShaderiv(shader, pname)
+	d.check("GetShaderiv", shader, pname)
+	return r
+}
+
+func (d *DebugContext) GetShaderParameteri(shader *Shader, pname Enum) int {
+	r := d.Context.GetShaderParameteri(shader, pname)
+	d.check("GetShaderParameteri", shader, pname)
+	return r
+}
+
+func (d *DebugContext) GetShaderInfoLog(shader *Shader) string {
+	r := d.Context.GetShaderInfoLog(shader)
+	d.check("GetShaderInfoLog", shader)
+	return r
+}
+
+func (d *DebugContext) GetShaderSource(shader *Shader) string {
+	r := d.Context.GetShaderSource(shader)
+	d.check("GetShaderSource", shader)
+	return r
+}
+
+func (d *DebugContext) IsProgram(program *Program) bool {
+	r := d.Context.IsProgram(program)
+	d.check("IsProgram", program)
+	return r
+}
+
+func (d *DebugContext) IsShader(shader *Shader) bool {
+	r := d.Context.IsShader(shader)
+	d.check("IsShader", shader)
+	return r
+}
+
+func (d *DebugContext) LinkProgram(program *Program) {
+	d.Context.LinkProgram(program)
+	d.check("LinkProgram", program)
+}
+
+func (d *DebugContext) LinkProgramErr(program *Program) error {
+	r := d.Context.LinkProgramErr(program)
+	d.check("LinkProgramErr", program)
+	return r
+}
+
+func (d *DebugContext) ShaderSource(shader *Shader, source string) {
+	d.Context.ShaderSource(shader, source)
+	d.check("ShaderSource", shader, source)
+}
+
+func (d *DebugContext) UseProgram(program *Program) {
+	d.Context.UseProgram(program)
+	d.check("UseProgram", program)
+}
+
+func (d *DebugContext) ValidateProgram(program *Program) {
+	d.Context.ValidateProgram(program)
+	d.check("ValidateProgram", program)
+}
+
+func (d *DebugContext) ActiveTexture(target Enum) {
+	d.Context.ActiveTexture(target)
+	d.check("ActiveTexture", target)
+}
+
+func (d *DebugContext) BindTexture(target Enum, texture *Texture) {
+	d.Context.BindTexture(target, texture)
+	d.check("BindTexture", target, texture)
+}
+
+func (d *DebugContext) CopyTexImage2D(target Enum, level int, internal Enum, x, y, w, h, border int) {
+	d.Context.CopyTexImage2D(target, level, internal, x, y, w, h, border)
+	d.check("CopyTexImage2D", target, level, internal, x, y, w, h, border)
+}
+
+func (d *DebugContext) CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, w, h int) {
+	d.Context.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, w, h)
+	d.check("CopyTexSubImage2D", target, level, xoffset, yoffset, x, y, w, h)
+}
+
+func (d *DebugContext) CreateTexture() *Texture {
+	r := d.Context.CreateTexture()
+	d.check("CreateTexture")
+	return r
+}
+
+func (d *DebugContext) DeleteTexture(texture *Texture) {
+	d.Context.DeleteTexture(texture)
+	d.check("DeleteTexture", texture)
+}
+
+func (d *DebugContext) GenerateMipmap(target Enum) {
+	d.Context.GenerateMipmap(target)
+	d.check("GenerateMipmap", target)
+}
+
+func (d *DebugContext) IsTexture(texture *Texture) bool {
+	r := d.Context.IsTexture(texture)
+	d.check("IsTexture", texture)
+	return r
+}
+
+func (d *DebugContext) TexImage2D(target Enum, level int, internalFormat, format, kind Enum, data interface{}) error {
+	r := d.Context.TexImage2D(target, level, internalFormat, format, kind, data)
+	d.check("TexImage2D", target, level, internalFormat, format, kind, data)
+	return r
+}
+
+func (d *DebugContext) TexImage2DEmpty(target Enum, level int, internalFormat, format, kind Enum, width, height int) {
+	d.Context.TexImage2DEmpty(target, level, internalFormat, format, kind, width, height)
+	d.check("TexImage2DEmpty", target, level, internalFormat, format, kind, width, height)
+}
+
+func (d *DebugContext) TexParameteri(target, pname, param Enum) {
+	d.Context.TexParameteri(target, pname, param)
+	d.check("TexParameteri", target, pname, param)
+}
+
+func (d *DebugContext) TexSubImage2D(target Enum, level, xoffset, yoffset, width, height int, format, kind Enum, data interface{}) error {
+	r := d.Context.TexSubImage2D(target, level, xoffset, yoffset, width, height, format, kind, data)
+	d.check("TexSubImage2D", target, level, xoffset, yoffset, width, height, format, kind, data)
+	return r
+}
+
+func (d *DebugContext) Disable(cap Enum) {
+	d.Context.Disable(cap)
+	d.check("Disable", cap)
+}
+
+func (d *DebugContext) Enable(cap Enum) {
+	d.Context.Enable(cap)
+	d.check("Enable", cap)
+}
+
+func (d *DebugContext) Finish() {
+	d.Context.Finish()
+	d.check("Finish")
+}
+
+func (d *DebugContext) Flush() {
+	d.Context.Flush()
+	d.check("Flush")
+}
+
+func (d *DebugContext) IsContextLost() bool {
+	r := d.Context.IsContextLost()
+	d.check("IsContextLost")
+	return r
+}
+
+func (d *DebugContext) IsEnabled(cap Enum) bool {
+	r := d.Context.IsEnabled(cap)
+	d.check("IsEnabled", cap)
+	return r
+}
+
+func (d *DebugContext) PixelStorei(pname Enum, param int) {
+	d.Context.PixelStorei(pname, param)
+	d.check("PixelStorei", pname, param)
+}
+
+func (d *DebugContext) Capabilities() Capabilities {
+	r := d.Context.Capabilities()
+	d.check("Capabilities")
+	return r
+}
+
+func (d *DebugContext) GetParameterBool(pname Enum) bool {
+	r := d.Context.GetParameterBool(pname)
+	d.check("GetParameterBool", pname)
+	return r
+}
+
+func (d *DebugContext) GetParameterBools(pname Enum) []bool {
+	r := d.Context.GetParameterBools(pname)
+	d.check("GetParameterBools", pname)
+	return r
+}
+
+func (d *DebugContext) GetParameterFloat(pname Enum) float32 {
+	r := d.Context.GetParameterFloat(pname)
+	d.check("GetParameterFloat", pname)
+	return r
+}
+
+func (d *DebugContext) GetParameterFloats(pname Enum) []float32 {
+	r := d.Context.GetParameterFloats(pname)
+	d.check("GetParameterFloats", pname)
+	return r
+}
+
+func (d *DebugContext) GetParameterInt(pname Enum) int {
+	r := d.Context.GetParameterInt(pname)
+	d.check("GetParameterInt", pname)
+	return r
+}
+
+func (d *DebugContext) GetParameterInts(pname Enum) []int32 {
+	r := d.Context.GetParameterInts(pname)
+	d.check("GetParameterInts", pname)
+	return r
+}
+
+func (d *DebugContext) GetParameterString(pname Enum) string {
+	r := d.Context.GetParameterString(pname)
+	d.check("GetParameterString", pname)
+	return r
+}
+
+func (d *DebugContext) Extensions() []string {
+	r := d.Context.Extensions()
+	d.check("Extensions")
+	return r
+}
+
+func (d *DebugContext) GetSupportedExtensions() []string {
+	r := d.Context.GetSupportedExtensions()
+	d.check("GetSupportedExtensions")
+	return r
+}
+
+func (d *DebugContext) HasExtension(name string) bool {
+	r := d.Context.HasExtension(name)
+	d.check("HasExtension", name)
+	return r
+}
+
+func (d *DebugContext) DisableVertexAttribArray(index int) {
+	d.Context.DisableVertexAttribArray(index)
+	d.check("DisableVertexAttribArray", index)
+}
+
+func (d *DebugContext) EnableVertexAttribArray(index int) {
+	d.Context.EnableVertexAttribArray(index)
+	d.check("EnableVertexAttribArray", index)
+}
+
+func (d *DebugContext) GetActiveAttrib(program *Program, index int) (name string, size int, typ Enum) {
+	name, size, typ = d.Context.GetActiveAttrib(program, index)
+	d.check("GetActiveAttrib", program, index)
+	return name, size, typ
+}
+
+func (d *DebugContext) GetActiveUniform(program *Program, index int) (name string, size int, typ Enum) {
+	name, size, typ = d.Context.GetActiveUniform(program, index)
+	d.check("GetActiveUniform", program, index)
+	return name, size, typ
+}
+
+func (d *DebugContext) GetAttribLocation(program *Program, name string) int {
+	r := d.Context.GetAttribLocation(program, name)
+	d.check("GetAttribLocation", program, name)
+	return r
+}
+
+func (d *DebugContext) GetUniformLocation(program *Program, name string) *UniformLocation {
+	r := d.Context.GetUniformLocation(program, name)
+	d.check("GetUniformLocation", program, name)
+	return r
+}
+
+func (d *DebugContext) Uniform1f(location *UniformLocation, x float32) {
+	d.Context.Uniform1f(location, x)
+	d.check("Uniform1f", location, x)
+}
+
+func (d *DebugContext) Uniform1i(location *UniformLocation, x int) {
+	d.Context.Uniform1i(location, x)
+	d.check("Uniform1i", location, x)
+}
+
+func (d *DebugContext) Uniform1iTexture(location *UniformLocation, tex *Texture) {
+	d.Context.Uniform1iTexture(location, tex)
+	d.check("Uniform1iTexture", location, tex)
+}
+
+func (d *DebugContext) Uniform2f(location *UniformLocation, x, y float32) {
+	d.Context.Uniform2f(location, x, y)
+	d.check("Uniform2f", location, x, y)
+}
+
+func (d *DebugContext) Uniform2i(location *UniformLocation, x, y int) {
+	d.Context.Uniform2i(location, x, y)
+	d.check("Uniform2i", location, x, y)
+}
+
+func (d *DebugContext) Uniform3f(location *UniformLocation, x, y, z float32) {
+	d.Context.Uniform3f(location, x, y, z)
+	d.check("Uniform3f", location, x, y, z)
+}
+
+func (d *DebugContext) Uniform3i(location *UniformLocation, x, y, z int) {
+	d.Context.Uniform3i(location, x, y, z)
+	d.check("Uniform3i", location, x, y, z)
+}
+
+func (d *DebugContext) Uniform4f(location *UniformLocation, x, y, z, w float32) {
+	d.Context.Uniform4f(location, x, y, z, w)
+	d.check("Uniform4f", location, x, y, z, w)
+}
+
+func (d *DebugContext) Uniform4i(location *UniformLocation, x, y, z, w int) {
+	d.Context.Uniform4i(location, x, y, z, w)
+	d.check("Uniform4i", location, x, y, z, w)
+}
+
+func (d *DebugContext) Uniform1fv(location *UniformLocation, value []float32) {
+	d.Context.Uniform1fv(location, value)
+	d.check("Uniform1fv", location, value)
+}
+
+func (d *DebugContext) Uniform1iv(location *UniformLocation, value []int32) {
+	d.Context.Uniform1iv(location, value)
+	d.check("Uniform1iv", location, value)
+}
+
+func (d *DebugContext) Uniform2fv(location *UniformLocation, value []float32) {
+	d.Context.Uniform2fv(location, value)
+	d.check("Uniform2fv", location, value)
+}
+
+func (d *DebugContext) Uniform2iv(location *UniformLocation, value []int32) {
+	d.Context.Uniform2iv(location, value)
+	d.check("Uniform2iv", location, value)
+}
+
+func (d *DebugContext) Uniform3fv(location *UniformLocation, value []float32) {
+	d.Context.Uniform3fv(location, value)
+	d.check("Uniform3fv", location, value)
+}
+
+func (d *DebugContext) Uniform3iv(location *UniformLocation, value []int32) {
+	d.Context.Uniform3iv(location, value)
+	d.check("Uniform3iv", location, value)
+}
+
+func (d *DebugContext) Uniform4fv(location *UniformLocation, value []float32) {
+	d.Context.Uniform4fv(location, value)
+	d.check("Uniform4fv", location, value)
+}
+
+func (d *DebugContext) Uniform4iv(location *UniformLocation, value []int32) {
+	d.Context.Uniform4iv(location, value)
+	d.check("Uniform4iv", location, value)
+}
+
+func (d *DebugContext) UniformMatrix2fv(location *UniformLocation, transpose bool, value []float32) {
+	d.Context.UniformMatrix2fv(location, transpose, value)
+	d.check("UniformMatrix2fv", location, transpose, value)
+}
+
+func (d *DebugContext) UniformMatrix3fv(location *UniformLocation, transpose bool, value []float32) {
+	d.Context.UniformMatrix3fv(location, transpose, value)
+	d.check("UniformMatrix3fv", location, transpose, value)
+}
+
+func (d *DebugContext) UniformMatrix4fv(location *UniformLocation, transpose bool, value []float32) {
+	d.Context.UniformMatrix4fv(location, transpose, value)
+	d.check("UniformMatrix4fv", location, transpose, value)
+}
+
+func (d *DebugContext) VertexAttribPointer(index, size int, typ Enum, normal bool, stride, offset int) {
+	d.Context.VertexAttribPointer(index, size, typ, normal, stride, offset)
+	d.check("VertexAttribPointer", index, size, typ, normal, stride, offset)
+}
+
+func (d *DebugContext) VertexAttrib1f(index int, x float32) {
+	d.Context.VertexAttrib1f(index, x)
+	d.check("VertexAttrib1f", index, x)
+}
+
+func (d *DebugContext) VertexAttrib2f(index int, x, y float32) {
+	d.Context.VertexAttrib2f(index, x, y)
+	d.check("VertexAttrib2f", index, x, y)
+}
+
+func (d *DebugContext) VertexAttrib3f(index int, x, y, z float32) {
+	d.Context.VertexAttrib3f(index, x, y, z)
+	d.check("VertexAttrib3f", index, x, y, z)
+}
+
+func (d *DebugContext) VertexAttrib4f(index int, x, y, z, w float32) {
+	d.Context.VertexAttrib4f(index, x, y, z, w)
+	d.check("VertexAttrib4f", index, x, y, z, w)
+}
+
+func (d *DebugContext) VertexAttrib1fv(index int, value []float32) {
+	d.Context.VertexAttrib1fv(index, value)
+	d.check("VertexAttrib1fv", index, value)
+}
+
+func (d *DebugContext) VertexAttrib2fv(index int, value []float32) {
+	d.Context.VertexAttrib2fv(index, value)
+	d.check("VertexAttrib2fv", index, value)
+}
+
+func (d *DebugContext) VertexAttrib3fv(index int, value []float32) {
+	d.Context.VertexAttrib3fv(index, value)
+	d.check("VertexAttrib3fv", index, value)
+}
+
+func (d *DebugContext) VertexAttrib4fv(index int, value []float32) {
+	d.Context.VertexAttrib4fv(index, value)
+	d.check("VertexAttrib4fv", index, value)
+}
+
+func (d *DebugContext) GetVertexAttribi(index int, pname Enum) int {
+	r := d.Context.GetVertexAttribi(index, pname)
+	d.check("GetVertexAttribi", index, pname)
+	return r
+}
+
+func (d *DebugContext) GetVertexAttribfv(index int, pname Enum) []float32 {
+	r := d.Context.GetVertexAttribfv(index, pname)
+	d.check("GetVertexAttribfv", index, pname)
+	return r
+}
+
+func (d *DebugContext) BindRenderBuffer(rb *RenderBuffer) {
+	d.Context.BindRenderBuffer(rb)
+	d.check("BindRenderBuffer", rb)
+}
+
+func (d *DebugContext) CreateRenderBuffer() *RenderBuffer {
+	r := d.Context.CreateRenderBuffer()
+	d.check("CreateRenderBuffer")
+	return r
+}
+
+func (d *DebugContext) DeleteRenderBuffer(rb *RenderBuffer) {
+	d.Context.DeleteRenderBuffer(rb)
+	d.check("DeleteRenderBuffer", rb)
+}
+
+func (d *DebugContext) GetRenderbufferParameter(target, pname Enum) int {
+	r := d.Context.GetRenderbufferParameter(target, pname)
+	d.check("GetRenderbufferParameter", target, pname)
+	return r
+}
+
+func (d *DebugContext) IsRenderbuffer(rb *RenderBuffer) bool {
+	r := d.Context.IsRenderbuffer(rb)
+	d.check("IsRenderbuffer", rb)
+	return r
+}
+
+func (d *DebugContext) RenderBufferStorage(internalFormat Enum, width, height int) {
+	d.Context.RenderBufferStorage(internalFormat, width, height)
+	d.check("RenderBufferStorage", internalFormat, width, height)
+}
+
+func (d *DebugContext) DrawArrays(mode Enum, first, count int) {
+	d.Context.DrawArrays(mode, first, count)
+	d.check("DrawArrays", mode, first, count)
+}
+
+func (d *DebugContext) DrawElements(mode Enum, count int, typ Enum, offset int) {
+	d.Context.DrawElements(mode, count, typ, offset)
+	d.check("DrawElements", mode, count, typ, offset)
+}
+
+func (d *DebugContext) ReadPixels(x, y, width, height int) (*image.RGBA, error) {
+	r, err := d.Context.ReadPixels(x, y, width, height)
+	d.check("ReadPixels", x, y, width, height)
+	return r, err
+}
+
+func (d *DebugContext) ReadPixelsInto(x, y, width, height int, pixels []byte) error {
+	r := d.Context.ReadPixelsInto(x, y, width, height, pixels)
+	d.check("ReadPixelsInto", x, y, width, height, pixels)
+	return r
+}
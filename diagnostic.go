@@ -0,0 +1,132 @@
+package gl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// Diagnostic is a single error or warning from a shader info log.
+type Diagnostic struct {
+	// Line and Column are 1-based, and 0 when the log doesn't give them.
+	Line   int
+	Column int
+
+	// Severity is the lower case severity reported by the driver, usually
+	// "error" or "warning".
+	Severity string
+	Message  string
+}
+
+func (d Diagnostic) String() string {
+	switch {
+	case d.Line > 0 && d.Column > 0:
+		return fmt.Sprintf("%d:%d: %s: %s", d.Line, d.Column, d.Severity, d.Message)
+	case d.Line > 0:
+		return fmt.Sprintf("%d: %s: %s", d.Line, d.Severity, d.Message)
+	}
+	return d.Severity + ": " + d.Message
+}
+
+var (
+	// Mesa: 0:12(5): error: message
+	mesaDiagnostic = regexp.MustCompile(`^\d+:(\d+)\((\d+)\):\s*(\w+):\s*(.*)$`)
+	// NVIDIA: 0(12) : error C1008: message
+	nvidiaDiagnostic = regexp.MustCompile(`^\d+\((\d+)\)\s*:\s*(\w+)\s+(.*)$`)
+	// ANGLE, WebGL and most GLES drivers: ERROR: 0:12: message
+	angleDiagnostic = regexp.MustCompile(`^(?i:(error|warning)):\s*\d+:(\d+):\s*(.*)$`)
+)
+
+// ParseDiagnostics parses a shader info log in the Mesa, NVIDIA or ANGLE
+// format. Lines that aren't in any of those formats, such as summaries, are
+// skipped; if no line could be parsed the whole log is returned as a single
+// error without a position.
+func ParseDiagnostics(log string) []Diagnostic {
+	var diags []Diagnostic
+	for _, line := range strings.Split(log, "\n") {
+		line = strings.TrimSpace(strings.TrimRight(line, "\x00"))
+		if m := mesaDiagnostic.FindStringSubmatch(line); m != nil {
+			diags = append(diags, Diagnostic{
+				Line:     atoi(m[1]),
+				Column:   atoi(m[2]),
+				Severity: strings.ToLower(m[3]),
+				Message:  m[4],
+			})
+		} else if m := nvidiaDiagnostic.FindStringSubmatch(line); m != nil {
+			diags = append(diags, Diagnostic{
+				Line:     atoi(m[1]),
+				Severity: strings.ToLower(m[2]),
+				Message:  m[3],
+			})
+		} else if m := angleDiagnostic.FindStringSubmatch(line); m != nil {
+			diags = append(diags, Diagnostic{
+				Line:     atoi(m[2]),
+				Severity: strings.ToLower(m[1]),
+				Message:  m[3],
+			})
+		}
+	}
+
+	if log = strings.TrimSpace(strings.TrimRight(log, "\x00")); len(diags) == 0 && log != "" {
+		diags = append(diags, Diagnostic{Severity: "error", Message: log})
+	}
+	return diags
+}
+
+func atoi(s string) int {
+	n, _ := strconv.Atoi(s)
+	return n
+}
+
+// SourceContext renders the lines of source each diagnostic points at, with
+// up to context lines around them. Lines are numbered, the offending line is
+// marked with '>' and followed by the diagnostic, with a caret under its
+// column when known. Diagnostics without a valid line are skipped.
+func SourceContext(source string, diagnostics []Diagnostic, context int) string {
+	lines := strings.Split(source, "\n")
+	width := len(strconv.Itoa(len(lines)))
+
+	var b strings.Builder
+	for _, d := range diagnostics {
+		if d.Line <= 0 || d.Line > len(lines) {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+
+		first, last := d.Line-context, d.Line+context
+		if first < 1 {
+			first = 1
+		}
+		if last > len(lines) {
+			last = len(lines)
+		}
+		for n := first; n <= last; n++ {
+			mark := " "
+			if n == d.Line {
+				mark = ">"
+			}
+			fmt.Fprintf(&b, "%s %*d | %s\n", mark, width, n, lines[n-1])
+			if n != d.Line {
+				continue
+			}
+
+			fmt.Fprintf(&b, "  %*s | ", width, "")
+			if col := d.Column - 1; col >= 0 && col <= len(lines[n-1]) {
+				// Keep tabs so the caret lines up with the source.
+				for _, r := range lines[n-1][:col] {
+					if r == '\t' {
+						b.WriteRune('\t')
+					} else {
+						b.WriteRune(' ')
+					}
+				}
+				b.WriteString("^ ")
+			}
+			fmt.Fprintf(&b, "%s: %s\n", d.Severity, d.Message)
+		}
+	}
+	return b.String()
+}
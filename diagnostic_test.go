@@ -0,0 +1,110 @@
+package gl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseDiagnostics(t *testing.T) {
+	for _, test := range []struct {
+		name string
+		log  string
+		want []Diagnostic
+	}{
+		{
+			name: "mesa",
+			log:  "0:12(5): error: `foo' undeclared\n0:14(1): warning: unused variable\n",
+			want: []Diagnostic{
+				{Line: 12, Column: 5, Severity: "error", Message: "`foo' undeclared"},
+				{Line: 14, Column: 1, Severity: "warning", Message: "unused variable"},
+			},
+		},
+		{
+			name: "nvidia",
+			log:  "0(12) : error C1008: undefined variable \"foo\"\n",
+			want: []Diagnostic{
+				{Line: 12, Severity: "error", Message: "C1008: undefined variable \"foo\""},
+			},
+		},
+		{
+			name: "angle",
+			log:  "ERROR: 0:12: 'foo' : undeclared identifier\nERROR: 1 compilation errors.  No code generated.\n\x00",
+			want: []Diagnostic{
+				{Line: 12, Severity: "error", Message: "'foo' : undeclared identifier"},
+			},
+		},
+		{
+			name: "apple",
+			log:  "WARNING: 0:3: Overflow in implicit constant conversion\nERROR: 0:12: Use of undeclared identifier 'foo'\n",
+			want: []Diagnostic{
+				{Line: 3, Severity: "warning", Message: "Overflow in implicit constant conversion"},
+				{Line: 12, Severity: "error", Message: "Use of undeclared identifier 'foo'"},
+			},
+		},
+		{
+			name: "unknown",
+			log:  "compilation failed\n",
+			want: []Diagnostic{
+				{Severity: "error", Message: "compilation failed"},
+			},
+		},
+		{
+			name: "empty",
+			log:  "\x00",
+		},
+	} {
+		if got := ParseDiagnostics(test.log); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: ParseDiagnostics(%q) = %#v, want %#v", test.name, test.log, got, test.want)
+		}
+	}
+}
+
+func TestSourceContext(t *testing.T) {
+	source := "void main() {\n\tfoo = 1;\n\tgl_FragColor = vec4(1);\n}"
+	for _, test := range []struct {
+		name        string
+		diagnostics []Diagnostic
+		context     int
+		want        string
+	}{
+		{
+			name:        "column",
+			diagnostics: []Diagnostic{{Line: 2, Column: 2, Severity: "error", Message: "undeclared"}},
+			context:     1,
+			want: "  1 | void main() {\n" +
+				"> 2 | \tfoo = 1;\n" +
+				"    | \t^ error: undeclared\n" +
+				"  3 | \tgl_FragColor = vec4(1);\n",
+		},
+		{
+			name:        "no column",
+			diagnostics: []Diagnostic{{Line: 4, Severity: "warning", Message: "end"}},
+			context:     0,
+			want: "> 4 | }\n" +
+				"    | warning: end\n",
+		},
+		{
+			name: "several",
+			diagnostics: []Diagnostic{
+				{Line: 1, Column: 6, Severity: "error", Message: "a"},
+				{Line: 3, Severity: "error", Message: "b"},
+			},
+			context: 0,
+			want: "> 1 | void main() {\n" +
+				"    |      ^ error: a\n" +
+				"\n" +
+				"> 3 | \tgl_FragColor = vec4(1);\n" +
+				"    | error: b\n",
+		},
+		{
+			name:        "out of range",
+			diagnostics: []Diagnostic{{Line: 0, Message: "x"}, {Line: 5, Message: "y"}},
+			context:     2,
+			want:        "",
+		},
+	} {
+		if got := SourceContext(source, test.diagnostics, test.context); got != test.want {
+			t.Errorf("%s: SourceContext returned\n%s\nwant\n%s", test.name, got, test.want)
+		}
+	}
+}
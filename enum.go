@@ -0,0 +1,113 @@
+package gl
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+)
+
+// enumNames maps the values of the Context constants to their names. The
+// values are the same for every Context of a backend, so the table is built
+// once, from the first Context created.
+var enumNames struct {
+	once  sync.Once
+	table atomic.Value // map[Enum]string
+}
+
+// EnumName returns the name of the Context constant with the given value,
+// e.g. "COLOR_ATTACHMENT0", or its hexadecimal value if there is none.
+//
+// Constants that share a value are ambiguous; the one declared first in the
+// Context is returned. Constants whose value is 0, such as ZERO, NONE and
+// those unsupported by the backend, are never named.
+func (c *Context) EnumName(value Enum) string {
+	c.loadEnumNames()
+	return enumName(value)
+}
+
+// loadEnumNames builds the enumNames table from c, unless a Context already
+// did.
+func (c *Context) loadEnumNames() {
+	enumNames.once.Do(func() {
+		enumNames.table.Store(c.enumTable())
+	})
+}
+
+// enumName returns the name of value in the enumNames table, or its
+// hexadecimal value if it has none or no Context was created yet.
+func enumName(value Enum) string {
+	if names, ok := enumNames.table.Load().(map[Enum]string); ok {
+		if name, ok := names[value]; ok {
+			return name
+		}
+	}
+	return fmt.Sprintf("0x%X", int(value))
+}
+
+// ErrorName returns the name of an error code returned by GetError, e.g.
+// "INVALID_OPERATION".
+func (c *Context) ErrorName(code Enum) string {
+	switch code {
+	case c.NO_ERROR:
+		return "NO_ERROR"
+	case c.INVALID_ENUM:
+		return "INVALID_ENUM"
+	case c.INVALID_VALUE:
+		return "INVALID_VALUE"
+	case c.INVALID_OPERATION:
+		return "INVALID_OPERATION"
+	case c.INVALID_FRAMEBUFFER_OPERATION:
+		return "INVALID_FRAMEBUFFER_OPERATION"
+	case c.OUT_OF_MEMORY:
+		return "OUT_OF_MEMORY"
+	case c.CONTEXT_LOST_WEBGL:
+		return "CONTEXT_LOST_WEBGL"
+	}
+	return fmt.Sprintf("0x%X", int(code))
+}
+
+// zeroConstants are the constants whose value is 0 in OpenGL itself, so a
+// zero value doesn't mean they are unsupported.
+var zeroConstants = map[string]bool{
+	"FALSE":    true,
+	"NONE":     true,
+	"NO_ERROR": true,
+	"POINTS":   true,
+	"ZERO":     true,
+}
+
+// Supported reports whether the Context constant with the given name, e.g.
+// "CLAMP_TO_BORDER", exists and has a value on this backend. Backends leave
+// the constants they don't support at 0.
+func (c *Context) Supported(constantName string) bool {
+	supported := false
+	c.Constants.each(func(name string, value Enum) {
+		if name == constantName {
+			supported = value != 0 || zeroConstants[name]
+		}
+	})
+	return supported
+}
+
+// UnsupportedConstants returns the names of the Context constants this
+// backend doesn't support, in declaration order.
+func (c *Context) UnsupportedConstants() []string {
+	var names []string
+	c.Constants.each(func(name string, value Enum) {
+		if value == 0 && !zeroConstants[name] {
+			names = append(names, name)
+		}
+	})
+	return names
+}
+
+// enumTable collects the constant fields of c by value.
+func (c *Context) enumTable() map[Enum]string {
+	names := make(map[Enum]string)
+	c.Constants.each(func(name string, value Enum) {
+		if _, ok := names[value]; value != 0 && !ok {
+			names[value] = name
+		}
+	})
+	return names
+}
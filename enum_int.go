@@ -0,0 +1,14 @@
+//go:build !gl_typedenums
+// +build !gl_typedenums
+
+package gl
+
+// Enum is the type of the Context constants and of the method parameters
+// that take one of them.
+//
+// By default Enum is an alias of int, so code written against the int API
+// keeps building. Build with the gl_typedenums tag to make it a distinct type
+// with a String method, which lets the compiler catch constants passed where
+// an ordinary int is expected. Enum will become a distinct type by default in
+// a future version.
+type Enum = int
@@ -0,0 +1,14 @@
+//go:build gl_typedenums
+// +build gl_typedenums
+
+package gl
+
+// Enum is the type of the Context constants and of the method parameters
+// that take one of them. Without the gl_typedenums build tag, Enum is an
+// alias of int instead.
+type Enum int
+
+// String returns the name of the Context constant e, see Context.EnumName.
+func (e Enum) String() string {
+	return enumName(e)
+}
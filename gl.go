@@ -4,6 +4,17 @@
 
 package gl
 
+//go:generate go run ./internal/cmd/glgen
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"strings"
+)
+
 /*
 Objects:
 
@@ -163,3 +174,383 @@ ReadPixels:
 void readPixels(int x, int y, long width, long height, enum format, enum type, Object pixels)
 
 */
+
+// Renderer is the set of methods every backend's Context implements with the
+// same signature. Engine code written against Renderer builds unchanged on the
+// desktop, mobile, WebGL, softgl and nogl targets; each backend asserts at
+// compile time that its *Context satisfies it.
+//
+// Backends may expose additional methods (for example the WebGL-named
+// CreateFramebuffer or the fixed-function MatrixMode on desktop), but those
+// are platform specific and not part of this interface.
+type Renderer interface {
+	// PerFragment
+	BlendColor(r, g, b, a float32)
+	BlendEquation(mode Enum)
+	BlendEquationSeparate(modeRGB, modeAlpha Enum)
+	BlendFunc(sfactor, dfactor Enum)
+	BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha Enum)
+	DepthFunc(fun Enum)
+	SampleCoverage(value float32, invert bool)
+	StencilFunc(function Enum, ref, mask int)
+	StencilFuncSeparate(face, function Enum, ref, mask int)
+	StencilOp(fail, zfail, zpass Enum)
+	StencilOpSeparate(face, fail, zfail, zpass Enum)
+
+	// FrameBuffer
+	Clear(flags Enum)
+	ClearColor(r, g, b, a float32)
+	ClearDepth(depth float32)
+	ClearStencil(s int)
+	ColorMask(r, g, b, a bool)
+	DepthMask(flag bool)
+	StencilMask(mask int)
+	StencilMaskSeparate(face Enum, mask int)
+	BindFrameBuffer(fb *FrameBuffer)
+	CheckFramebufferStatus(target Enum) Enum
+	CreateFrameBuffer() *FrameBuffer
+	DeleteFrameBuffer(fb *FrameBuffer)
+	FrameBufferRenderBuffer(target, attachment Enum, rb *RenderBuffer)
+	FrameBufferTexture2D(target, attachment, texTarget Enum, t *Texture, level int)
+	IsFramebuffer(fb *FrameBuffer) bool
+
+	// Buffer
+	BindBuffer(target Enum, buffer *Buffer)
+	BufferData(target Enum, data interface{}, usage Enum)
+	BufferSubData(target Enum, offset int, data interface{})
+	CreateBuffer() *Buffer
+	DeleteBuffer(buffer *Buffer)
+	GetBufferParameter(target, pname Enum) int
+	IsBuffer(buffer *Buffer) bool
+
+	// View
+	DepthRange(zNear, zFar float32)
+	Scissor(x, y, width, height int)
+	Viewport(x, y, width, height int)
+	GetViewport() [4]int32
+
+	// Rasterization
+	CullFace(mode Enum)
+	FrontFace(mode Enum)
+	LineWidth(width float32)
+	PolygonOffset(factor, units float32)
+
+	// Shaders
+	AttachShader(program *Program, shader *Shader)
+	BindAttribLocation(program *Program, index int, name string)
+	BuildProgram(vertexSrc, fragmentSrc string) (*Program, error)
+	CompileShader(shader *Shader)
+	CompileShaderErr(shader *Shader) error
+	CreateProgram() *Program
+	CreateShader(typ Enum) *Shader
+	DeleteProgram(program *Program)
+	DeleteShader(shader *Shader)
+	DetachShader(program *Program, shader *Shader)
+	GetAttachedShaders(program *Program) []*Shader
+	GetProgramParameteri(program *Program, pname Enum) int
+	GetProgramParameterb(program *Program, pname Enum) bool
+	GetProgramInfoLog(program *Program) string
+	GetShaderiv(shader *Shader, pname Enum) bool
+	GetShaderParameteri(shader *Shader, pname Enum) int
+	GetShaderInfoLog(shader *Shader) string
+	GetShaderSource(shader *Shader) string
+	IsProgram(program *Program) bool
+	IsShader(shader *Shader) bool
+	LinkProgram(program *Program)
+	LinkProgramErr(program *Program) error
+	ShaderSource(shader *Shader, source string)
+	UseProgram(program *Program)
+	ValidateProgram(program *Program)
+
+	// Textures
+	ActiveTexture(target Enum)
+	BindTexture(target Enum, texture *Texture)
+	CopyTexImage2D(target Enum, level int, internal Enum, x, y, w, h, border int)
+	CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, w, h int)
+	CreateTexture() *Texture
+	DeleteTexture(texture *Texture)
+	GenerateMipmap(target Enum)
+	IsTexture(texture *Texture) bool
+	TexImage2D(target Enum, level int, internalFormat, format, kind Enum, data interface{}) error
+	TexImage2DEmpty(target Enum, level int, internalFormat, format, kind Enum, width, height int)
+	TexParameteri(target, pname, param Enum)
+	TexSubImage2D(target Enum, level, xoffset, yoffset, width, height int, format, kind Enum, data interface{}) error
+
+	// Special
+	Disable(cap Enum)
+	Enable(cap Enum)
+	Finish()
+	Flush()
+	GetError() Enum
+	IsContextLost() bool
+	IsEnabled(cap Enum) bool
+	PixelStorei(pname Enum, param int)
+	EnumName(value Enum) string
+	ErrorName(code Enum) string
+	Supported(constantName string) bool
+	UnsupportedConstants() []string
+
+	// State
+	Capabilities() Capabilities
+	GetParameterBool(pname Enum) bool
+	GetParameterBools(pname Enum) []bool
+	GetParameterFloat(pname Enum) float32
+	GetParameterFloats(pname Enum) []float32
+
+	// GetParameterInt and GetParameterInts return object bindings such as
+	// CURRENT_PROGRAM and TEXTURE_BINDING_2D as the name of the bound
+	// object, except on WebGL, whose objects have no names and which
+	// returns 1 when an object is bound. Only comparing such values with 0
+	// is portable.
+	GetParameterInt(pname Enum) int
+	GetParameterInts(pname Enum) []int32
+	GetParameterString(pname Enum) string
+	Extensions() []string
+	GetSupportedExtensions() []string
+	HasExtension(name string) bool
+
+	// Uniforms and Attributes
+	DisableVertexAttribArray(index int)
+	EnableVertexAttribArray(index int)
+	GetActiveAttrib(program *Program, index int) (name string, size int, typ Enum)
+	GetActiveUniform(program *Program, index int) (name string, size int, typ Enum)
+	GetAttribLocation(program *Program, name string) int
+	GetUniformLocation(program *Program, name string) *UniformLocation
+	Uniform1f(location *UniformLocation, x float32)
+	Uniform1i(location *UniformLocation, x int)
+	Uniform1iTexture(location *UniformLocation, tex *Texture)
+	Uniform2f(location *UniformLocation, x, y float32)
+	Uniform2i(location *UniformLocation, x, y int)
+	Uniform3f(location *UniformLocation, x, y, z float32)
+	Uniform3i(location *UniformLocation, x, y, z int)
+	Uniform4f(location *UniformLocation, x, y, z, w float32)
+	Uniform4i(location *UniformLocation, x, y, z, w int)
+	Uniform1fv(location *UniformLocation, value []float32)
+	Uniform1iv(location *UniformLocation, value []int32)
+	Uniform2fv(location *UniformLocation, value []float32)
+	Uniform2iv(location *UniformLocation, value []int32)
+	Uniform3fv(location *UniformLocation, value []float32)
+	Uniform3iv(location *UniformLocation, value []int32)
+	Uniform4fv(location *UniformLocation, value []float32)
+	Uniform4iv(location *UniformLocation, value []int32)
+	UniformMatrix2fv(location *UniformLocation, transpose bool, value []float32)
+	UniformMatrix3fv(location *UniformLocation, transpose bool, value []float32)
+	UniformMatrix4fv(location *UniformLocation, transpose bool, value []float32)
+	VertexAttribPointer(index, size int, typ Enum, normal bool, stride, offset int)
+	VertexAttrib1f(index int, x float32)
+	VertexAttrib2f(index int, x, y float32)
+	VertexAttrib3f(index int, x, y, z float32)
+	VertexAttrib4f(index int, x, y, z, w float32)
+	VertexAttrib1fv(index int, value []float32)
+	VertexAttrib2fv(index int, value []float32)
+	VertexAttrib3fv(index int, value []float32)
+	VertexAttrib4fv(index int, value []float32)
+	GetVertexAttribi(index int, pname Enum) int
+	GetVertexAttribfv(index int, pname Enum) []float32
+
+	// RenderBuffer
+	BindRenderBuffer(rb *RenderBuffer)
+	CreateRenderBuffer() *RenderBuffer
+	DeleteRenderBuffer(rb *RenderBuffer)
+	GetRenderbufferParameter(target, pname Enum) int
+	IsRenderbuffer(rb *RenderBuffer) bool
+	RenderBufferStorage(internalFormat Enum, width, height int)
+
+	// DrawBuffer
+	DrawArrays(mode Enum, first, count int)
+	DrawElements(mode Enum, count int, typ Enum, offset int)
+
+	// ReadPixels
+	ReadPixels(x, y, width, height int) (*image.RGBA, error)
+	ReadPixelsInto(x, y, width, height int, pixels []byte) error
+}
+
+// matrixCount returns how many n×n matrices are stored in value. It returns 0
+// if the length of value isn't a multiple of n*n, raising INVALID_VALUE.
+// Methods setting uniform matrices do nothing when it returns 0.
+func (c *Context) matrixCount(n int, value []float32) int {
+	return c.vectorCount(n*n, len(value))
+}
+
+// vectorCount returns how many vectors of n components are stored in a slice
+// of the given length, the count Uniform*v methods pass to OpenGL. It returns
+// 0 if length isn't a multiple of n, raising INVALID_VALUE. Methods setting
+// uniform arrays do nothing when it returns 0, so an empty slice is a no-op.
+func (c *Context) vectorCount(n, length int) int {
+	if length%n != 0 {
+		c.invalidValue()
+		return 0
+	}
+	return length / n
+}
+
+// attribValues reports whether value holds the n components set by the
+// VertexAttrib*fv methods, raising INVALID_VALUE if it is shorter. Further
+// values are ignored.
+func (c *Context) attribValues(n int, value []float32) bool {
+	if len(value) < n {
+		c.invalidValue()
+		return false
+	}
+	return true
+}
+
+// transposeMatrices returns a copy of value in which each of the consecutive
+// n×n matrices is transposed. It is used by the backends whose driver can't
+// transpose uniform matrices itself.
+func transposeMatrices(n int, value []float32) []float32 {
+	size := n * n
+	t := make([]float32, len(value))
+	for m := 0; m+size <= len(value); m += size {
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				t[m+j*n+i] = value[m+i*n+j]
+			}
+		}
+	}
+	return t
+}
+
+// ReadPixels reads a rectangle of the currently bound framebuffer into a new
+// image. The lower left corner of the rectangle is at (x, y) in framebuffer
+// coordinates, but the image is returned top row first like any other
+// image.Image.
+func (c *Context) ReadPixels(x, y, width, height int) (*image.RGBA, error) {
+	if width <= 0 || height <= 0 {
+		return nil, fmt.Errorf("gl: ReadPixels: invalid size %dx%d", width, height)
+	}
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	if err := c.ReadPixelsInto(x, y, width, height, img.Pix); err != nil {
+		return nil, err
+	}
+	return img, nil
+}
+
+// errReadPixelsSize is returned by ReadPixelsInto when the destination can't
+// hold the requested rectangle.
+var errReadPixelsSize = errors.New("gl: ReadPixelsInto: pixels is too small for the requested rectangle")
+
+// checkReadPixels validates the arguments of ReadPixelsInto.
+func checkReadPixels(width, height int, pixels []byte) error {
+	if width <= 0 || height <= 0 {
+		return fmt.Errorf("gl: ReadPixelsInto: invalid size %dx%d", width, height)
+	}
+	if len(pixels) < 4*width*height {
+		return errReadPixelsSize
+	}
+	return nil
+}
+
+// flipRows reverses the order of the rows in pix, turning the bottom-up rows
+// returned by OpenGL into the top-down order used by package image.
+func flipRows(pix []byte, stride, height int) {
+	tmp := make([]byte, stride)
+	for top, bottom := 0, height-1; top < bottom; top, bottom = top+1, bottom-1 {
+		t := pix[top*stride : (top+1)*stride]
+		b := pix[bottom*stride : (bottom+1)*stride]
+		copy(tmp, t)
+		copy(t, b)
+		copy(b, tmp)
+	}
+}
+
+// Capabilities describes the limits and features of the device behind a
+// Context, as returned by Context.Capabilities.
+type Capabilities struct {
+	MaxTextureSize       int
+	MaxVertexAttribs     int
+	MaxTextureImageUnits int
+	MaxVaryingVectors    int
+	MaxRenderbufferSize  int
+
+	ShadingLanguageVersion string
+	Vendor                 string
+	Renderer               string
+	Version                string
+
+	// Extensions holds the normalized extension names, see
+	// Context.Extensions.
+	Extensions []string
+}
+
+// parameterSize returns the number of values glGet stores for pname.
+func (c *Context) parameterSize(pname Enum) int {
+	switch pname {
+	case c.BLEND_COLOR, c.COLOR_CLEAR_VALUE, c.COLOR_WRITEMASK, c.SCISSOR_BOX, c.VIEWPORT:
+		return 4
+	case c.ALIASED_LINE_WIDTH_RANGE, c.ALIASED_POINT_SIZE_RANGE, c.DEPTH_RANGE, c.MAX_VIEWPORT_DIMS:
+		return 2
+	case c.COMPRESSED_TEXTURE_FORMATS:
+		return c.GetParameterInt(c.NUM_COMPRESSED_TEXTURE_FORMATS)
+	}
+	return 1
+}
+
+// extensionName returns name without the "GL_" prefix used by desktop OpenGL
+// and OpenGL ES, which is the form returned by Context.Extensions.
+func extensionName(name string) string {
+	return strings.TrimPrefix(name, "GL_")
+}
+
+// glExtensions splits the GL_EXTENSIONS string into normalized names.
+func glExtensions(extensions string) []string {
+	names := strings.Fields(extensions)
+	for i := range names {
+		names[i] = extensionName(names[i])
+	}
+	return names
+}
+
+// containsExtension reports whether names holds the extension name, which may
+// be given with or without its "GL_" prefix.
+func containsExtension(names []string, name string) bool {
+	name = extensionName(name)
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// unsupportedEnum reports whether value, passed to a method as a capability or
+// parameter name, is 0, the value of the Context constants the backend
+// doesn't support. Methods don't pass such values on to OpenGL; the next
+// GetError returns INVALID_ENUM instead.
+func (c *Context) unsupportedEnum(value Enum) bool {
+	if value != 0 {
+		return false
+	}
+	c.enumError = true
+	return true
+}
+
+// unsupportedTexParameter reports whether param, the value TexParameteri sets
+// pname to, is an unsupported constant. This is only checked for the wrap and
+// filter modes, none of which is 0 in OpenGL.
+func (c *Context) unsupportedTexParameter(pname, param Enum) bool {
+	switch pname {
+	case c.TEXTURE_WRAP_S, c.TEXTURE_WRAP_T, c.TEXTURE_MIN_FILTER, c.TEXTURE_MAG_FILTER:
+		return c.unsupportedEnum(param)
+	}
+	return false
+}
+
+// bufferBytes returns the little endian encoding of the slice data.
+func bufferBytes(data interface{}) ([]byte, error) {
+	if p, ok := data.([]byte); ok {
+		return append([]byte(nil), p...), nil
+	}
+	var buf bytes.Buffer
+	if err := binary.Write(&buf, binary.LittleEndian, data); err != nil {
+		return nil, fmt.Errorf("gl: unsupported buffer data %T", data)
+	}
+	return buf.Bytes(), nil
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -1,8 +1,15 @@
 // This doesn't actually send anything to the graphics card. This is so that servers
 // can use engo and other parts of the engine while being headless and not depending
-// on the OpenGL library. Anything that does have a return, returns a zero-value
-// of whatever's supposed to be returned, except returns true for booleans in case
-// success checks are used.
+// on the OpenGL library. The constants have their OpenGL values.
+//
+// Objects are tracked like a driver would: they get unique names, are alive
+// until deleted, remember what they are bound to, and keep the size of buffers,
+// textures and renderbuffers, so that misuse raises an error through GetError,
+// e.g. INVALID_OPERATION when binding a deleted texture. Nothing is drawn, and
+// shaders compile whatever their source. Enabled capabilities are tracked as
+// well, and queries about anything else return a zero-value.
+// Tests can script the results and check the calls made with the Mock of a
+// Context.
 
 //go:build nogl
 // +build nogl
@@ -21,318 +28,61 @@ type Program struct{ uint32 }
 type UniformLocation struct{ int32 }
 type Shader struct{ uint32 }
 
+var _ Renderer = (*Context)(nil)
+
 type Context struct {
-	ARRAY_BUFFER                                 int
-	ARRAY_BUFFER_BINDING                         int
-	ATTACHED_SHADERS                             int
-	BACK                                         int
-	BLEND                                        int
-	BLEND_COLOR                                  int
-	BLEND_DST_ALPHA                              int
-	BLEND_DST_RGB                                int
-	BLEND_EQUATION                               int
-	BLEND_EQUATION_ALPHA                         int
-	BLEND_EQUATION_RGB                           int
-	BLEND_SRC_ALPHA                              int
-	BLEND_SRC_RGB                                int
-	BLUE_BITS                                    int
-	BOOL                                         int
-	BOOL_VEC2                                    int
-	BOOL_VEC3                                    int
-	BOOL_VEC4                                    int
-	BROWSER_DEFAULT_WEBGL                        int
-	BUFFER_SIZE                                  int
-	BUFFER_USAGE                                 int
-	BYTE                                         int
-	CCW                                          int
-	CLAMP_TO_EDGE                                int
-	CLAMP_TO_BORDER                              int
-	COLOR_ATTACHMENT0                            int
-	COLOR_BUFFER_BIT                             int
-	COLOR_CLEAR_VALUE                            int
-	COLOR_WRITEMASK                              int
-	COMPILE_STATUS                               uint32
-	COMPRESSED_TEXTURE_FORMATS                   int
-	CONSTANT_ALPHA                               int
-	CONSTANT_COLOR                               int
-	CONTEXT_LOST_WEBGL                           int
-	CULL_FACE                                    int
-	CULL_FACE_MODE                               int
-	CURRENT_PROGRAM                              int
-	CURRENT_VERTEX_ATTRIB                        int
-	CW                                           int
-	DECR                                         int
-	DECR_WRAP                                    int
-	DELETE_STATUS                                int
-	DEPTH_ATTACHMENT                             int
-	DEPTH_BITS                                   int
-	DEPTH_BUFFER_BIT                             int
-	DEPTH_CLEAR_VALUE                            int
-	DEPTH_COMPONENT                              int
-	DEPTH_COMPONENT16                            int
-	DEPTH_FUNC                                   int
-	DEPTH_RANGE                                  int
-	DEPTH_STENCIL                                int
-	DEPTH_STENCIL_ATTACHMENT                     int
-	DEPTH_TEST                                   int
-	DEPTH_WRITEMASK                              int
-	DITHER                                       int
-	DONT_CARE                                    int
-	DST_ALPHA                                    int
-	DST_COLOR                                    int
-	DYNAMIC_DRAW                                 int
-	ELEMENT_ARRAY_BUFFER                         int
-	ELEMENT_ARRAY_BUFFER_BINDING                 int
-	EQUAL                                        int
-	FASTEST                                      int
-	FLOAT                                        int
-	FLOAT_MAT2                                   int
-	FLOAT_MAT3                                   int
-	FLOAT_MAT4                                   int
-	FLOAT_VEC2                                   int
-	FLOAT_VEC3                                   int
-	FLOAT_VEC4                                   int
-	FRAGMENT_SHADER                              int
-	FRAMEBUFFER                                  int
-	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME           int
-	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE           int
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE int
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL         int
-	FRAMEBUFFER_BINDING                          int
-	FRAMEBUFFER_COMPLETE                         int
-	FRAMEBUFFER_INCOMPLETE_ATTACHMENT            int
-	FRAMEBUFFER_INCOMPLETE_DIMENSIONS            int
-	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT    int
-	FRAMEBUFFER_UNSUPPORTED                      int
-	FRONT                                        int
-	FRONT_AND_BACK                               int
-	FRONT_FACE                                   int
-	FUNC_ADD                                     int
-	FUNC_REVERSE_SUBTRACT                        int
-	FUNC_SUBTRACT                                int
-	GENERATE_MIPMAP_HINT                         int
-	GEQUAL                                       int
-	GREATER                                      int
-	GREEN_BITS                                   int
-	HIGH_FLOAT                                   int
-	HIGH_INT                                     int
-	INCR                                         int
-	INCR_WRAP                                    int
-	INFO_LOG_LENGTH                              uint32
-	INT                                          int
-	INT_VEC2                                     int
-	INT_VEC3                                     int
-	INT_VEC4                                     int
-	INVALID_ENUM                                 int
-	INVALID_FRAMEBUFFER_OPERATION                int
-	INVALID_OPERATION                            int
-	INVALID_VALUE                                int
-	INVERT                                       int
-	KEEP                                         int
-	LEQUAL                                       int
-	LESS                                         int
-	LINEAR                                       int
-	LINEAR_MIPMAP_LINEAR                         int
-	LINEAR_MIPMAP_NEAREST                        int
-	LINES                                        int
-	LINE_LOOP                                    int
-	LINE_STRIP                                   int
-	LINE_STIPPLE                                 int
-	LINE_WIDTH                                   int
-	LINK_STATUS                                  int
-	LOW_FLOAT                                    int
-	LOW_INT                                      int
-	LUMINANCE                                    int
-	LUMINANCE_ALPHA                              int
-	MAX_COMBINED_TEXTURE_IMAGE_UNITS             int
-	MAX_CUBE_MAP_TEXTURE_SIZE                    int
-	MAX_FRAGMENT_UNIFORM_VECTORS                 int
-	MAX_RENDERBUFFER_SIZE                        int
-	MAX_TEXTURE_IMAGE_UNITS                      int
-	MAX_TEXTURE_SIZE                             int
-	MAX_VARYING_VECTORS                          int
-	MAX_VERTEX_ATTRIBS                           int
-	MAX_VERTEX_TEXTURE_IMAGE_UNITS               int
-	MAX_VERTEX_UNIFORM_VECTORS                   int
-	MAX_VIEWPORT_DIMS                            int
-	MEDIUM_FLOAT                                 int
-	MEDIUM_INT                                   int
-	MIRRORED_REPEAT                              int
-	MULTISAMPLE                                  int
-	NEAREST                                      int
-	NEAREST_MIPMAP_LINEAR                        int
-	NEAREST_MIPMAP_NEAREST                       int
-	NEVER                                        int
-	NICEST                                       int
-	NONE                                         int
-	NOTEQUAL                                     int
-	NO_ERROR                                     int
-	NUM_COMPRESSED_TEXTURE_FORMATS               int
-	ONE                                          int
-	ONE_MINUS_CONSTANT_ALPHA                     int
-	ONE_MINUS_CONSTANT_COLOR                     int
-	ONE_MINUS_DST_ALPHA                          int
-	ONE_MINUS_DST_COLOR                          int
-	ONE_MINUS_SRC_ALPHA                          int
-	ONE_MINUS_SRC_COLOR                          int
-	OUT_OF_MEMORY                                int
-	PACK_ALIGNMENT                               int
-	POINTS                                       int
-	POLYGON_OFFSET_FACTOR                        int
-	POLYGON_OFFSET_FILL                          int
-	POLYGON_OFFSET_UNITS                         int
-	RED_BITS                                     int
-	RENDERBUFFER                                 int
-	RENDERBUFFER_ALPHA_SIZE                      int
-	RENDERBUFFER_BINDING                         int
-	RENDERBUFFER_BLUE_SIZE                       int
-	RENDERBUFFER_DEPTH_SIZE                      int
-	RENDERBUFFER_GREEN_SIZE                      int
-	RENDERBUFFER_HEIGHT                          int
-	RENDERBUFFER_INTERNAL_FORMAT                 int
-	RENDERBUFFER_RED_SIZE                        int
-	RENDERBUFFER_STENCIL_SIZE                    int
-	RENDERBUFFER_WIDTH                           int
-	RENDERER                                     int
-	REPEAT                                       int
-	REPLACE                                      int
-	RGB                                          int
-	RGB5_A1                                      int
-	RGB565                                       int
-	RGBA                                         int
-	RGBA4                                        int
-	RGBA8                                        int
-	SAMPLER_2D                                   int
-	SAMPLER_CUBE                                 int
-	SAMPLES                                      int
-	SAMPLE_ALPHA_TO_COVERAGE                     int
-	SAMPLE_BUFFERS                               int
-	SAMPLE_COVERAGE                              int
-	SAMPLE_COVERAGE_INVERT                       int
-	SAMPLE_COVERAGE_VALUE                        int
-	SCISSOR_BOX                                  int
-	SCISSOR_TEST                                 int
-	SHADER_COMPILER                              int
-	SHADER_SOURCE_LENGTH                         int
-	SHADER_TYPE                                  int
-	SHADING_LANGUAGE_VERSION                     int
-	SHORT                                        int
-	SRC_ALPHA                                    int
-	SRC_ALPHA_SATURATE                           int
-	SRC_COLOR                                    int
-	STATIC_DRAW                                  int
-	STENCIL_ATTACHMENT                           int
-	STENCIL_BACK_FAIL                            int
-	STENCIL_BACK_FUNC                            int
-	STENCIL_BACK_PASS_DEPTH_FAIL                 int
-	STENCIL_BACK_PASS_DEPTH_PASS                 int
-	STENCIL_BACK_REF                             int
-	STENCIL_BACK_VALUE_MASK                      int
-	STENCIL_BACK_WRITEMASK                       int
-	STENCIL_BITS                                 int
-	STENCIL_BUFFER_BIT                           int
-	STENCIL_CLEAR_VALUE                          int
-	STENCIL_FAIL                                 int
-	STENCIL_FUNC                                 int
-	STENCIL_INDEX                                int
-	STENCIL_INDEX8                               int
-	STENCIL_PASS_DEPTH_FAIL                      int
-	STENCIL_PASS_DEPTH_PASS                      int
-	STENCIL_REF                                  int
-	STENCIL_TEST                                 int
-	STENCIL_VALUE_MASK                           int
-	STENCIL_WRITEMASK                            int
-	STREAM_DRAW                                  int
-	SUBPIXEL_BITS                                int
-	TEXTURE                                      int
-	TEXTURE0                                     int
-	TEXTURE1                                     int
-	TEXTURE2                                     int
-	TEXTURE3                                     int
-	TEXTURE4                                     int
-	TEXTURE5                                     int
-	TEXTURE6                                     int
-	TEXTURE7                                     int
-	TEXTURE8                                     int
-	TEXTURE9                                     int
-	TEXTURE10                                    int
-	TEXTURE11                                    int
-	TEXTURE12                                    int
-	TEXTURE13                                    int
-	TEXTURE14                                    int
-	TEXTURE15                                    int
-	TEXTURE16                                    int
-	TEXTURE17                                    int
-	TEXTURE18                                    int
-	TEXTURE19                                    int
-	TEXTURE20                                    int
-	TEXTURE21                                    int
-	TEXTURE22                                    int
-	TEXTURE23                                    int
-	TEXTURE24                                    int
-	TEXTURE25                                    int
-	TEXTURE26                                    int
-	TEXTURE27                                    int
-	TEXTURE28                                    int
-	TEXTURE29                                    int
-	TEXTURE30                                    int
-	TEXTURE31                                    int
-	TEXTURE_2D                                   int
-	TEXTURE_BINDING_2D                           int
-	TEXTURE_BINDING_CUBE_MAP                     int
-	TEXTURE_CUBE_MAP                             int
-	TEXTURE_CUBE_MAP_NEGATIVE_X                  int
-	TEXTURE_CUBE_MAP_NEGATIVE_Y                  int
-	TEXTURE_CUBE_MAP_NEGATIVE_Z                  int
-	TEXTURE_CUBE_MAP_POSITIVE_X                  int
-	TEXTURE_CUBE_MAP_POSITIVE_Y                  int
-	TEXTURE_CUBE_MAP_POSITIVE_Z                  int
-	TEXTURE_MAG_FILTER                           int
-	TEXTURE_MIN_FILTER                           int
-	TEXTURE_WRAP_S                               int
-	TEXTURE_WRAP_T                               int
-	TRIANGLES                                    int
-	TRIANGLE_FAN                                 int
-	TRIANGLE_STRIP                               int
-	UNPACK_ALIGNMENT                             int
-	UNPACK_COLORSPACE_CONVERSION_WEBGL           int
-	UNPACK_FLIP_Y_WEBGL                          int
-	UNPACK_PREMULTIPLY_ALPHA_WEBGL               int
-	UNSIGNED_BYTE                                int
-	UNSIGNED_INT                                 int
-	UNSIGNED_SHORT                               int
-	UNSIGNED_SHORT_4_4_4_4                       int
-	UNSIGNED_SHORT_5_5_5_1                       int
-	UNSIGNED_SHORT_5_6_5                         int
-	VALIDATE_STATUS                              int
-	VENDOR                                       int
-	VERSION                                      int
-	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING           int
-	VERTEX_ATTRIB_ARRAY_ENABLED                  int
-	VERTEX_ATTRIB_ARRAY_NORMALIZED               int
-	VERTEX_ATTRIB_ARRAY_POINTER                  int
-	VERTEX_ATTRIB_ARRAY_SIZE                     int
-	VERTEX_ATTRIB_ARRAY_STRIDE                   int
-	VERTEX_ATTRIB_ARRAY_TYPE                     int
-	VERTEX_SHADER                                int
-	VIEWPORT                                     int
-	ZERO                                         int
-	TRUE                                         int
+	Constants
+
+	// enumError is set when a method was passed an unsupported constant, and
+	// reported as INVALID_ENUM by the next GetError.
+	enumError bool
+
+	// err is the first error raised since GetError was last called.
+	err Enum
+
+	// Objects by name. Names are unique across all kinds of objects.
+	lastID        uint32
+	buffers       map[uint32]*noglBuffer
+	textures      map[uint32]*noglTexture
+	renderbuffers map[uint32]*noglRenderbuffer
+	framebuffers  map[uint32]*noglFramebuffer
+	shaders       map[uint32]*noglShader
+	programs      map[uint32]*noglProgram
+
+	// Bindings.
+	arrayBuffer   *noglBuffer
+	elementBuffer *noglBuffer
+	attribBuffers [noglMaxVertexAttribs]*noglBuffer
+	activeTexture int
+	textureUnits  [noglMaxTextureUnits]*noglTexture
+	renderbuffer  *noglRenderbuffer
+	framebuffer   *noglFramebuffer
+	program       *noglProgram
+
+	// enabled holds the capabilities enabled with Enable.
+	enabled map[Enum]bool
+
+	// mock scripts and records the calls, once Mock was called.
+	mock *Mock
 }
 
 func NewContext() *Context {
-	return &Context{}
-}
-
-func (c *Context) CreateShader(typ int) *Shader {
-	shader := &Shader{0}
-	return shader
+	c := &Context{
+		Constants:     newConstants(),
+		buffers:       make(map[uint32]*noglBuffer),
+		textures:      make(map[uint32]*noglTexture),
+		renderbuffers: make(map[uint32]*noglRenderbuffer),
+		framebuffers:  make(map[uint32]*noglFramebuffer),
+		shaders:       make(map[uint32]*noglShader),
+		programs:      make(map[uint32]*noglProgram),
+		enabled:       make(map[Enum]bool),
+	}
+	c.err = c.NO_ERROR
+	c.enabled[c.DITHER] = true
+	c.loadEnumNames()
+	return c
 }
 
-func (c *Context) ShaderSource(shader *Shader, source string) {}
-
-func (c *Context) CompileShader(shader *Shader) {}
-
 func (c *Context) Ptr(data interface{}) unsafe.Pointer {
 	var ptr unsafe.Pointer
 	return ptr
@@ -347,135 +97,381 @@ func (c *Context) GoStr(cstr *uint8) string {
 	return ""
 }
 
-func (c *Context) DeleteShader(shader *Shader) {}
+func (c *Context) LineWidth(width float32) {
+	c.mockCall("LineWidth", args(width))
+}
 
-func (c *Context) DeleteTexture(texture *Texture) {}
+func (c *Context) GetError() Enum {
+	r := c.err
+	if c.enumError {
+		c.enumError = false
+		r = c.INVALID_ENUM
+	} else {
+		c.err = c.NO_ERROR
+	}
+	c.mockCall("GetError", nil, &r)
+	return r
+}
 
-func (c *Context) GetShaderiv(shader *Shader, pname uint32) bool {
-	return true
+// invalidValue raises INVALID_VALUE.
+func (c *Context) invalidValue() {
+	c.setError(c.INVALID_VALUE)
 }
 
-func (c *Context) GetShaderInfoLog(shader *Shader) string {
-	return ""
+func (c *Context) EnableVertexAttribArray(index int) {
+	c.attribIndex(index)
+	c.mockCall("EnableVertexAttribArray", args(index))
 }
 
-func (c *Context) CreateProgram() *Program {
-	return &Program{0}
+func (c *Context) DisableVertexAttribArray(index int) {
+	c.attribIndex(index)
+	c.mockCall("DisableVertexAttribArray", args(index))
 }
 
-func (c *Context) DeleteProgram(program *Program) {}
+func (c *Context) VertexAttrib1f(index int, x float32) {
+	c.mockCall("VertexAttrib1f", args(index, x))
+}
 
-func (c *Context) BindAttribLocation(program *Program, index int, name string) {}
+func (c *Context) VertexAttrib2f(index int, x, y float32) {
+	c.mockCall("VertexAttrib2f", args(index, x, y))
+}
 
-func (c *Context) GetProgramParameteri(program *Program, pname int) int {
-	return 0
+func (c *Context) VertexAttrib3f(index int, x, y, z float32) {
+	c.mockCall("VertexAttrib3f", args(index, x, y, z))
 }
 
-func (c *Context) GetProgramParameterb(program *Program, pname int) bool {
-	return true
+func (c *Context) VertexAttrib4f(index int, x, y, z, w float32) {
+	c.mockCall("VertexAttrib4f", args(index, x, y, z, w))
 }
 
-func (c *Context) GetProgramInfoLog(program *Program) string {
-	return ""
+func (c *Context) VertexAttrib1fv(index int, value []float32) {
+	c.attribValues(1, value)
+	c.mockCall("VertexAttrib1fv", args(index, value))
 }
 
-func (c *Context) AttachShader(program *Program, shader *Shader) {}
+func (c *Context) VertexAttrib2fv(index int, value []float32) {
+	c.attribValues(2, value)
+	c.mockCall("VertexAttrib2fv", args(index, value))
+}
 
-func (c *Context) LineStipple(factor int32, pattern uint16) {}
+func (c *Context) VertexAttrib3fv(index int, value []float32) {
+	c.attribValues(3, value)
+	c.mockCall("VertexAttrib3fv", args(index, value))
+}
 
-func (c *Context) LineWidth(width float32) {}
+func (c *Context) VertexAttrib4fv(index int, value []float32) {
+	c.attribValues(4, value)
+	c.mockCall("VertexAttrib4fv", args(index, value))
+}
 
-func (c *Context) LinkProgram(program *Program) {}
+func (c *Context) GetVertexAttribi(index int, pname Enum) int {
+	var r int
+	if c.attribIndex(index) && pname == c.VERTEX_ATTRIB_ARRAY_BUFFER_BINDING {
+		r = objectID(c.attribBuffers[index])
+	}
+	c.mockCall("GetVertexAttribi", args(index, pname), &r)
+	return r
+}
 
-func (c *Context) CreateTexture() *Texture {
-	return &Texture{0}
+func (c *Context) GetVertexAttribfv(index int, pname Enum) []float32 {
+	r := make([]float32, 4)
+	c.mockCall("GetVertexAttribfv", args(index, pname), &r)
+	return r
 }
 
-func (c *Context) BindTexture(target int, texture *Texture) {}
+// capability reports whether cap can be enabled, raising INVALID_ENUM if not.
+func (c *Context) capability(cap Enum) bool {
+	if c.unsupportedEnum(cap) {
+		return false
+	}
+	switch cap {
+	case c.BLEND, c.CULL_FACE, c.DEPTH_TEST, c.DITHER, c.POLYGON_OFFSET_FILL,
+		c.SAMPLE_ALPHA_TO_COVERAGE, c.SAMPLE_COVERAGE, c.SCISSOR_TEST, c.STENCIL_TEST, c.MULTISAMPLE:
+		return true
+	}
+	c.setError(c.INVALID_ENUM)
+	return false
+}
 
-func (c *Context) ActiveTexture(target int) {}
+func (c *Context) Enable(flag Enum) {
+	if c.capability(flag) {
+		c.enabled[flag] = true
+	}
+	c.mockCall("Enable", args(flag))
+}
 
-func (c *Context) TexParameteri(target int, pname int, param int) {}
+func (c *Context) Disable(flag Enum) {
+	if c.capability(flag) {
+		c.enabled[flag] = false
+	}
+	c.mockCall("Disable", args(flag))
+}
 
-func (c *Context) TexImage2D(target, level, internalFormat, format, kind int, data interface{}) {}
+func (c *Context) BlendFunc(src, dst Enum) {
+	c.mockCall("BlendFunc", args(src, dst))
+}
 
-func (c *Context) TexImage2DEmpty(target, level, internalFormat, format, kind, width, height int) {}
+func (c *Context) BlendEquation(mode Enum) {
+	c.mockCall("BlendEquation", args(mode))
+}
 
-func (c *Context) GetAttribLocation(program *Program, name string) int {
-	return 0
+func (c *Context) UniformMatrix2fv(location *UniformLocation, transpose bool, value []float32) {
+	if c.matrixCount(2, value) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("UniformMatrix2fv", args(location, transpose, value))
 }
 
-func (c *Context) GetUniformLocation(program *Program, name string) *UniformLocation {
-	return &UniformLocation{0}
+func (c *Context) UniformMatrix3fv(location *UniformLocation, transpose bool, value []float32) {
+	if c.matrixCount(3, value) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("UniformMatrix3fv", args(location, transpose, value))
 }
 
-func (c *Context) GetError() int {
-	return 0
+func (c *Context) UniformMatrix4fv(location *UniformLocation, transpose bool, value []float32) {
+	if c.matrixCount(4, value) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("UniformMatrix4fv", args(location, transpose, value))
 }
 
-func (c *Context) CreateBuffer() *Buffer {
-	return &Buffer{0}
+func (c *Context) Uniform1f(location *UniformLocation, x float32) {
+	c.uniform(location)
+	c.mockCall("Uniform1f", args(location, x))
 }
 
-func (c *Context) DeleteBuffer(buffer *Buffer) {}
+func (c *Context) Uniform1i(location *UniformLocation, x int) {
+	c.uniform(location)
+	c.mockCall("Uniform1i", args(location, x))
+}
 
-func (c *Context) BindBuffer(target int, buffer *Buffer) {}
+func (c *Context) Uniform1iTexture(location *UniformLocation, tex *Texture) {
+	c.uniform(location)
+	c.mockCall("Uniform1iTexture", args(location, tex))
+}
 
-func (c *Context) BufferData(target int, data interface{}, usage int) {}
+func (c *Context) Uniform2f(location *UniformLocation, x, y float32) {
+	c.uniform(location)
+	c.mockCall("Uniform2f", args(location, x, y))
+}
 
-func (c *Context) EnableVertexAttribArray(index int) {}
+func (c *Context) Uniform3f(location *UniformLocation, x, y, z float32) {
+	c.uniform(location)
+	c.mockCall("Uniform3f", args(location, x, y, z))
+}
 
-func (c *Context) DisableVertexAttribArray(index int) {}
+func (c *Context) Uniform4f(location *UniformLocation, x, y, z, w float32) {
+	c.uniform(location)
+	c.mockCall("Uniform4f", args(location, x, y, z, w))
+}
 
-func (c *Context) VertexAttribPointer(index, size, typ int, normal bool, stride int, offset int) {}
+func (c *Context) Uniform2i(location *UniformLocation, x, y int) {
+	c.uniform(location)
+	c.mockCall("Uniform2i", args(location, x, y))
+}
 
-func (c *Context) Enable(flag int) {}
+func (c *Context) Uniform3i(location *UniformLocation, x, y, z int) {
+	c.uniform(location)
+	c.mockCall("Uniform3i", args(location, x, y, z))
+}
 
-func (c *Context) Disable(flag int) {}
+func (c *Context) Uniform4i(location *UniformLocation, x, y, z, w int) {
+	c.uniform(location)
+	c.mockCall("Uniform4i", args(location, x, y, z, w))
+}
 
-func (c *Context) BlendFunc(src, dst int) {}
+func (c *Context) Uniform1fv(location *UniformLocation, value []float32) {
+	if c.vectorCount(1, len(value)) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("Uniform1fv", args(location, value))
+}
 
-func (c *Context) BlendEquation(mode int) {}
+func (c *Context) Uniform1iv(location *UniformLocation, value []int32) {
+	if c.vectorCount(1, len(value)) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("Uniform1iv", args(location, value))
+}
 
-func (c *Context) UniformMatrix2fv(location *UniformLocation, transpose bool, value []float32) {}
+func (c *Context) Uniform2fv(location *UniformLocation, value []float32) {
+	if c.vectorCount(2, len(value)) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("Uniform2fv", args(location, value))
+}
 
-func (c *Context) UniformMatrix3fv(location *UniformLocation, transpose bool, value []float32) {}
+func (c *Context) Uniform2iv(location *UniformLocation, value []int32) {
+	if c.vectorCount(2, len(value)) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("Uniform2iv", args(location, value))
+}
 
-func (c *Context) UniformMatrix4fv(location *UniformLocation, transpose bool, value []float32) {}
+func (c *Context) Uniform3fv(location *UniformLocation, value []float32) {
+	if c.vectorCount(3, len(value)) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("Uniform3fv", args(location, value))
+}
 
-func (c *Context) UseProgram(program *Program) {}
+func (c *Context) Uniform3iv(location *UniformLocation, value []int32) {
+	if c.vectorCount(3, len(value)) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("Uniform3iv", args(location, value))
+}
 
-func (c *Context) ValidateProgram(program *Program) {}
+func (c *Context) Uniform4fv(location *UniformLocation, value []float32) {
+	if c.vectorCount(4, len(value)) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("Uniform4fv", args(location, value))
+}
 
-func (c *Context) Uniform1f(location *UniformLocation, x float32) {}
+func (c *Context) Uniform4iv(location *UniformLocation, value []int32) {
+	if c.vectorCount(4, len(value)) != 0 {
+		c.uniform(location)
+	}
+	c.mockCall("Uniform4iv", args(location, value))
+}
 
-func (c *Context) Uniform1i(location *UniformLocation, x int) {}
+// DrawArrays checks that a program is in use and the framebuffer is complete.
+func (c *Context) DrawArrays(mode Enum, first, count int) {
+	if first < 0 || count < 0 {
+		c.setError(c.INVALID_VALUE)
+	} else {
+		c.drawable()
+	}
+	c.mockCall("DrawArrays", args(mode, first, count))
+}
 
-func (c *Context) Uniform1iTexture(location *UniformLocation, tex *Texture) {}
+// DrawElements checks that a program is in use, the framebuffer is complete,
+// and the indices lie within the bound element array buffer.
+func (c *Context) DrawElements(mode Enum, count int, typ Enum, offset int) {
+	c.drawElements(count, typ, offset)
+	c.mockCall("DrawElements", args(mode, count, typ, offset))
+}
 
-func (c *Context) Uniform2f(location *UniformLocation, x, y float32) {}
+func (c *Context) ReadPixelsInto(x, y, width, height int, pixels []byte) error {
+	r := checkReadPixels(width, height, pixels)
+	if r == nil {
+		c.framebufferComplete()
+	}
+	c.mockCall("ReadPixelsInto", args(x, y, width, height, pixels), &r)
+	return r
+}
 
-func (c *Context) Uniform3f(location *UniformLocation, x, y, z float32) {}
+func (c *Context) ClearColor(r, g, b, a float32) {
+	c.mockCall("ClearColor", args(r, g, b, a))
+}
 
-func (c *Context) Uniform4f(location *UniformLocation, x, y, z, w float32) {}
+func (c *Context) Viewport(x, y, width, height int) {
+	c.mockCall("Viewport", args(x, y, width, height))
+}
 
-func (c *Context) BufferSubData(target int, offset int, data interface{}) {}
+func (c *Context) GetViewport() [4]int32 {
+	r := [4]int32{0, 0, 0, 0}
+	c.mockCall("GetViewport", nil, &r)
+	return r
+}
 
-func (c *Context) DrawArrays(mode, first, count int) {}
+func (c *Context) GetParameterBool(pname Enum) bool {
+	v, _ := c.state(pname)
+	r := v != 0
+	c.mockCall("GetParameterBool", args(pname), &r)
+	return r
+}
 
-func (c *Context) DrawElements(mode, count, typ, offset int) {}
+func (c *Context) GetParameterBools(pname Enum) []bool {
+	r := make([]bool, c.parameterSize(pname))
+	if v, ok := c.state(pname); ok {
+		r[0] = v != 0
+	}
+	c.mockCall("GetParameterBools", args(pname), &r)
+	return r
+}
 
-func (c *Context) ClearColor(r, g, b, a float32) {}
+func (c *Context) GetParameterFloat(pname Enum) float32 {
+	var r float32
+	c.mockCall("GetParameterFloat", args(pname), &r)
+	return r
+}
 
-func (c *Context) Viewport(x, y, width, height int) {}
+func (c *Context) GetParameterFloats(pname Enum) []float32 {
+	r := make([]float32, c.parameterSize(pname))
+	c.mockCall("GetParameterFloats", args(pname), &r)
+	return r
+}
 
-func (c *Context) GetViewport() [4]int32 {
-	return [4]int32{0, 0, 0, 0}
+func (c *Context) GetParameterInt(pname Enum) int {
+	r, _ := c.state(pname)
+	c.mockCall("GetParameterInt", args(pname), &r)
+	return r
 }
 
-func (c *Context) Scissor(x, y, width, height int) {}
+func (c *Context) GetParameterInts(pname Enum) []int32 {
+	r := make([]int32, c.parameterSize(pname))
+	if v, ok := c.state(pname); ok {
+		r[0] = int32(v)
+	}
+	c.mockCall("GetParameterInts", args(pname), &r)
+	return r
+}
 
-func (c *Context) Clear(flags int) {}
+func (c *Context) GetParameterString(pname Enum) string {
+	var r string
+	c.mockCall("GetParameterString", args(pname), &r)
+	return r
+}
+
+func (c *Context) GetSupportedExtensions() []string {
+	r := []string{}
+	c.mockCall("GetSupportedExtensions", nil, &r)
+	return r
+}
+
+func (c *Context) Extensions() []string {
+	r := []string{}
+	c.mockCall("Extensions", nil, &r)
+	return r
+}
+
+// HasExtension returns false, no extensions are available without a device.
+func (c *Context) HasExtension(name string) bool {
+	var r bool
+	c.mockCall("HasExtension", args(name), &r)
+	return r
+}
+
+// Capabilities reports limits typical of a modest OpenGL ES 2.0 device, so
+// code sizing its resources from them behaves sensibly without one.
+func (c *Context) Capabilities() Capabilities {
+	r := Capabilities{
+		MaxTextureSize:         noglMaxTextureSize,
+		MaxVertexAttribs:       noglMaxVertexAttribs,
+		MaxTextureImageUnits:   noglMaxTextureUnits,
+		MaxVaryingVectors:      noglMaxVaryingVectors,
+		MaxRenderbufferSize:    noglMaxRenderbufferSize,
+		ShadingLanguageVersion: "OpenGL ES GLSL ES 1.00 (nogl)",
+		Vendor:                 "EngoEngine",
+		Renderer:               "nogl",
+		Version:                "OpenGL ES 2.0 (nogl)",
+		Extensions:             []string{},
+	}
+	c.mockCall("Capabilities", nil, &r)
+	return r
+}
+
+func (c *Context) Scissor(x, y, width, height int) {
+	c.mockCall("Scissor", args(x, y, width, height))
+}
+
+func (c *Context) Clear(flags Enum) {
+	c.framebufferComplete()
+	c.mockCall("Clear", args(flags))
+}
 
 func (c *Context) MatrixMode(mode uint32) {}
 
@@ -485,31 +481,173 @@ func (c *Context) PushMatrix() {}
 
 func (c *Context) PopMatrix() {}
 
-func (c *Context) CreateRenderBuffer() *RenderBuffer {
-	return &RenderBuffer{0}
+func (c *Context) BlendColor(r, g, b, a float32) {
+	c.mockCall("BlendColor", args(r, g, b, a))
+}
+
+func (c *Context) BlendEquationSeparate(modeRGB, modeAlpha Enum) {
+	c.mockCall("BlendEquationSeparate", args(modeRGB, modeAlpha))
+}
+
+func (c *Context) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha Enum) {
+	c.mockCall("BlendFuncSeparate", args(srcRGB, dstRGB, srcAlpha, dstAlpha))
+}
+
+func (c *Context) DepthFunc(fun Enum) {
+	c.mockCall("DepthFunc", args(fun))
+}
+
+func (c *Context) SampleCoverage(value float32, invert bool) {
+	c.mockCall("SampleCoverage", args(value, invert))
+}
+
+func (c *Context) StencilFunc(function Enum, ref, mask int) {
+	c.mockCall("StencilFunc", args(function, ref, mask))
+}
+
+func (c *Context) StencilFuncSeparate(face, function Enum, ref, mask int) {
+	c.mockCall("StencilFuncSeparate", args(face, function, ref, mask))
+}
+
+func (c *Context) StencilOp(fail, zfail, zpass Enum) {
+	c.mockCall("StencilOp", args(fail, zfail, zpass))
 }
 
-func (c *Context) DeleteRenderBuffer(rb *RenderBuffer) {
+func (c *Context) StencilOpSeparate(face, fail, zfail, zpass Enum) {
+	c.mockCall("StencilOpSeparate", args(face, fail, zfail, zpass))
 }
 
-func (c *Context) BindRenderBuffer(rb *RenderBuffer) {
+func (c *Context) StencilMask(mask int) {
+	c.mockCall("StencilMask", args(mask))
 }
 
-func (c *Context) RenderBufferStorage(internalFormat int, width, height int) {
+func (c *Context) StencilMaskSeparate(face Enum, mask int) {
+	c.mockCall("StencilMaskSeparate", args(face, mask))
 }
 
-func (c *Context) CreateFrameBuffer() *FrameBuffer {
-	return &FrameBuffer{0}
+func (c *Context) ClearDepth(depth float32) {
+	c.mockCall("ClearDepth", args(depth))
 }
 
-func (c *Context) DeleteFrameBuffer(fb *FrameBuffer) {
+func (c *Context) ClearStencil(s int) {
+	c.mockCall("ClearStencil", args(s))
+}
+
+func (c *Context) ColorMask(r, g, b, a bool) {
+	c.mockCall("ColorMask", args(r, g, b, a))
+}
+
+func (c *Context) DepthMask(flag bool) {
+	c.mockCall("DepthMask", args(flag))
+}
+
+func (c *Context) DepthRange(zNear, zFar float32) {
+	c.mockCall("DepthRange", args(zNear, zFar))
+}
+
+func (c *Context) CullFace(mode Enum) {
+	c.mockCall("CullFace", args(mode))
+}
+
+func (c *Context) FrontFace(mode Enum) {
+	c.mockCall("FrontFace", args(mode))
+}
+
+func (c *Context) PolygonOffset(factor, units float32) {
+	c.mockCall("PolygonOffset", args(factor, units))
+}
+
+func (c *Context) Finish() {
+	c.mockCall("Finish", nil)
+}
+
+func (c *Context) Flush() {
+	c.mockCall("Flush", nil)
+}
+
+// IsContextLost returns false, as there's no context that could be lost.
+func (c *Context) IsContextLost() bool {
+	var r bool
+	c.mockCall("IsContextLost", nil, &r)
+	return r
+}
+
+func (c *Context) IsEnabled(cap Enum) bool {
+	r := c.capability(cap) && c.enabled[cap]
+	c.mockCall("IsEnabled", args(cap), &r)
+	return r
+}
+
+func (c *Context) PixelStorei(pname Enum, param int) {
+	c.mockCall("PixelStorei", args(pname, param))
+}
+
+// attribIndex reports whether index is that of a vertex attribute, raising
+// INVALID_VALUE if not.
+func (c *Context) attribIndex(index int) bool {
+	if index < 0 || index >= noglMaxVertexAttribs {
+		c.setError(c.INVALID_VALUE)
+		return false
+	}
+	return true
 }
 
-func (c *Context) BindFrameBuffer(fb *FrameBuffer) {
+// drawable reports whether drawing is possible, raising an error if there is
+// no current program or the framebuffer is incomplete.
+func (c *Context) drawable() bool {
+	if c.program == nil {
+		c.setError(c.INVALID_OPERATION)
+		return false
+	}
+	return c.framebufferComplete()
 }
 
-func (c *Context) FrameBufferTexture2D(target, attachment, texTarget int, t *Texture, level int) {
+func (c *Context) drawElements(count int, typ Enum, offset int) {
+	var size int
+	switch typ {
+	case c.UNSIGNED_BYTE:
+		size = 1
+	case c.UNSIGNED_SHORT:
+		size = 2
+	case c.UNSIGNED_INT:
+		size = 4
+	default:
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	if count < 0 || offset < 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	if c.elementBuffer == nil || offset%size != 0 || offset+count*size > c.elementBuffer.size {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	c.drawable()
 }
 
-func (c *Context) FrameBufferRenderBuffer(target, attachment int, rb *RenderBuffer) {
+// state returns the value of the state pname tracked by c: the name of the
+// object bound to a binding point, the active texture unit, or 1 if a
+// capability is enabled. It returns false if pname isn't tracked.
+func (c *Context) state(pname Enum) (int, bool) {
+	switch pname {
+	case c.BLEND, c.CULL_FACE, c.DEPTH_TEST, c.DITHER, c.POLYGON_OFFSET_FILL,
+		c.SAMPLE_ALPHA_TO_COVERAGE, c.SAMPLE_COVERAGE, c.SCISSOR_TEST, c.STENCIL_TEST:
+		return boolInt(c.enabled[pname]), true
+	case c.ACTIVE_TEXTURE:
+		return int(c.TEXTURE0) + c.activeTexture, true
+	case c.ARRAY_BUFFER_BINDING:
+		return objectID(c.arrayBuffer), true
+	case c.CURRENT_PROGRAM:
+		return objectID(c.program), true
+	case c.ELEMENT_ARRAY_BUFFER_BINDING:
+		return objectID(c.elementBuffer), true
+	case c.FRAMEBUFFER_BINDING:
+		return objectID(c.framebuffer), true
+	case c.RENDERBUFFER_BINDING:
+		return objectID(c.renderbuffer), true
+	case c.TEXTURE_BINDING_2D:
+		return objectID(c.textureUnits[c.activeTexture]), true
+	}
+	return 0, false
 }
@@ -0,0 +1,226 @@
+//go:build nogl
+// +build nogl
+
+package gl
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// MockAny matches any argument in the expectations of a Mock.
+var MockAny interface{} = mockAny{}
+
+type mockAny struct{}
+
+func (mockAny) String() string { return "MockAny" }
+
+// Mock scripts the results of the calls made to a nogl Context and records
+// them, so tests can exercise the error paths of code using a Context without
+// a GPU.
+//
+// Methods return what the nogl backend would, e.g. true from GetShaderiv for
+// a compiled shader, unless results were given for them with ReturnOnce,
+// Return or Do. Results are given in the order the method returns them; an
+// int is converted to the Enum a method returns, and nil stands for the zero
+// value. The state of the Context is updated as if no results were given.
+type Mock struct {
+	once     map[string][][]interface{}
+	funcs    map[string]func(args []interface{}) []interface{}
+	calls    []*Call
+	expected []*Call
+}
+
+// Mock returns the Mock of c, creating it on the first call. Calls made
+// before are not recorded.
+func (c *Context) Mock() *Mock {
+	if c.mock == nil {
+		c.mock = &Mock{}
+	}
+	return c.mock
+}
+
+// Return makes every later call to method return results.
+func (m *Mock) Return(method string, results ...interface{}) *Mock {
+	return m.Do(method, func([]interface{}) []interface{} {
+		return results
+	})
+}
+
+// ReturnOnce makes the next call to method return results. Results given by
+// several calls are returned by as many calls, in order, before those given
+// by Return or Do.
+func (m *Mock) ReturnOnce(method string, results ...interface{}) *Mock {
+	if m.once == nil {
+		m.once = make(map[string][][]interface{})
+	}
+	m.once[method] = append(m.once[method], results)
+	return m
+}
+
+// Do makes every later call to method return the results of fn, which is
+// passed the arguments of the call. fn returning nil leaves the default
+// results.
+func (m *Mock) Do(method string, fn func(args []interface{}) []interface{}) *Mock {
+	if m.funcs == nil {
+		m.funcs = make(map[string]func(args []interface{}) []interface{})
+	}
+	m.funcs[method] = fn
+	return m
+}
+
+// Expect adds the call method(args...) to the calls Verify checks were made.
+// MockAny matches any argument, and the args may be omitted to match any
+// call to method.
+func (m *Mock) Expect(method string, args ...interface{}) *Mock {
+	m.expected = append(m.expected, &Call{Method: method, Args: args})
+	return m
+}
+
+// Verify returns an error unless the calls given to Expect were made, in the
+// order they were expected. Other calls may be made in between.
+func (m *Mock) Verify() error {
+	i := 0
+	for _, call := range m.calls {
+		if i < len(m.expected) && mockMatches(m.expected[i], call) {
+			i++
+		}
+	}
+	if i == len(m.expected) {
+		return nil
+	}
+	if i == 0 {
+		return fmt.Errorf("gl: expected call %s wasn't made", mockCallString(m.expected[i]))
+	}
+	return fmt.Errorf("gl: expected call %s wasn't made after %s", mockCallString(m.expected[i]), mockCallString(m.expected[i-1]))
+}
+
+// Calls returns the calls made to the Context, in order. Their Args and
+// Results hold the values passed and returned as they are, not normalized
+// like those of a TraceContext.
+func (m *Mock) Calls() []*Call {
+	return m.calls
+}
+
+// CallsTo returns the calls made to method, in order.
+func (m *Mock) CallsTo(method string) []*Call {
+	var calls []*Call
+	for _, call := range m.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// Reset forgets the calls made, the expected calls and the results given.
+func (m *Mock) Reset() {
+	*m = Mock{}
+}
+
+// mockCall records the call method(args...) to the Mock of c, if any,
+// storing the results it was given through results, which point to the
+// default results of the method.
+func (c *Context) mockCall(method string, args []interface{}, results ...interface{}) {
+	m := c.mock
+	if m == nil {
+		return
+	}
+	var given []interface{}
+	if queue := m.once[method]; len(queue) > 0 {
+		given, m.once[method] = queue[0], queue[1:]
+	} else if fn := m.funcs[method]; fn != nil {
+		given = fn(args)
+	}
+	if given != nil && len(given) != len(results) {
+		panic(fmt.Sprintf("gl: Mock given %d results for %s, which returns %d", len(given), method, len(results)))
+	}
+	call := &Call{Method: method, Args: args}
+	for i, result := range results {
+		dst := reflect.ValueOf(result).Elem()
+		if given != nil {
+			setMockResult(method, i, dst, given[i])
+		}
+		call.Results = append(call.Results, dst.Interface())
+	}
+	m.calls = append(m.calls, call)
+}
+
+// setMockResult stores the i-th result v of method in dst.
+func setMockResult(method string, i int, dst reflect.Value, v interface{}) {
+	if v == nil {
+		dst.Set(reflect.Zero(dst.Type()))
+		return
+	}
+	val := reflect.ValueOf(v)
+	switch {
+	case val.Type().AssignableTo(dst.Type()):
+		dst.Set(val)
+	case isInteger(val.Kind()) && isInteger(dst.Kind()):
+		dst.Set(val.Convert(dst.Type()))
+	default:
+		panic(fmt.Sprintf("gl: Mock result %d of %s is a %T, not a %v", i, method, v, dst.Type()))
+	}
+}
+
+// isInteger reports whether k is the kind of an integer type.
+func isInteger(k reflect.Kind) bool {
+	switch k {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return false
+}
+
+// mockMatches reports whether call is a call to the method of the expected
+// call e with its arguments.
+func mockMatches(e, call *Call) bool {
+	if call.Method != e.Method {
+		return false
+	}
+	if len(e.Args) == 0 {
+		return true
+	}
+	if len(e.Args) != len(call.Args) {
+		return false
+	}
+	for i, want := range e.Args {
+		if _, any := want.(mockAny); !any && !mockEqual(want, call.Args[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// mockEqual reports whether the argument got equals want. Objects are equal
+// if they are the same, and integers if their values are.
+func mockEqual(want, got interface{}) bool {
+	if want == nil || got == nil {
+		return want == nil && (got == nil || isNilObject(got))
+	}
+	w, g := reflect.ValueOf(want), reflect.ValueOf(got)
+	switch {
+	case w.Kind() == reflect.Ptr && g.Kind() == reflect.Ptr:
+		return w.Type() == g.Type() && w.Pointer() == g.Pointer()
+	case isInteger(w.Kind()) && isInteger(g.Kind()):
+		i64 := reflect.TypeOf(int64(0))
+		return w.Convert(i64).Int() == g.Convert(i64).Int()
+	}
+	return reflect.DeepEqual(want, got)
+}
+
+// mockCallString formats the expected call e as Go code, e.g.
+// `BindTexture(3553, nil)`.
+func mockCallString(e *Call) string {
+	s := make([]string, len(e.Args))
+	for i, arg := range e.Args {
+		if arg == nil {
+			s[i] = "nil"
+		} else {
+			s[i] = fmt.Sprint(arg)
+		}
+	}
+	return e.Method + "(" + strings.Join(s, ", ") + ")"
+}
@@ -0,0 +1,65 @@
+//go:build nogl
+// +build nogl
+
+package gl
+
+import "testing"
+
+func TestMockResults(t *testing.T) {
+	c := NewContext()
+	m := c.Mock()
+	m.Return("GetError", c.INVALID_ENUM)
+	m.ReturnOnce("GetError", int(c.OUT_OF_MEMORY)).ReturnOnce("GetError", nil)
+	for i, want := range []Enum{c.OUT_OF_MEMORY, 0, c.INVALID_ENUM, c.INVALID_ENUM} {
+		if got := c.GetError(); got != want {
+			t.Errorf("GetError call %d returned %s, want %s", i, c.ErrorName(got), c.ErrorName(want))
+		}
+	}
+
+	shader := c.CreateShader(c.VERTEX_SHADER)
+	c.CompileShader(shader)
+	m.Do("GetShaderiv", func(args []interface{}) []interface{} {
+		if args[1] == c.DELETE_STATUS {
+			return []interface{}{true}
+		}
+		return nil
+	})
+	if !c.GetShaderiv(shader, c.DELETE_STATUS) {
+		t.Error("GetShaderiv of DELETE_STATUS returned false, want the true given by Do")
+	}
+	if !c.GetShaderiv(shader, c.COMPILE_STATUS) {
+		t.Error("GetShaderiv of COMPILE_STATUS returned false, want the default true")
+	}
+
+	calls := m.CallsTo("GetError")
+	if len(calls) != 4 || calls[0].Results[0] != c.OUT_OF_MEMORY {
+		t.Errorf("GetError calls recorded as %v", calls)
+	}
+}
+
+func TestMockVerify(t *testing.T) {
+	c := NewContext()
+	m := c.Mock()
+	tex := c.CreateTexture()
+	m.Expect("BindTexture", c.TEXTURE_2D, tex).
+		Expect("TexParameteri", MockAny, int(c.TEXTURE_MIN_FILTER), int(c.LINEAR)).
+		Expect("BindTexture", int(c.TEXTURE_2D), nil)
+
+	c.BindTexture(c.TEXTURE_2D, tex)
+	c.TexParameteri(c.TEXTURE_2D, c.TEXTURE_MAG_FILTER, c.NEAREST)
+	c.TexParameteri(c.TEXTURE_2D, c.TEXTURE_MIN_FILTER, c.LINEAR)
+	if err, want := m.Verify(), "gl: expected call BindTexture(3553, nil) wasn't made after TexParameteri(MockAny, 10241, 9729)"; err == nil || err.Error() != want {
+		t.Errorf("Verify returned %v, want %s", err, want)
+	}
+	c.BindTexture(c.TEXTURE_2D, nil)
+	if err := m.Verify(); err != nil {
+		t.Error(err)
+	}
+
+	m.Reset()
+	c.BindTexture(c.TEXTURE_2D, nil)
+	m.Expect("TexParameteri").Expect("BindTexture")
+	if err, want := m.Verify(), "gl: expected call TexParameteri() wasn't made"; err == nil || err.Error() != want {
+		t.Errorf("Verify returned %v, want %s", err, want)
+	}
+}
@@ -0,0 +1,1211 @@
+//go:build nogl
+// +build nogl
+
+package gl
+
+import (
+	"fmt"
+	"image"
+)
+
+// Limits of the nogl backend, reported by Capabilities.
+const (
+	noglMaxVertexAttribs    = 8
+	noglMaxTextureUnits     = 8
+	noglMaxVaryingVectors   = 8
+	noglMaxTextureSize      = 2048
+	noglMaxRenderbufferSize = 2048
+)
+
+// noglBuffer is a buffer object. Only the size of its data is kept.
+type noglBuffer struct {
+	id    uint32
+	size  int
+	usage Enum
+}
+
+// noglTexture is a 2D texture object. Only the size and format of its base
+// level are kept.
+type noglTexture struct {
+	id     uint32
+	width  int
+	height int
+	format Enum
+}
+
+// noglRenderbuffer is a renderbuffer object.
+type noglRenderbuffer struct {
+	id     uint32
+	format Enum
+	width  int
+	height int
+}
+
+// noglAttachment is an image attached to a framebuffer object, either a
+// texture or a renderbuffer.
+type noglAttachment struct {
+	texture      *noglTexture
+	renderbuffer *noglRenderbuffer
+}
+
+func (a noglAttachment) attached() bool {
+	return a.texture != nil || a.renderbuffer != nil
+}
+
+// size returns the size of the attached image.
+func (a noglAttachment) size() (width, height int) {
+	if a.texture != nil {
+		return a.texture.width, a.texture.height
+	}
+	if a.renderbuffer != nil {
+		return a.renderbuffer.width, a.renderbuffer.height
+	}
+	return 0, 0
+}
+
+// noglFramebuffer is a framebuffer object.
+type noglFramebuffer struct {
+	id      uint32
+	color   noglAttachment
+	depth   noglAttachment
+	stencil noglAttachment
+}
+
+// detach removes the attachments for which match returns true.
+func (fb *noglFramebuffer) detach(match func(a noglAttachment) bool) {
+	for _, a := range []*noglAttachment{&fb.color, &fb.depth, &fb.stencil} {
+		if a.attached() && match(*a) {
+			*a = noglAttachment{}
+		}
+	}
+}
+
+// noglShader is a shader object.
+type noglShader struct {
+	id       uint32
+	typ      Enum
+	source   string
+	compiled bool
+
+	// deleted is set by DeleteShader, and attached counts the programs the
+	// shader is attached to. It is deleted once flagged and attached to none.
+	deleted  bool
+	attached int
+}
+
+// noglProgram is a program object.
+type noglProgram struct {
+	id        uint32
+	shaders   []*noglShader
+	linked    bool
+	validated bool
+	log       string
+
+	// deleted is set by DeleteProgram. The program stays alive as long as
+	// it is in use.
+	deleted bool
+}
+
+// setError records code as the result of the next GetError, unless an error
+// is already pending. As in OpenGL, the call that raised it has no effect.
+func (c *Context) setError(code Enum) {
+	if c.err == c.NO_ERROR {
+		c.err = code
+	}
+}
+
+// newID returns an unused object name.
+func (c *Context) newID() uint32 {
+	c.lastID++
+	return c.lastID
+}
+
+// ObjectCounts holds the number of objects of each kind.
+type ObjectCounts struct {
+	Buffers       int
+	Textures      int
+	Renderbuffers int
+	Framebuffers  int
+	Shaders       int
+	Programs      int
+}
+
+// LiveObjects returns the number of objects of each kind that were created
+// and not deleted yet, to help find leaks.
+func (c *Context) LiveObjects() ObjectCounts {
+	return ObjectCounts{
+		Buffers:       len(c.buffers),
+		Textures:      len(c.textures),
+		Renderbuffers: len(c.renderbuffers),
+		Framebuffers:  len(c.framebuffers),
+		Shaders:       len(c.shaders),
+		Programs:      len(c.programs),
+	}
+}
+
+// Buffer --------------------------------------------------------------------
+
+func (c *Context) CreateBuffer() *Buffer {
+	b := &noglBuffer{id: c.newID(), usage: c.STATIC_DRAW}
+	c.buffers[b.id] = b
+	r := &Buffer{b.id}
+	c.mockCall("CreateBuffer", nil, &r)
+	return r
+}
+
+func (c *Context) DeleteBuffer(buffer *Buffer) {
+	if b := c.buffers[bufferID(buffer)]; b != nil {
+		delete(c.buffers, b.id)
+		if c.arrayBuffer == b {
+			c.arrayBuffer = nil
+		}
+		if c.elementBuffer == b {
+			c.elementBuffer = nil
+		}
+		for i := range c.attribBuffers {
+			if c.attribBuffers[i] == b {
+				c.attribBuffers[i] = nil
+			}
+		}
+	}
+	c.mockCall("DeleteBuffer", args(buffer))
+}
+
+func (c *Context) IsBuffer(buffer *Buffer) bool {
+	r := c.buffers[bufferID(buffer)] != nil
+	c.mockCall("IsBuffer", args(buffer), &r)
+	return r
+}
+
+func (c *Context) BindBuffer(target Enum, buffer *Buffer) {
+	c.bindBuffer(target, buffer)
+	c.mockCall("BindBuffer", args(target, buffer))
+}
+
+func (c *Context) bindBuffer(target Enum, buffer *Buffer) {
+	var b *noglBuffer
+	if id := bufferID(buffer); id != 0 {
+		if b = c.buffers[id]; b == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	switch target {
+	case c.ARRAY_BUFFER:
+		c.arrayBuffer = b
+	case c.ELEMENT_ARRAY_BUFFER:
+		c.elementBuffer = b
+	default:
+		c.setError(c.INVALID_ENUM)
+	}
+}
+
+// boundBuffer returns the buffer bound to target, raising an error and
+// returning nil if there is none.
+func (c *Context) boundBuffer(target Enum) *noglBuffer {
+	var b *noglBuffer
+	switch target {
+	case c.ARRAY_BUFFER:
+		b = c.arrayBuffer
+	case c.ELEMENT_ARRAY_BUFFER:
+		b = c.elementBuffer
+	default:
+		c.setError(c.INVALID_ENUM)
+		return nil
+	}
+	if b == nil {
+		c.setError(c.INVALID_OPERATION)
+	}
+	return b
+}
+
+// BufferData sets the size of the bound buffer to that of the slice data, or
+// to data bytes if it is an int.
+func (c *Context) BufferData(target Enum, data interface{}, usage Enum) {
+	c.bufferData(target, data, usage)
+	c.mockCall("BufferData", args(target, data, usage))
+}
+
+func (c *Context) bufferData(target Enum, data interface{}, usage Enum) {
+	b := c.boundBuffer(target)
+	if b == nil {
+		return
+	}
+	switch usage {
+	case c.STREAM_DRAW, c.STATIC_DRAW, c.DYNAMIC_DRAW:
+	default:
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	size, ok := data.(int)
+	if !ok {
+		p, err := bufferBytes(data)
+		if err != nil {
+			c.setError(c.INVALID_VALUE)
+			return
+		}
+		size = len(p)
+	}
+	if size < 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	b.size, b.usage = size, usage
+}
+
+func (c *Context) BufferSubData(target Enum, offset int, data interface{}) {
+	if b := c.boundBuffer(target); b != nil {
+		p, err := bufferBytes(data)
+		if err != nil || offset < 0 || offset+len(p) > b.size {
+			c.setError(c.INVALID_VALUE)
+		}
+	}
+	c.mockCall("BufferSubData", args(target, offset, data))
+}
+
+func (c *Context) GetBufferParameter(target, pname Enum) int {
+	var r int
+	if b := c.boundBuffer(target); b != nil {
+		switch pname {
+		case c.BUFFER_SIZE:
+			r = b.size
+		case c.BUFFER_USAGE:
+			r = int(b.usage)
+		default:
+			c.setError(c.INVALID_ENUM)
+		}
+	}
+	c.mockCall("GetBufferParameter", args(target, pname), &r)
+	return r
+}
+
+func (c *Context) VertexAttribPointer(index, size int, typ Enum, normal bool, stride, offset int) {
+	switch {
+	case index < 0 || index >= noglMaxVertexAttribs || size < 1 || size > 4 || stride < 0 || offset < 0:
+		c.setError(c.INVALID_VALUE)
+	case c.arrayBuffer == nil:
+		c.setError(c.INVALID_OPERATION)
+	default:
+		c.attribBuffers[index] = c.arrayBuffer
+	}
+	c.mockCall("VertexAttribPointer", args(index, size, typ, normal, stride, offset))
+}
+
+func bufferID(buffer *Buffer) uint32 {
+	if buffer == nil {
+		return 0
+	}
+	return buffer.uint32
+}
+
+// Texture -------------------------------------------------------------------
+
+func (c *Context) CreateTexture() *Texture {
+	t := &noglTexture{id: c.newID(), format: c.RGBA}
+	c.textures[t.id] = t
+	r := &Texture{t.id}
+	c.mockCall("CreateTexture", nil, &r)
+	return r
+}
+
+// DeleteTexture deletes the texture, unbinding it from every texture unit and
+// from the bound framebuffer.
+func (c *Context) DeleteTexture(texture *Texture) {
+	if t := c.textures[textureID(texture)]; t != nil {
+		delete(c.textures, t.id)
+		for i := range c.textureUnits {
+			if c.textureUnits[i] == t {
+				c.textureUnits[i] = nil
+			}
+		}
+		if fb := c.framebuffer; fb != nil {
+			fb.detach(func(a noglAttachment) bool { return a.texture == t })
+		}
+	}
+	c.mockCall("DeleteTexture", args(texture))
+}
+
+func (c *Context) IsTexture(texture *Texture) bool {
+	r := c.textures[textureID(texture)] != nil
+	c.mockCall("IsTexture", args(texture), &r)
+	return r
+}
+
+// TextureSize returns the size of the base level of the texture, or 0×0 if
+// it has no image or isn't a texture.
+func (c *Context) TextureSize(texture *Texture) (width, height int) {
+	if t := c.textures[textureID(texture)]; t != nil {
+		return t.width, t.height
+	}
+	return 0, 0
+}
+
+func (c *Context) ActiveTexture(target Enum) {
+	unit := int(target - c.TEXTURE0)
+	if unit < 0 || unit >= noglMaxTextureUnits {
+		c.setError(c.INVALID_ENUM)
+	} else {
+		c.activeTexture = unit
+	}
+	c.mockCall("ActiveTexture", args(target))
+}
+
+func (c *Context) BindTexture(target Enum, texture *Texture) {
+	c.bindTexture(target, texture)
+	c.mockCall("BindTexture", args(target, texture))
+}
+
+func (c *Context) bindTexture(target Enum, texture *Texture) {
+	if target != c.TEXTURE_2D {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	var t *noglTexture
+	if id := textureID(texture); id != 0 {
+		if t = c.textures[id]; t == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	c.textureUnits[c.activeTexture] = t
+}
+
+// boundTexture returns the texture bound to target on the active unit,
+// raising an error and returning nil if there is none.
+func (c *Context) boundTexture(target Enum) *noglTexture {
+	if target != c.TEXTURE_2D {
+		c.setError(c.INVALID_ENUM)
+		return nil
+	}
+	t := c.textureUnits[c.activeTexture]
+	if t == nil {
+		c.setError(c.INVALID_OPERATION)
+	}
+	return t
+}
+
+func (c *Context) TexParameteri(target, pname, param Enum) {
+	if !c.unsupportedEnum(target) && !c.unsupportedEnum(pname) && !c.unsupportedTexParameter(pname, param) {
+		c.boundTexture(target)
+	}
+	c.mockCall("TexParameteri", args(target, pname, param))
+}
+
+// TexImage2D sets the size and format of the bound texture to those of the
+// image.Image data, or to 0×0 if data is nil.
+func (c *Context) TexImage2D(target Enum, level int, internalFormat, format, kind Enum, data interface{}) error {
+	var r error
+	switch img := data.(type) {
+	case nil:
+		c.texImage(target, level, format, kind, 0, 0)
+	case image.Image:
+		c.texImage(target, level, format, kind, img.Bounds().Dx(), img.Bounds().Dy())
+	default:
+		r = fmt.Errorf("gl: image type unsupported: %T", data)
+	}
+	c.mockCall("TexImage2D", args(target, level, internalFormat, format, kind, data), &r)
+	return r
+}
+
+func (c *Context) TexImage2DEmpty(target Enum, level int, internalFormat, format, kind Enum, width, height int) {
+	c.texImage(target, level, format, kind, width, height)
+	c.mockCall("TexImage2DEmpty", args(target, level, internalFormat, format, kind, width, height))
+}
+
+// texImage sets the size and format of the bound texture. Levels other than
+// 0 are only checked.
+func (c *Context) texImage(target Enum, level int, format, kind Enum, width, height int) {
+	t := c.boundTexture(target)
+	if t == nil {
+		return
+	}
+	if level < 0 || width < 0 || height < 0 || width > noglMaxTextureSize || height > noglMaxTextureSize {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	if c.bytesPerPixel(format, kind) == 0 {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	if level == 0 {
+		t.width, t.height, t.format = width, height, format
+	}
+}
+
+// TexSubImage2D checks that the width×height rectangle at (xoffset, yoffset)
+// lies within the bound texture, and that data holds enough pixels for it.
+func (c *Context) TexSubImage2D(target Enum, level, xoffset, yoffset, width, height int, format, kind Enum, data interface{}) error {
+	_, r := c.texturePixels(data, width, height, format, kind)
+	if r == nil {
+		c.texSubImage(target, level, xoffset, yoffset, width, height)
+		if c.bytesPerPixel(format, kind) == 0 {
+			c.setError(c.INVALID_ENUM)
+		}
+	}
+	c.mockCall("TexSubImage2D", args(target, level, xoffset, yoffset, width, height, format, kind, data), &r)
+	return r
+}
+
+// texSubImage raises an error if the rectangle doesn't lie within the base
+// level of the bound texture.
+func (c *Context) texSubImage(target Enum, level, xoffset, yoffset, width, height int) {
+	t := c.boundTexture(target)
+	if t == nil || level != 0 {
+		return
+	}
+	if xoffset < 0 || yoffset < 0 || width < 0 || height < 0 || xoffset+width > t.width || yoffset+height > t.height {
+		c.setError(c.INVALID_VALUE)
+	}
+}
+
+func (c *Context) CopyTexImage2D(target Enum, level int, internal Enum, x, y, w, h, border int) {
+	switch {
+	case border != 0:
+		c.setError(c.INVALID_VALUE)
+	case internal != c.ALPHA && internal != c.LUMINANCE && internal != c.LUMINANCE_ALPHA && internal != c.RGB && internal != c.RGBA:
+		c.setError(c.INVALID_ENUM)
+	case c.framebufferComplete():
+		c.texImage(target, level, internal, c.UNSIGNED_BYTE, w, h)
+	}
+	c.mockCall("CopyTexImage2D", args(target, level, internal, x, y, w, h, border))
+}
+
+func (c *Context) CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, w, h int) {
+	if c.framebufferComplete() {
+		c.texSubImage(target, level, xoffset, yoffset, w, h)
+	}
+	c.mockCall("CopyTexSubImage2D", args(target, level, xoffset, yoffset, x, y, w, h))
+}
+
+// GenerateMipmap raises INVALID_OPERATION unless the bound texture has a
+// power of two size, as in OpenGL ES 2.0.
+func (c *Context) GenerateMipmap(target Enum) {
+	if t := c.boundTexture(target); t != nil && (!isPowerOfTwo(t.width) || !isPowerOfTwo(t.height)) {
+		c.setError(c.INVALID_OPERATION)
+	}
+	c.mockCall("GenerateMipmap", args(target))
+}
+
+func textureID(texture *Texture) uint32 {
+	if texture == nil {
+		return 0
+	}
+	return texture.uint32
+}
+
+// RenderBuffer --------------------------------------------------------------
+
+func (c *Context) CreateRenderBuffer() *RenderBuffer {
+	rb := &noglRenderbuffer{id: c.newID(), format: c.RGBA4}
+	c.renderbuffers[rb.id] = rb
+	r := &RenderBuffer{rb.id}
+	c.mockCall("CreateRenderBuffer", nil, &r)
+	return r
+}
+
+func (c *Context) DeleteRenderBuffer(rb *RenderBuffer) {
+	if r := c.renderbuffers[renderbufferID(rb)]; r != nil {
+		delete(c.renderbuffers, r.id)
+		if c.renderbuffer == r {
+			c.renderbuffer = nil
+		}
+		if fb := c.framebuffer; fb != nil {
+			fb.detach(func(a noglAttachment) bool { return a.renderbuffer == r })
+		}
+	}
+	c.mockCall("DeleteRenderBuffer", args(rb))
+}
+
+func (c *Context) IsRenderbuffer(rb *RenderBuffer) bool {
+	r := c.renderbuffers[renderbufferID(rb)] != nil
+	c.mockCall("IsRenderbuffer", args(rb), &r)
+	return r
+}
+
+func (c *Context) BindRenderBuffer(rb *RenderBuffer) {
+	c.bindRenderBuffer(rb)
+	c.mockCall("BindRenderBuffer", args(rb))
+}
+
+func (c *Context) bindRenderBuffer(rb *RenderBuffer) {
+	var r *noglRenderbuffer
+	if id := renderbufferID(rb); id != 0 {
+		if r = c.renderbuffers[id]; r == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	c.renderbuffer = r
+}
+
+// RenderBufferStorage sets the size and format of the bound renderbuffer.
+func (c *Context) RenderBufferStorage(internalFormat Enum, width, height int) {
+	switch r := c.renderbuffer; {
+	case r == nil:
+		c.setError(c.INVALID_OPERATION)
+	case width < 0 || height < 0 || width > noglMaxRenderbufferSize || height > noglMaxRenderbufferSize:
+		c.setError(c.INVALID_VALUE)
+	case !c.colorRenderable(internalFormat) && !c.depthRenderable(internalFormat) && !c.stencilRenderable(internalFormat):
+		c.setError(c.INVALID_ENUM)
+	default:
+		r.format, r.width, r.height = internalFormat, width, height
+	}
+	c.mockCall("RenderBufferStorage", args(internalFormat, width, height))
+}
+
+func (c *Context) colorRenderable(format Enum) bool {
+	switch format {
+	case c.RGBA4, c.RGB565, c.RGB5_A1, c.RGBA8:
+		return true
+	}
+	return false
+}
+
+func (c *Context) depthRenderable(format Enum) bool {
+	return format == c.DEPTH_COMPONENT16 || format == c.DEPTH_STENCIL
+}
+
+func (c *Context) stencilRenderable(format Enum) bool {
+	return format == c.STENCIL_INDEX8 || format == c.DEPTH_STENCIL
+}
+
+func (c *Context) GetRenderbufferParameter(target, pname Enum) int {
+	var r int
+	switch rb := c.renderbuffer; {
+	case target != c.RENDERBUFFER:
+		c.setError(c.INVALID_ENUM)
+	case rb == nil:
+		c.setError(c.INVALID_OPERATION)
+	case pname == c.RENDERBUFFER_WIDTH:
+		r = rb.width
+	case pname == c.RENDERBUFFER_HEIGHT:
+		r = rb.height
+	case pname == c.RENDERBUFFER_INTERNAL_FORMAT:
+		r = int(rb.format)
+	case pname == c.RENDERBUFFER_RED_SIZE, pname == c.RENDERBUFFER_GREEN_SIZE,
+		pname == c.RENDERBUFFER_BLUE_SIZE, pname == c.RENDERBUFFER_ALPHA_SIZE:
+		r = 8 * boolInt(c.colorRenderable(rb.format) && rb.width > 0)
+	case pname == c.RENDERBUFFER_DEPTH_SIZE:
+		r = 16 * boolInt(c.depthRenderable(rb.format) && rb.width > 0)
+	case pname == c.RENDERBUFFER_STENCIL_SIZE:
+		r = 8 * boolInt(c.stencilRenderable(rb.format) && rb.width > 0)
+	default:
+		c.setError(c.INVALID_ENUM)
+	}
+	c.mockCall("GetRenderbufferParameter", args(target, pname), &r)
+	return r
+}
+
+func renderbufferID(rb *RenderBuffer) uint32 {
+	if rb == nil {
+		return 0
+	}
+	return rb.uint32
+}
+
+// FrameBuffer ---------------------------------------------------------------
+
+func (c *Context) CreateFrameBuffer() *FrameBuffer {
+	fb := &noglFramebuffer{id: c.newID()}
+	c.framebuffers[fb.id] = fb
+	r := &FrameBuffer{fb.id}
+	c.mockCall("CreateFrameBuffer", nil, &r)
+	return r
+}
+
+func (c *Context) DeleteFrameBuffer(fb *FrameBuffer) {
+	if f := c.framebuffers[framebufferID(fb)]; f != nil {
+		delete(c.framebuffers, f.id)
+		if c.framebuffer == f {
+			c.framebuffer = nil
+		}
+	}
+	c.mockCall("DeleteFrameBuffer", args(fb))
+}
+
+func (c *Context) IsFramebuffer(fb *FrameBuffer) bool {
+	r := c.framebuffers[framebufferID(fb)] != nil
+	c.mockCall("IsFramebuffer", args(fb), &r)
+	return r
+}
+
+// BindFrameBuffer binds a framebuffer, or the default framebuffer if fb is
+// nil.
+func (c *Context) BindFrameBuffer(fb *FrameBuffer) {
+	c.bindFrameBuffer(fb)
+	c.mockCall("BindFrameBuffer", args(fb))
+}
+
+func (c *Context) bindFrameBuffer(fb *FrameBuffer) {
+	var f *noglFramebuffer
+	if id := framebufferID(fb); id != 0 {
+		if f = c.framebuffers[id]; f == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	c.framebuffer = f
+}
+
+// attachmentPoint returns the attachments of the bound framebuffer selected
+// by target and attachment, raising an error and returning nil if there are
+// none.
+func (c *Context) attachmentPoint(target, attachment Enum) []*noglAttachment {
+	if target != c.FRAMEBUFFER {
+		c.setError(c.INVALID_ENUM)
+		return nil
+	}
+	fb := c.framebuffer
+	if fb == nil {
+		c.setError(c.INVALID_OPERATION)
+		return nil
+	}
+	switch attachment {
+	case c.COLOR_ATTACHMENT0:
+		return []*noglAttachment{&fb.color}
+	case c.DEPTH_ATTACHMENT:
+		return []*noglAttachment{&fb.depth}
+	case c.STENCIL_ATTACHMENT:
+		return []*noglAttachment{&fb.stencil}
+	case c.DEPTH_STENCIL_ATTACHMENT:
+		return []*noglAttachment{&fb.depth, &fb.stencil}
+	}
+	c.setError(c.INVALID_ENUM)
+	return nil
+}
+
+// FrameBufferTexture2D attaches level 0 of a texture to the bound framebuffer,
+// or detaches the attachment if t is nil.
+func (c *Context) FrameBufferTexture2D(target, attachment, texTarget Enum, t *Texture, level int) {
+	c.attach(target, attachment, func() (noglAttachment, bool) {
+		id := textureID(t)
+		if id == 0 {
+			return noglAttachment{}, true
+		}
+		if texTarget != c.TEXTURE_2D {
+			c.setError(c.INVALID_ENUM)
+			return noglAttachment{}, false
+		}
+		if level != 0 {
+			c.setError(c.INVALID_VALUE)
+			return noglAttachment{}, false
+		}
+		tex := c.textures[id]
+		if tex == nil {
+			c.setError(c.INVALID_OPERATION)
+			return noglAttachment{}, false
+		}
+		return noglAttachment{texture: tex}, true
+	})
+	c.mockCall("FrameBufferTexture2D", args(target, attachment, texTarget, t, level))
+}
+
+// FrameBufferRenderBuffer attaches a renderbuffer to the bound framebuffer,
+// or detaches the attachment if rb is nil.
+func (c *Context) FrameBufferRenderBuffer(target, attachment Enum, rb *RenderBuffer) {
+	c.attach(target, attachment, func() (noglAttachment, bool) {
+		id := renderbufferID(rb)
+		if id == 0 {
+			return noglAttachment{}, true
+		}
+		r := c.renderbuffers[id]
+		if r == nil {
+			c.setError(c.INVALID_OPERATION)
+			return noglAttachment{}, false
+		}
+		return noglAttachment{renderbuffer: r}, true
+	})
+	c.mockCall("FrameBufferRenderBuffer", args(target, attachment, rb))
+}
+
+// attach stores the attachment returned by image at the attachment point of
+// the bound framebuffer, unless image returns false.
+func (c *Context) attach(target, attachment Enum, image func() (noglAttachment, bool)) {
+	points := c.attachmentPoint(target, attachment)
+	if points == nil {
+		return
+	}
+	a, ok := image()
+	if !ok {
+		return
+	}
+	for _, p := range points {
+		*p = a
+	}
+}
+
+func (c *Context) CheckFramebufferStatus(target Enum) Enum {
+	var r Enum
+	if target != c.FRAMEBUFFER {
+		c.setError(c.INVALID_ENUM)
+	} else {
+		r = c.framebufferStatus()
+	}
+	c.mockCall("CheckFramebufferStatus", args(target), &r)
+	return r
+}
+
+// framebufferStatus returns the completeness status of the bound framebuffer.
+func (c *Context) framebufferStatus() Enum {
+	fb := c.framebuffer
+	if fb == nil {
+		return c.FRAMEBUFFER_COMPLETE
+	}
+	if !fb.color.attached() && !fb.depth.attached() && !fb.stencil.attached() {
+		return c.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT
+	}
+
+	valid := []bool{
+		!fb.color.attached() || fb.color.texture != nil || c.colorRenderable(fb.color.renderbuffer.format),
+		!fb.depth.attached() || fb.depth.renderbuffer != nil && c.depthRenderable(fb.depth.renderbuffer.format),
+		!fb.stencil.attached() || fb.stencil.renderbuffer != nil && c.stencilRenderable(fb.stencil.renderbuffer.format),
+	}
+	width, height := -1, -1
+	for i, a := range []noglAttachment{fb.color, fb.depth, fb.stencil} {
+		if !a.attached() {
+			continue
+		}
+		w, h := a.size()
+		if !valid[i] || w == 0 || h == 0 {
+			return c.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+		}
+		if width >= 0 && (w != width || h != height) {
+			return c.FRAMEBUFFER_INCOMPLETE_DIMENSIONS
+		}
+		width, height = w, h
+	}
+	return c.FRAMEBUFFER_COMPLETE
+}
+
+// framebufferComplete reports whether the bound framebuffer is complete,
+// raising INVALID_FRAMEBUFFER_OPERATION if not.
+func (c *Context) framebufferComplete() bool {
+	if c.framebufferStatus() != c.FRAMEBUFFER_COMPLETE {
+		c.setError(c.INVALID_FRAMEBUFFER_OPERATION)
+		return false
+	}
+	return true
+}
+
+func framebufferID(fb *FrameBuffer) uint32 {
+	if fb == nil {
+		return 0
+	}
+	return fb.uint32
+}
+
+// Shaders and Programs ------------------------------------------------------
+
+func (c *Context) CreateShader(typ Enum) *Shader {
+	r := &Shader{0}
+	if typ != c.VERTEX_SHADER && typ != c.FRAGMENT_SHADER {
+		c.setError(c.INVALID_ENUM)
+	} else {
+		s := &noglShader{id: c.newID(), typ: typ}
+		c.shaders[s.id] = s
+		r = &Shader{s.id}
+	}
+	c.mockCall("CreateShader", args(typ), &r)
+	return r
+}
+
+// shader returns the shader object, raising INVALID_VALUE and returning nil if
+// there is none.
+func (c *Context) shader(shader *Shader) *noglShader {
+	var s *noglShader
+	if shader != nil {
+		s = c.shaders[shader.uint32]
+	}
+	if s == nil {
+		c.setError(c.INVALID_VALUE)
+	}
+	return s
+}
+
+// DeleteShader flags the shader for deletion. It is deleted once it isn't
+// attached to any program, so programs it is attached to keep using it.
+func (c *Context) DeleteShader(shader *Shader) {
+	if shader != nil {
+		if s := c.shaders[shader.uint32]; s != nil {
+			s.deleted = true
+			c.freeShader(s)
+		}
+	}
+	c.mockCall("DeleteShader", args(shader))
+}
+
+// freeShader deletes s if it is flagged for deletion and no longer attached.
+func (c *Context) freeShader(s *noglShader) {
+	if s.deleted && s.attached == 0 {
+		delete(c.shaders, s.id)
+	}
+}
+
+func (c *Context) IsShader(shader *Shader) bool {
+	r := shader != nil && c.shaders[shader.uint32] != nil
+	c.mockCall("IsShader", args(shader), &r)
+	return r
+}
+
+func (c *Context) ShaderSource(shader *Shader, source string) {
+	if s := c.shader(shader); s != nil {
+		s.source = source
+	}
+	c.mockCall("ShaderSource", args(shader, source))
+}
+
+func (c *Context) GetShaderSource(shader *Shader) string {
+	var r string
+	if s := c.shader(shader); s != nil {
+		r = s.source
+	}
+	c.mockCall("GetShaderSource", args(shader), &r)
+	return r
+}
+
+// CompileShader marks the shader as compiled. The source isn't checked, so
+// compiling always succeeds.
+func (c *Context) CompileShader(shader *Shader) {
+	if s := c.shader(shader); s != nil {
+		s.compiled = true
+	}
+	c.mockCall("CompileShader", args(shader))
+}
+
+// Returns a parameter from a shader object
+func (c *Context) GetShaderiv(shader *Shader, pname Enum) bool {
+	r := c.shaderParameter(shader, pname) != 0
+	c.mockCall("GetShaderiv", args(shader, pname), &r)
+	return r
+}
+
+func (c *Context) GetShaderParameteri(shader *Shader, pname Enum) int {
+	r := c.shaderParameter(shader, pname)
+	c.mockCall("GetShaderParameteri", args(shader, pname), &r)
+	return r
+}
+
+func (c *Context) shaderParameter(shader *Shader, pname Enum) int {
+	s := c.shader(shader)
+	if s == nil {
+		return 0
+	}
+	switch pname {
+	case c.SHADER_TYPE:
+		return int(s.typ)
+	case c.COMPILE_STATUS:
+		return boolInt(s.compiled)
+	case c.DELETE_STATUS:
+		return boolInt(s.deleted)
+	case c.INFO_LOG_LENGTH:
+		return 0
+	case c.SHADER_SOURCE_LENGTH:
+		return infoLogLength(s.source)
+	}
+	c.setError(c.INVALID_ENUM)
+	return 0
+}
+
+func (c *Context) GetShaderInfoLog(shader *Shader) string {
+	c.shader(shader)
+	var r string
+	c.mockCall("GetShaderInfoLog", args(shader), &r)
+	return r
+}
+
+func (c *Context) CreateProgram() *Program {
+	p := &noglProgram{id: c.newID()}
+	c.programs[p.id] = p
+	r := &Program{p.id}
+	c.mockCall("CreateProgram", nil, &r)
+	return r
+}
+
+// programObject returns the program object, raising INVALID_VALUE and
+// returning nil if there is none.
+func (c *Context) programObject(program *Program) *noglProgram {
+	var p *noglProgram
+	if program != nil {
+		p = c.programs[program.uint32]
+	}
+	if p == nil {
+		c.setError(c.INVALID_VALUE)
+	}
+	return p
+}
+
+// linkedProgram returns the program if it is linked, raising an error and
+// returning nil otherwise.
+func (c *Context) linkedProgram(program *Program) *noglProgram {
+	p := c.programObject(program)
+	if p != nil && !p.linked {
+		c.setError(c.INVALID_OPERATION)
+		return nil
+	}
+	return p
+}
+
+// DeleteProgram flags the program for deletion. If it is in use, it is
+// deleted once another program is, otherwise right away. The shaders attached
+// to it are detached when it is deleted.
+func (c *Context) DeleteProgram(program *Program) {
+	if program != nil {
+		if p := c.programs[program.uint32]; p != nil {
+			p.deleted = true
+			c.freeProgram(p)
+		}
+	}
+	c.mockCall("DeleteProgram", args(program))
+}
+
+// freeProgram deletes p if it is flagged for deletion and no longer in use.
+func (c *Context) freeProgram(p *noglProgram) {
+	if !p.deleted || c.program == p {
+		return
+	}
+	for _, s := range p.shaders {
+		s.attached--
+		c.freeShader(s)
+	}
+	p.shaders = nil
+	delete(c.programs, p.id)
+}
+
+func (c *Context) IsProgram(program *Program) bool {
+	r := program != nil && c.programs[program.uint32] != nil
+	c.mockCall("IsProgram", args(program), &r)
+	return r
+}
+
+func (c *Context) AttachShader(program *Program, shader *Shader) {
+	c.attachShader(program, shader)
+	c.mockCall("AttachShader", args(program, shader))
+}
+
+func (c *Context) attachShader(program *Program, shader *Shader) {
+	p, s := c.programObject(program), c.shader(shader)
+	if p == nil || s == nil {
+		return
+	}
+	for _, attached := range p.shaders {
+		if attached.typ == s.typ {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	p.shaders = append(p.shaders, s)
+	s.attached++
+}
+
+func (c *Context) DetachShader(program *Program, shader *Shader) {
+	c.detachShader(program, shader)
+	c.mockCall("DetachShader", args(program, shader))
+}
+
+func (c *Context) detachShader(program *Program, shader *Shader) {
+	p := c.programObject(program)
+	if p == nil || shader == nil {
+		return
+	}
+	for i, s := range p.shaders {
+		if s.id == shader.uint32 {
+			p.shaders = append(p.shaders[:i], p.shaders[i+1:]...)
+			s.attached--
+			c.freeShader(s)
+			return
+		}
+	}
+	c.setError(c.INVALID_OPERATION)
+}
+
+func (c *Context) GetAttachedShaders(program *Program) []*Shader {
+	var r []*Shader
+	if p := c.programObject(program); p != nil {
+		r = make([]*Shader, len(p.shaders))
+		for i, s := range p.shaders {
+			r[i] = &Shader{s.id}
+		}
+	}
+	c.mockCall("GetAttachedShaders", args(program), &r)
+	return r
+}
+
+func (c *Context) BindAttribLocation(program *Program, index int, name string) {
+	if c.programObject(program) != nil && (index < 0 || index >= noglMaxVertexAttribs) {
+		c.setError(c.INVALID_VALUE)
+	}
+	c.mockCall("BindAttribLocation", args(program, index, name))
+}
+
+// LinkProgram links the program if a compiled vertex shader and a compiled
+// fragment shader are attached to it.
+func (c *Context) LinkProgram(program *Program) {
+	if p := c.programObject(program); p != nil {
+		p.linked, p.validated, p.log = false, false, ""
+		var vs, fs *noglShader
+		for _, s := range p.shaders {
+			if s.typ == c.VERTEX_SHADER {
+				vs = s
+			} else {
+				fs = s
+			}
+		}
+		switch {
+		case vs == nil || fs == nil:
+			p.log = "ERROR: 0:0: nogl: a vertex and a fragment shader must be attached\n"
+		case !vs.compiled || !fs.compiled:
+			p.log = "ERROR: 0:0: nogl: attached shaders must be compiled\n"
+		default:
+			p.linked = true
+		}
+	}
+	c.mockCall("LinkProgram", args(program))
+}
+
+// UseProgram makes program the current program, or unsets it if program is
+// nil. The program used before is deleted if it was flagged for deletion.
+func (c *Context) UseProgram(program *Program) {
+	previous := c.program
+	if program == nil || program.uint32 == 0 {
+		c.program = nil
+	} else if p := c.programObject(program); p != nil {
+		if p.linked {
+			c.program = p
+		} else {
+			c.setError(c.INVALID_OPERATION)
+		}
+	}
+	if previous != nil {
+		c.freeProgram(previous)
+	}
+	c.mockCall("UseProgram", args(program))
+}
+
+// ValidateProgram marks the program as valid if it is linked.
+func (c *Context) ValidateProgram(program *Program) {
+	if p := c.programObject(program); p != nil {
+		p.validated = p.linked
+	}
+	c.mockCall("ValidateProgram", args(program))
+}
+
+func (c *Context) GetProgramParameteri(program *Program, pname Enum) int {
+	r := c.programParameter(program, pname)
+	c.mockCall("GetProgramParameteri", args(program, pname), &r)
+	return r
+}
+
+func (c *Context) GetProgramParameterb(program *Program, pname Enum) bool {
+	r := c.programParameter(program, pname) != 0
+	c.mockCall("GetProgramParameterb", args(program, pname), &r)
+	return r
+}
+
+func (c *Context) programParameter(program *Program, pname Enum) int {
+	p := c.programObject(program)
+	if p == nil {
+		return 0
+	}
+	switch pname {
+	case c.LINK_STATUS:
+		return boolInt(p.linked)
+	case c.VALIDATE_STATUS:
+		return boolInt(p.validated)
+	case c.DELETE_STATUS:
+		return boolInt(p.deleted)
+	case c.ATTACHED_SHADERS:
+		return len(p.shaders)
+	case c.ACTIVE_ATTRIBUTES, c.ACTIVE_UNIFORMS:
+		return 0
+	case c.INFO_LOG_LENGTH:
+		return infoLogLength(p.log)
+	}
+	c.setError(c.INVALID_ENUM)
+	return 0
+}
+
+func (c *Context) GetProgramInfoLog(program *Program) string {
+	var r string
+	if p := c.programObject(program); p != nil {
+		r = p.log
+	}
+	c.mockCall("GetProgramInfoLog", args(program), &r)
+	return r
+}
+
+// GetAttribLocation returns 0 for every attribute of a linked program, the
+// source of its shaders isn't parsed.
+func (c *Context) GetAttribLocation(program *Program, name string) int {
+	r := -1
+	if c.linkedProgram(program) != nil {
+		r = 0
+	}
+	c.mockCall("GetAttribLocation", args(program, name), &r)
+	return r
+}
+
+// GetUniformLocation returns location 0 for every uniform of a linked
+// program, the source of its shaders isn't parsed.
+func (c *Context) GetUniformLocation(program *Program, name string) *UniformLocation {
+	r := &UniformLocation{-1}
+	if c.linkedProgram(program) != nil {
+		r = &UniformLocation{0}
+	}
+	c.mockCall("GetUniformLocation", args(program, name), &r)
+	return r
+}
+
+// GetActiveAttrib raises INVALID_VALUE, programs have no known active
+// attributes.
+func (c *Context) GetActiveAttrib(program *Program, index int) (name string, size int, typ Enum) {
+	if c.linkedProgram(program) != nil {
+		c.setError(c.INVALID_VALUE)
+	}
+	c.mockCall("GetActiveAttrib", args(program, index), &name, &size, &typ)
+	return name, size, typ
+}
+
+// GetActiveUniform raises INVALID_VALUE, programs have no known active
+// uniforms.
+func (c *Context) GetActiveUniform(program *Program, index int) (name string, size int, typ Enum) {
+	if c.linkedProgram(program) != nil {
+		c.setError(c.INVALID_VALUE)
+	}
+	c.mockCall("GetActiveUniform", args(program, index), &name, &size, &typ)
+	return name, size, typ
+}
+
+// uniform raises INVALID_OPERATION when setting the uniform at location
+// without a current program. Location -1 is ignored, as in OpenGL.
+func (c *Context) uniform(location *UniformLocation) {
+	if location != nil && location.int32 != -1 && c.program == nil {
+		c.setError(c.INVALID_OPERATION)
+	}
+}
+
+// objectID returns the name of a bound object, or 0 if none is bound.
+func objectID(o interface{}) int {
+	switch o := o.(type) {
+	case *noglBuffer:
+		if o != nil {
+			return int(o.id)
+		}
+	case *noglTexture:
+		if o != nil {
+			return int(o.id)
+		}
+	case *noglRenderbuffer:
+		if o != nil {
+			return int(o.id)
+		}
+	case *noglFramebuffer:
+		if o != nil {
+			return int(o.id)
+		}
+	case *noglProgram:
+		if o != nil {
+			return int(o.id)
+		}
+	}
+	return 0
+}
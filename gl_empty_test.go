@@ -0,0 +1,150 @@
+//go:build nogl
+// +build nogl
+
+package gl
+
+import "testing"
+
+// checkError checks that the error raised by the calls made to c since the
+// last check is want.
+func checkError(t *testing.T, c *Context, want Enum) {
+	t.Helper()
+	if got := c.GetError(); got != want {
+		t.Errorf("raised %s, want %s", c.ErrorName(got), c.ErrorName(want))
+	}
+}
+
+// linkProgram returns a linked program and its attached shaders.
+func linkProgram(t *testing.T, c *Context) (*Program, *Shader, *Shader) {
+	t.Helper()
+	vs, fs := c.CreateShader(c.VERTEX_SHADER), c.CreateShader(c.FRAGMENT_SHADER)
+	c.CompileShader(vs)
+	c.CompileShader(fs)
+	program := c.CreateProgram()
+	c.AttachShader(program, vs)
+	c.AttachShader(program, fs)
+	c.LinkProgram(program)
+	if !c.GetProgramParameterb(program, c.LINK_STATUS) {
+		t.Fatal("linking failed")
+	}
+	checkError(t, c, c.NO_ERROR)
+	return program, vs, fs
+}
+
+func TestNoglBindDeleted(t *testing.T) {
+	c := NewContext()
+	tex := c.CreateTexture()
+	c.DeleteTexture(tex)
+	c.BindTexture(c.TEXTURE_2D, tex)
+	checkError(t, c, c.INVALID_OPERATION)
+	c.BindTexture(c.TEXTURE_2D, nil)
+	checkError(t, c, c.NO_ERROR)
+
+	buf := c.CreateBuffer()
+	c.DeleteBuffer(buf)
+	c.BindBuffer(c.ARRAY_BUFFER, buf)
+	checkError(t, c, c.INVALID_OPERATION)
+}
+
+func TestNoglBufferSize(t *testing.T) {
+	c := NewContext()
+	c.BufferData(c.ARRAY_BUFFER, 4, c.STATIC_DRAW)
+	checkError(t, c, c.INVALID_OPERATION)
+
+	c.BindBuffer(c.ARRAY_BUFFER, c.CreateBuffer())
+	c.BufferData(c.ARRAY_BUFFER, []float32{1, 2}, c.STATIC_DRAW)
+	if size := c.GetBufferParameter(c.ARRAY_BUFFER, c.BUFFER_SIZE); size != 8 {
+		t.Errorf("buffer size is %d, want 8", size)
+	}
+	c.BufferSubData(c.ARRAY_BUFFER, 4, []float32{3})
+	checkError(t, c, c.NO_ERROR)
+	c.BufferSubData(c.ARRAY_BUFFER, 6, []uint16{1, 2})
+	checkError(t, c, c.INVALID_VALUE)
+	c.BufferSubData(c.ARRAY_BUFFER, -1, []byte{1})
+	checkError(t, c, c.INVALID_VALUE)
+
+	c.BufferData(c.ARRAY_BUFFER, 16, c.DYNAMIC_DRAW)
+	if size := c.GetBufferParameter(c.ARRAY_BUFFER, c.BUFFER_SIZE); size != 16 {
+		t.Errorf("buffer size is %d, want 16", size)
+	}
+	if usage := c.GetBufferParameter(c.ARRAY_BUFFER, c.BUFFER_USAGE); Enum(usage) != c.DYNAMIC_DRAW {
+		t.Errorf("buffer usage is %s, want DYNAMIC_DRAW", c.EnumName(Enum(usage)))
+	}
+	checkError(t, c, c.NO_ERROR)
+}
+
+func TestNoglDeleteShader(t *testing.T) {
+	c := NewContext()
+	program, vs, _ := linkProgram(t, c)
+
+	c.DeleteShader(vs)
+	if !c.IsShader(vs) {
+		t.Error("an attached shader was deleted right away")
+	}
+	if !c.GetShaderiv(vs, c.DELETE_STATUS) {
+		t.Error("DELETE_STATUS of a shader flagged for deletion is false")
+	}
+	c.DetachShader(program, vs)
+	if c.IsShader(vs) {
+		t.Error("a shader flagged for deletion wasn't deleted once detached")
+	}
+	checkError(t, c, c.NO_ERROR)
+
+	unattached := c.CreateShader(c.VERTEX_SHADER)
+	c.DeleteShader(unattached)
+	if c.IsShader(unattached) {
+		t.Error("an unattached shader wasn't deleted right away")
+	}
+}
+
+func TestNoglDeleteProgram(t *testing.T) {
+	c := NewContext()
+	program, vs, fs := linkProgram(t, c)
+	c.DeleteShader(vs)
+	c.DeleteShader(fs)
+	c.UseProgram(program)
+
+	c.DeleteProgram(program)
+	if !c.IsProgram(program) {
+		t.Error("the program in use was deleted right away")
+	}
+	if !c.GetProgramParameterb(program, c.DELETE_STATUS) {
+		t.Error("DELETE_STATUS of a program flagged for deletion is false")
+	}
+	c.UseProgram(nil)
+	if c.IsProgram(program) {
+		t.Error("a program flagged for deletion wasn't deleted once unused")
+	}
+	if c.IsShader(vs) || c.IsShader(fs) {
+		t.Error("shaders flagged for deletion weren't deleted with their program")
+	}
+	checkError(t, c, c.NO_ERROR)
+
+	other, _, _ := linkProgram(t, c)
+	c.DeleteProgram(other)
+	if c.IsProgram(other) {
+		t.Error("an unused program wasn't deleted right away")
+	}
+}
+
+func TestNoglCapabilities(t *testing.T) {
+	c := NewContext()
+	if !c.IsEnabled(c.DITHER) || c.IsEnabled(c.BLEND) {
+		t.Error("DITHER should be the only capability enabled by default")
+	}
+	c.Enable(c.BLEND)
+	if !c.IsEnabled(c.BLEND) || !c.GetParameterBool(c.BLEND) {
+		t.Error("BLEND isn't enabled after Enable")
+	}
+	c.Disable(c.BLEND)
+	if c.IsEnabled(c.BLEND) || c.GetParameterInt(c.BLEND) != 0 {
+		t.Error("BLEND is enabled after Disable")
+	}
+	checkError(t, c, c.NO_ERROR)
+
+	c.Enable(c.TEXTURE_2D)
+	checkError(t, c, c.INVALID_ENUM)
+	if c.IsEnabled(c.TEXTURE_2D) {
+		t.Error("TEXTURE_2D isn't a capability but was enabled")
+	}
+}
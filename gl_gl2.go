@@ -2,12 +2,13 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build (darwin || linux || windows) && !ios && !android && !js && !nogl
+//go:build (darwin || linux || windows) && !ios && !android && !js && !nogl && !softgl
 // +build darwin linux windows
 // +build !ios
 // +build !android
 // +build !js
 // +build !nogl
+// +build !softgl
 
 package gl
 
@@ -16,6 +17,7 @@ import (
 	"image"
 	"log"
 	"reflect"
+	"strings"
 	"unsafe"
 
 	"github.com/go-gl/gl/v2.1/gl"
@@ -29,604 +31,38 @@ type Program struct{ uint32 }
 type UniformLocation struct{ int32 }
 type Shader struct{ uint32 }
 
+var _ Renderer = (*Context)(nil)
+
 type Context struct {
-	ARRAY_BUFFER                                 int
-	ARRAY_BUFFER_BINDING                         int
-	ATTACHED_SHADERS                             int
-	BACK                                         int
-	BLEND                                        int
-	BLEND_COLOR                                  int
-	BLEND_DST_ALPHA                              int
-	BLEND_DST_RGB                                int
-	BLEND_EQUATION                               int
-	BLEND_EQUATION_ALPHA                         int
-	BLEND_EQUATION_RGB                           int
-	BLEND_SRC_ALPHA                              int
-	BLEND_SRC_RGB                                int
-	BLUE_BITS                                    int
-	BOOL                                         int
-	BOOL_VEC2                                    int
-	BOOL_VEC3                                    int
-	BOOL_VEC4                                    int
-	BROWSER_DEFAULT_WEBGL                        int
-	BUFFER_SIZE                                  int
-	BUFFER_USAGE                                 int
-	BYTE                                         int
-	CCW                                          int
-	CLAMP_TO_EDGE                                int
-	CLAMP_TO_BORDER                              int
-	COLOR_ATTACHMENT0                            int
-	COLOR_BUFFER_BIT                             int
-	COLOR_CLEAR_VALUE                            int
-	COLOR_WRITEMASK                              int
-	COMPILE_STATUS                               uint32
-	COMPRESSED_TEXTURE_FORMATS                   int
-	CONSTANT_ALPHA                               int
-	CONSTANT_COLOR                               int
-	CONTEXT_LOST_WEBGL                           int
-	CULL_FACE                                    int
-	CULL_FACE_MODE                               int
-	CURRENT_PROGRAM                              int
-	CURRENT_VERTEX_ATTRIB                        int
-	CW                                           int
-	DECR                                         int
-	DECR_WRAP                                    int
-	DELETE_STATUS                                int
-	DEPTH_ATTACHMENT                             int
-	DEPTH_BITS                                   int
-	DEPTH_BUFFER_BIT                             int
-	DEPTH_CLEAR_VALUE                            int
-	DEPTH_COMPONENT                              int
-	DEPTH_COMPONENT16                            int
-	DEPTH_FUNC                                   int
-	DEPTH_RANGE                                  int
-	DEPTH_STENCIL                                int
-	DEPTH_STENCIL_ATTACHMENT                     int
-	DEPTH_TEST                                   int
-	DEPTH_WRITEMASK                              int
-	DITHER                                       int
-	DONT_CARE                                    int
-	DST_ALPHA                                    int
-	DST_COLOR                                    int
-	DYNAMIC_DRAW                                 int
-	ELEMENT_ARRAY_BUFFER                         int
-	ELEMENT_ARRAY_BUFFER_BINDING                 int
-	EQUAL                                        int
-	FASTEST                                      int
-	FLOAT                                        int
-	FLOAT_MAT2                                   int
-	FLOAT_MAT3                                   int
-	FLOAT_MAT4                                   int
-	FLOAT_VEC2                                   int
-	FLOAT_VEC3                                   int
-	FLOAT_VEC4                                   int
-	FRAGMENT_SHADER                              int
-	FRAMEBUFFER                                  int
-	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME           int
-	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE           int
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE int
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL         int
-	FRAMEBUFFER_BINDING                          int
-	FRAMEBUFFER_COMPLETE                         int
-	FRAMEBUFFER_INCOMPLETE_ATTACHMENT            int
-	FRAMEBUFFER_INCOMPLETE_DIMENSIONS            int
-	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT    int
-	FRAMEBUFFER_UNSUPPORTED                      int
-	FRONT                                        int
-	FRONT_AND_BACK                               int
-	FRONT_FACE                                   int
-	FUNC_ADD                                     int
-	FUNC_REVERSE_SUBTRACT                        int
-	FUNC_SUBTRACT                                int
-	GENERATE_MIPMAP_HINT                         int
-	GEQUAL                                       int
-	GREATER                                      int
-	GREEN_BITS                                   int
-	HIGH_FLOAT                                   int
-	HIGH_INT                                     int
-	INCR                                         int
-	INCR_WRAP                                    int
-	INFO_LOG_LENGTH                              uint32
-	INT                                          int
-	INT_VEC2                                     int
-	INT_VEC3                                     int
-	INT_VEC4                                     int
-	INVALID_ENUM                                 int
-	INVALID_FRAMEBUFFER_OPERATION                int
-	INVALID_OPERATION                            int
-	INVALID_VALUE                                int
-	INVERT                                       int
-	KEEP                                         int
-	LEQUAL                                       int
-	LESS                                         int
-	LINEAR                                       int
-	LINEAR_MIPMAP_LINEAR                         int
-	LINEAR_MIPMAP_NEAREST                        int
-	LINES                                        int
-	LINE_LOOP                                    int
-	LINE_STRIP                                   int
-	LINE_STIPPLE                                 int
-	LINE_WIDTH                                   int
-	LINK_STATUS                                  int
-	LOW_FLOAT                                    int
-	LOW_INT                                      int
-	LUMINANCE                                    int
-	LUMINANCE_ALPHA                              int
-	MAX_COMBINED_TEXTURE_IMAGE_UNITS             int
-	MAX_CUBE_MAP_TEXTURE_SIZE                    int
-	MAX_FRAGMENT_UNIFORM_VECTORS                 int
-	MAX_RENDERBUFFER_SIZE                        int
-	MAX_TEXTURE_IMAGE_UNITS                      int
-	MAX_TEXTURE_SIZE                             int
-	MAX_VARYING_VECTORS                          int
-	MAX_VERTEX_ATTRIBS                           int
-	MAX_VERTEX_TEXTURE_IMAGE_UNITS               int
-	MAX_VERTEX_UNIFORM_VECTORS                   int
-	MAX_VIEWPORT_DIMS                            int
-	MEDIUM_FLOAT                                 int
-	MEDIUM_INT                                   int
-	MIRRORED_REPEAT                              int
-	MULTISAMPLE                                  int
-	NEAREST                                      int
-	NEAREST_MIPMAP_LINEAR                        int
-	NEAREST_MIPMAP_NEAREST                       int
-	NEVER                                        int
-	NICEST                                       int
-	NONE                                         int
-	NOTEQUAL                                     int
-	NO_ERROR                                     int
-	NUM_COMPRESSED_TEXTURE_FORMATS               int
-	ONE                                          int
-	ONE_MINUS_CONSTANT_ALPHA                     int
-	ONE_MINUS_CONSTANT_COLOR                     int
-	ONE_MINUS_DST_ALPHA                          int
-	ONE_MINUS_DST_COLOR                          int
-	ONE_MINUS_SRC_ALPHA                          int
-	ONE_MINUS_SRC_COLOR                          int
-	OUT_OF_MEMORY                                int
-	PACK_ALIGNMENT                               int
-	POINTS                                       int
-	POLYGON_OFFSET_FACTOR                        int
-	POLYGON_OFFSET_FILL                          int
-	POLYGON_OFFSET_UNITS                         int
-	RED_BITS                                     int
-	RENDERBUFFER                                 int
-	RENDERBUFFER_ALPHA_SIZE                      int
-	RENDERBUFFER_BINDING                         int
-	RENDERBUFFER_BLUE_SIZE                       int
-	RENDERBUFFER_DEPTH_SIZE                      int
-	RENDERBUFFER_GREEN_SIZE                      int
-	RENDERBUFFER_HEIGHT                          int
-	RENDERBUFFER_INTERNAL_FORMAT                 int
-	RENDERBUFFER_RED_SIZE                        int
-	RENDERBUFFER_STENCIL_SIZE                    int
-	RENDERBUFFER_WIDTH                           int
-	RENDERER                                     int
-	REPEAT                                       int
-	REPLACE                                      int
-	RGB                                          int
-	RGB5_A1                                      int
-	RGB565                                       int
-	RGBA                                         int
-	RGBA4                                        int
-	RGBA8                                        int
-	SAMPLER_2D                                   int
-	SAMPLER_CUBE                                 int
-	SAMPLES                                      int
-	SAMPLE_ALPHA_TO_COVERAGE                     int
-	SAMPLE_BUFFERS                               int
-	SAMPLE_COVERAGE                              int
-	SAMPLE_COVERAGE_INVERT                       int
-	SAMPLE_COVERAGE_VALUE                        int
-	SCISSOR_BOX                                  int
-	SCISSOR_TEST                                 int
-	SHADER_COMPILER                              int
-	SHADER_SOURCE_LENGTH                         int
-	SHADER_TYPE                                  int
-	SHADING_LANGUAGE_VERSION                     int
-	SHORT                                        int
-	SRC_ALPHA                                    int
-	SRC_ALPHA_SATURATE                           int
-	SRC_COLOR                                    int
-	STATIC_DRAW                                  int
-	STENCIL_ATTACHMENT                           int
-	STENCIL_BACK_FAIL                            int
-	STENCIL_BACK_FUNC                            int
-	STENCIL_BACK_PASS_DEPTH_FAIL                 int
-	STENCIL_BACK_PASS_DEPTH_PASS                 int
-	STENCIL_BACK_REF                             int
-	STENCIL_BACK_VALUE_MASK                      int
-	STENCIL_BACK_WRITEMASK                       int
-	STENCIL_BITS                                 int
-	STENCIL_BUFFER_BIT                           int
-	STENCIL_CLEAR_VALUE                          int
-	STENCIL_FAIL                                 int
-	STENCIL_FUNC                                 int
-	STENCIL_INDEX                                int
-	STENCIL_INDEX8                               int
-	STENCIL_PASS_DEPTH_FAIL                      int
-	STENCIL_PASS_DEPTH_PASS                      int
-	STENCIL_REF                                  int
-	STENCIL_TEST                                 int
-	STENCIL_VALUE_MASK                           int
-	STENCIL_WRITEMASK                            int
-	STREAM_DRAW                                  int
-	SUBPIXEL_BITS                                int
-	TEXTURE                                      int
-	TEXTURE0                                     int
-	TEXTURE1                                     int
-	TEXTURE2                                     int
-	TEXTURE3                                     int
-	TEXTURE4                                     int
-	TEXTURE5                                     int
-	TEXTURE6                                     int
-	TEXTURE7                                     int
-	TEXTURE8                                     int
-	TEXTURE9                                     int
-	TEXTURE10                                    int
-	TEXTURE11                                    int
-	TEXTURE12                                    int
-	TEXTURE13                                    int
-	TEXTURE14                                    int
-	TEXTURE15                                    int
-	TEXTURE16                                    int
-	TEXTURE17                                    int
-	TEXTURE18                                    int
-	TEXTURE19                                    int
-	TEXTURE20                                    int
-	TEXTURE21                                    int
-	TEXTURE22                                    int
-	TEXTURE23                                    int
-	TEXTURE24                                    int
-	TEXTURE25                                    int
-	TEXTURE26                                    int
-	TEXTURE27                                    int
-	TEXTURE28                                    int
-	TEXTURE29                                    int
-	TEXTURE30                                    int
-	TEXTURE31                                    int
-	TEXTURE_2D                                   int
-	TEXTURE_BINDING_2D                           int
-	TEXTURE_BINDING_CUBE_MAP                     int
-	TEXTURE_CUBE_MAP                             int
-	TEXTURE_CUBE_MAP_NEGATIVE_X                  int
-	TEXTURE_CUBE_MAP_NEGATIVE_Y                  int
-	TEXTURE_CUBE_MAP_NEGATIVE_Z                  int
-	TEXTURE_CUBE_MAP_POSITIVE_X                  int
-	TEXTURE_CUBE_MAP_POSITIVE_Y                  int
-	TEXTURE_CUBE_MAP_POSITIVE_Z                  int
-	TEXTURE_MAG_FILTER                           int
-	TEXTURE_MIN_FILTER                           int
-	TEXTURE_WRAP_S                               int
-	TEXTURE_WRAP_T                               int
-	TRIANGLES                                    int
-	TRIANGLE_FAN                                 int
-	TRIANGLE_STRIP                               int
-	UNPACK_ALIGNMENT                             int
-	UNPACK_COLORSPACE_CONVERSION_WEBGL           int
-	UNPACK_FLIP_Y_WEBGL                          int
-	UNPACK_PREMULTIPLY_ALPHA_WEBGL               int
-	UNSIGNED_BYTE                                int
-	UNSIGNED_INT                                 int
-	UNSIGNED_SHORT                               int
-	UNSIGNED_SHORT_4_4_4_4                       int
-	UNSIGNED_SHORT_5_5_5_1                       int
-	UNSIGNED_SHORT_5_6_5                         int
-	VALIDATE_STATUS                              int
-	VENDOR                                       int
-	VERSION                                      int
-	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING           int
-	VERTEX_ATTRIB_ARRAY_ENABLED                  int
-	VERTEX_ATTRIB_ARRAY_NORMALIZED               int
-	VERTEX_ATTRIB_ARRAY_POINTER                  int
-	VERTEX_ATTRIB_ARRAY_SIZE                     int
-	VERTEX_ATTRIB_ARRAY_STRIDE                   int
-	VERTEX_ATTRIB_ARRAY_TYPE                     int
-	VERTEX_SHADER                                int
-	VIEWPORT                                     int
-	ZERO                                         int
-	TRUE                                         int
-}
-
-func NewContext() *Context {
+	Constants
+
+	// enumError is set when a method was passed an unsupported constant, and
+	// reported as INVALID_ENUM by the next GetError.
+	enumError bool
+
+	// valueError is set when a method was passed values it didn't pass on to
+	// OpenGL, and reported as INVALID_VALUE by the next GetError.
+	valueError bool
+}
+
+// ContextOption configures a Context created by NewContext.
+type ContextOption func(c *Context)
+
+// NewContext initializes OpenGL for the current thread's context and applies
+// the options, in order.
+func NewContext(options ...ContextOption) *Context {
 	if err := gl.Init(); err != nil {
 		log.Fatal(err)
 	}
-	return &Context{
-		ARRAY_BUFFER:                       gl.ARRAY_BUFFER,
-		ARRAY_BUFFER_BINDING:               gl.ARRAY_BUFFER_BINDING,
-		ATTACHED_SHADERS:                   gl.ATTACHED_SHADERS,
-		BACK:                               gl.BACK,
-		BLEND:                              gl.BLEND,
-		BLEND_COLOR:                        gl.BLEND_COLOR,
-		BLEND_DST_ALPHA:                    gl.BLEND_DST_ALPHA,
-		BLEND_DST_RGB:                      gl.BLEND_DST_RGB,
-		BLEND_EQUATION:                     gl.BLEND_EQUATION,
-		BLEND_EQUATION_ALPHA:               gl.BLEND_EQUATION_ALPHA,
-		BLEND_EQUATION_RGB:                 gl.BLEND_EQUATION_RGB,
-		BLEND_SRC_ALPHA:                    gl.BLEND_SRC_ALPHA,
-		BLEND_SRC_RGB:                      gl.BLEND_SRC_RGB,
-		BLUE_BITS:                          gl.BLUE_BITS,
-		BOOL:                               gl.BOOL,
-		BOOL_VEC2:                          gl.BOOL_VEC2,
-		BOOL_VEC3:                          gl.BOOL_VEC3,
-		BOOL_VEC4:                          gl.BOOL_VEC4,
-		BUFFER_SIZE:                        gl.BUFFER_SIZE,
-		BUFFER_USAGE:                       gl.BUFFER_USAGE,
-		BYTE:                               gl.BYTE,
-		CCW:                                gl.CCW,
-		CLAMP_TO_EDGE:                      gl.CLAMP_TO_EDGE,
-		CLAMP_TO_BORDER:                    gl.CLAMP_TO_BORDER,
-		COLOR_ATTACHMENT0:                  gl.COLOR_ATTACHMENT0,
-		COLOR_BUFFER_BIT:                   gl.COLOR_BUFFER_BIT,
-		COLOR_CLEAR_VALUE:                  gl.COLOR_CLEAR_VALUE,
-		COLOR_WRITEMASK:                    gl.COLOR_WRITEMASK,
-		COMPILE_STATUS:                     gl.COMPILE_STATUS,
-		COMPRESSED_TEXTURE_FORMATS:         gl.COMPRESSED_TEXTURE_FORMATS,
-		CONSTANT_ALPHA:                     gl.CONSTANT_ALPHA,
-		CONSTANT_COLOR:                     gl.CONSTANT_COLOR,
-		CULL_FACE:                          gl.CULL_FACE,
-		CULL_FACE_MODE:                     gl.CULL_FACE_MODE,
-		CURRENT_PROGRAM:                    gl.CURRENT_PROGRAM,
-		CURRENT_VERTEX_ATTRIB:              gl.CURRENT_VERTEX_ATTRIB,
-		CW:                                 gl.CW,
-		DECR:                               gl.DECR,
-		DECR_WRAP:                          gl.DECR_WRAP,
-		DELETE_STATUS:                      gl.DELETE_STATUS,
-		DEPTH_ATTACHMENT:                   gl.DEPTH_ATTACHMENT,
-		DEPTH_BITS:                         gl.DEPTH_BITS,
-		DEPTH_BUFFER_BIT:                   gl.DEPTH_BUFFER_BIT,
-		DEPTH_CLEAR_VALUE:                  gl.DEPTH_CLEAR_VALUE,
-		DEPTH_COMPONENT:                    gl.DEPTH_COMPONENT,
-		DEPTH_COMPONENT16:                  gl.DEPTH_COMPONENT16,
-		DEPTH_FUNC:                         gl.DEPTH_FUNC,
-		DEPTH_RANGE:                        gl.DEPTH_RANGE,
-		DEPTH_STENCIL:                      gl.DEPTH_STENCIL,
-		DEPTH_STENCIL_ATTACHMENT:           gl.DEPTH_STENCIL_ATTACHMENT,
-		DEPTH_TEST:                         gl.DEPTH_TEST,
-		DEPTH_WRITEMASK:                    gl.DEPTH_WRITEMASK,
-		DITHER:                             gl.DITHER,
-		DONT_CARE:                          gl.DONT_CARE,
-		DST_ALPHA:                          gl.DST_ALPHA,
-		DST_COLOR:                          gl.DST_COLOR,
-		DYNAMIC_DRAW:                       gl.DYNAMIC_DRAW,
-		ELEMENT_ARRAY_BUFFER:               gl.ELEMENT_ARRAY_BUFFER,
-		ELEMENT_ARRAY_BUFFER_BINDING:       gl.ELEMENT_ARRAY_BUFFER_BINDING,
-		EQUAL:                              gl.EQUAL,
-		FASTEST:                            gl.FASTEST,
-		FLOAT:                              gl.FLOAT,
-		FLOAT_MAT2:                         gl.FLOAT_MAT2,
-		FLOAT_MAT3:                         gl.FLOAT_MAT3,
-		FLOAT_MAT4:                         gl.FLOAT_MAT4,
-		FLOAT_VEC2:                         gl.FLOAT_VEC2,
-		FLOAT_VEC3:                         gl.FLOAT_VEC3,
-		FLOAT_VEC4:                         gl.FLOAT_VEC4,
-		FRAGMENT_SHADER:                    gl.FRAGMENT_SHADER,
-		FRAMEBUFFER:                        gl.FRAMEBUFFER,
-		FRAMEBUFFER_ATTACHMENT_OBJECT_NAME: gl.FRAMEBUFFER_ATTACHMENT_OBJECT_NAME,
-		FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE: gl.FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE,
-		FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE: gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE,
-		FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL:         gl.FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL,
-		FRAMEBUFFER_BINDING:                          gl.FRAMEBUFFER_BINDING,
-		FRAMEBUFFER_COMPLETE:                         gl.FRAMEBUFFER_COMPLETE,
-		FRAMEBUFFER_INCOMPLETE_ATTACHMENT:            gl.FRAMEBUFFER_INCOMPLETE_ATTACHMENT,
-		FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT:    gl.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT,
-		FRAMEBUFFER_UNSUPPORTED:                      gl.FRAMEBUFFER_UNSUPPORTED,
-		FRONT:                                        gl.FRONT,
-		FRONT_AND_BACK:                               gl.FRONT_AND_BACK,
-		FRONT_FACE:                                   gl.FRONT_FACE,
-		FUNC_ADD:                                     gl.FUNC_ADD,
-		FUNC_REVERSE_SUBTRACT:                        gl.FUNC_REVERSE_SUBTRACT,
-		FUNC_SUBTRACT:                                gl.FUNC_SUBTRACT,
-		GENERATE_MIPMAP_HINT:                         gl.GENERATE_MIPMAP_HINT,
-		GEQUAL:                                       gl.GEQUAL,
-		GREATER:                                      gl.GREATER,
-		GREEN_BITS:                                   gl.GREEN_BITS,
-		HIGH_FLOAT:                                   gl.HIGH_FLOAT,
-		HIGH_INT:                                     gl.HIGH_INT,
-		INCR:                                         gl.INCR,
-		INCR_WRAP:                                    gl.INCR_WRAP,
-		INFO_LOG_LENGTH:                              gl.INFO_LOG_LENGTH,
-		INT:                                          gl.INT,
-		INT_VEC2:                                     gl.INT_VEC2,
-		INT_VEC3:                                     gl.INT_VEC3,
-		INT_VEC4:                                     gl.INT_VEC4,
-		INVALID_ENUM:                                 gl.INVALID_ENUM,
-		INVALID_FRAMEBUFFER_OPERATION:                gl.INVALID_FRAMEBUFFER_OPERATION,
-		INVALID_OPERATION:                            gl.INVALID_OPERATION,
-		INVALID_VALUE:                                gl.INVALID_VALUE,
-		INVERT:                                       gl.INVERT,
-		KEEP:                                         gl.KEEP,
-		LEQUAL:                                       gl.LEQUAL,
-		LESS:                                         gl.LESS,
-		LINEAR:                                       gl.LINEAR,
-		LINEAR_MIPMAP_LINEAR:                         gl.LINEAR_MIPMAP_LINEAR,
-		LINEAR_MIPMAP_NEAREST:                        gl.LINEAR_MIPMAP_NEAREST,
-		LINES:                                        gl.LINES,
-		LINE_LOOP:                                    gl.LINE_LOOP,
-		LINE_STRIP:                                   gl.LINE_STRIP,
-		LINE_STIPPLE:                                 gl.LINE_STIPPLE,
-		LINE_WIDTH:                                   gl.LINE_WIDTH,
-		LINK_STATUS:                                  gl.LINK_STATUS,
-		LOW_FLOAT:                                    gl.LOW_FLOAT,
-		LOW_INT:                                      gl.LOW_INT,
-		LUMINANCE:                                    gl.LUMINANCE,
-		LUMINANCE_ALPHA:                              gl.LUMINANCE_ALPHA,
-		MAX_COMBINED_TEXTURE_IMAGE_UNITS:             gl.MAX_COMBINED_TEXTURE_IMAGE_UNITS,
-		MAX_CUBE_MAP_TEXTURE_SIZE:                    gl.MAX_CUBE_MAP_TEXTURE_SIZE,
-		MAX_FRAGMENT_UNIFORM_VECTORS:                 gl.MAX_FRAGMENT_UNIFORM_VECTORS,
-		MAX_RENDERBUFFER_SIZE:                        gl.MAX_RENDERBUFFER_SIZE,
-		MAX_TEXTURE_IMAGE_UNITS:                      gl.MAX_TEXTURE_IMAGE_UNITS,
-		MAX_TEXTURE_SIZE:                             gl.MAX_TEXTURE_SIZE,
-		MAX_VARYING_VECTORS:                          gl.MAX_VARYING_VECTORS,
-		MAX_VERTEX_ATTRIBS:                           gl.MAX_VERTEX_ATTRIBS,
-		MAX_VERTEX_TEXTURE_IMAGE_UNITS:               gl.MAX_VERTEX_TEXTURE_IMAGE_UNITS,
-		MAX_VERTEX_UNIFORM_VECTORS:                   gl.MAX_VERTEX_UNIFORM_VECTORS,
-		MAX_VIEWPORT_DIMS:                            gl.MAX_VIEWPORT_DIMS,
-		MEDIUM_FLOAT:                                 gl.MEDIUM_FLOAT,
-		MEDIUM_INT:                                   gl.MEDIUM_INT,
-		MIRRORED_REPEAT:                              gl.MIRRORED_REPEAT,
-		MULTISAMPLE:                                  gl.MULTISAMPLE,
-		NEAREST:                                      gl.NEAREST,
-		NEAREST_MIPMAP_LINEAR:                        gl.NEAREST_MIPMAP_LINEAR,
-		NEAREST_MIPMAP_NEAREST:                       gl.NEAREST_MIPMAP_NEAREST,
-		NEVER:                                        gl.NEVER,
-		NICEST:                                       gl.NICEST,
-		NONE:                                         gl.NONE,
-		NOTEQUAL:                                     gl.NOTEQUAL,
-		NO_ERROR:                                     gl.NO_ERROR,
-		NUM_COMPRESSED_TEXTURE_FORMATS:               gl.NUM_COMPRESSED_TEXTURE_FORMATS,
-		ONE:                                          gl.ONE,
-		ONE_MINUS_CONSTANT_ALPHA:                     gl.ONE_MINUS_CONSTANT_ALPHA,
-		ONE_MINUS_CONSTANT_COLOR:                     gl.ONE_MINUS_CONSTANT_COLOR,
-		ONE_MINUS_DST_ALPHA:                          gl.ONE_MINUS_DST_ALPHA,
-		ONE_MINUS_DST_COLOR:                          gl.ONE_MINUS_DST_COLOR,
-		ONE_MINUS_SRC_ALPHA:                          gl.ONE_MINUS_SRC_ALPHA,
-		ONE_MINUS_SRC_COLOR:                          gl.ONE_MINUS_SRC_COLOR,
-		OUT_OF_MEMORY:                                gl.OUT_OF_MEMORY,
-		PACK_ALIGNMENT:                               gl.PACK_ALIGNMENT,
-		POINTS:                                       gl.POINTS,
-		POLYGON_OFFSET_FACTOR:                        gl.POLYGON_OFFSET_FACTOR,
-		POLYGON_OFFSET_FILL:                          gl.POLYGON_OFFSET_FILL,
-		POLYGON_OFFSET_UNITS:                         gl.POLYGON_OFFSET_UNITS,
-		RED_BITS:                                     gl.RED_BITS,
-		RENDERBUFFER:                                 gl.RENDERBUFFER,
-		RENDERBUFFER_ALPHA_SIZE:                      gl.RENDERBUFFER_ALPHA_SIZE,
-		RENDERBUFFER_BINDING:                         gl.RENDERBUFFER_BINDING,
-		RENDERBUFFER_BLUE_SIZE:                       gl.RENDERBUFFER_BLUE_SIZE,
-		RENDERBUFFER_DEPTH_SIZE:                      gl.RENDERBUFFER_DEPTH_SIZE,
-		RENDERBUFFER_GREEN_SIZE:                      gl.RENDERBUFFER_GREEN_SIZE,
-		RENDERBUFFER_HEIGHT:                          gl.RENDERBUFFER_HEIGHT,
-		RENDERBUFFER_INTERNAL_FORMAT:                 gl.RENDERBUFFER_INTERNAL_FORMAT,
-		RENDERBUFFER_RED_SIZE:                        gl.RENDERBUFFER_RED_SIZE,
-		RENDERBUFFER_STENCIL_SIZE:                    gl.RENDERBUFFER_STENCIL_SIZE,
-		RENDERBUFFER_WIDTH:                           gl.RENDERBUFFER_WIDTH,
-		RENDERER:                                     gl.RENDERER,
-		REPEAT:                                       gl.REPEAT,
-		REPLACE:                                      gl.REPLACE,
-		RGB:                                          gl.RGB,
-		RGB5_A1:                                      gl.RGB5_A1,
-		RGB565:                                       gl.RGB565,
-		RGBA:                                         gl.RGBA,
-		RGBA4:                                        gl.RGBA4,
-		RGBA8:                                        gl.RGBA8,
-		SAMPLER_2D:                                   gl.SAMPLER_2D,
-		SAMPLER_CUBE:                                 gl.SAMPLER_CUBE,
-		SAMPLES:                                      gl.SAMPLES,
-		SAMPLE_ALPHA_TO_COVERAGE:                     gl.SAMPLE_ALPHA_TO_COVERAGE,
-		SAMPLE_BUFFERS:                               gl.SAMPLE_BUFFERS,
-		SAMPLE_COVERAGE:                              gl.SAMPLE_COVERAGE,
-		SAMPLE_COVERAGE_INVERT:                       gl.SAMPLE_COVERAGE_INVERT,
-		SAMPLE_COVERAGE_VALUE:                        gl.SAMPLE_COVERAGE_VALUE,
-		SCISSOR_BOX:                                  gl.SCISSOR_BOX,
-		SCISSOR_TEST:                                 gl.SCISSOR_TEST,
-		SHADER_COMPILER:                              gl.SHADER_COMPILER,
-		SHADER_SOURCE_LENGTH:                         gl.SHADER_SOURCE_LENGTH,
-		SHADER_TYPE:                                  gl.SHADER_TYPE,
-		SHADING_LANGUAGE_VERSION:                     gl.SHADING_LANGUAGE_VERSION,
-		SHORT:                                        gl.SHORT,
-		SRC_ALPHA:                                    gl.SRC_ALPHA,
-		SRC_ALPHA_SATURATE:                           gl.SRC_ALPHA_SATURATE,
-		SRC_COLOR:                                    gl.SRC_COLOR,
-		STATIC_DRAW:                                  gl.STATIC_DRAW,
-		STENCIL_ATTACHMENT:                           gl.STENCIL_ATTACHMENT,
-		STENCIL_BACK_FAIL:                            gl.STENCIL_BACK_FAIL,
-		STENCIL_BACK_FUNC:                            gl.STENCIL_BACK_FUNC,
-		STENCIL_BACK_PASS_DEPTH_FAIL:                 gl.STENCIL_BACK_PASS_DEPTH_FAIL,
-		STENCIL_BACK_PASS_DEPTH_PASS:                 gl.STENCIL_BACK_PASS_DEPTH_PASS,
-		STENCIL_BACK_REF:                             gl.STENCIL_BACK_REF,
-		STENCIL_BACK_VALUE_MASK:                      gl.STENCIL_BACK_VALUE_MASK,
-		STENCIL_BACK_WRITEMASK:                       gl.STENCIL_BACK_WRITEMASK,
-		STENCIL_BITS:                                 gl.STENCIL_BITS,
-		STENCIL_BUFFER_BIT:                           gl.STENCIL_BUFFER_BIT,
-		STENCIL_CLEAR_VALUE:                          gl.STENCIL_CLEAR_VALUE,
-		STENCIL_FAIL:                                 gl.STENCIL_FAIL,
-		STENCIL_FUNC:                                 gl.STENCIL_FUNC,
-		STENCIL_INDEX:                                gl.STENCIL_INDEX,
-		STENCIL_INDEX8:                               gl.STENCIL_INDEX8,
-		STENCIL_PASS_DEPTH_FAIL:                      gl.STENCIL_PASS_DEPTH_FAIL,
-		STENCIL_PASS_DEPTH_PASS:                      gl.STENCIL_PASS_DEPTH_PASS,
-		STENCIL_REF:                                  gl.STENCIL_REF,
-		STENCIL_TEST:                                 gl.STENCIL_TEST,
-		STENCIL_VALUE_MASK:                           gl.STENCIL_VALUE_MASK,
-		STENCIL_WRITEMASK:                            gl.STENCIL_WRITEMASK,
-		STREAM_DRAW:                                  gl.STREAM_DRAW,
-		SUBPIXEL_BITS:                                gl.SUBPIXEL_BITS,
-		TEXTURE:                                      gl.TEXTURE,
-		TEXTURE0:                                     gl.TEXTURE0,
-		TEXTURE1:                                     gl.TEXTURE1,
-		TEXTURE2:                                     gl.TEXTURE2,
-		TEXTURE3:                                     gl.TEXTURE3,
-		TEXTURE4:                                     gl.TEXTURE4,
-		TEXTURE5:                                     gl.TEXTURE5,
-		TEXTURE6:                                     gl.TEXTURE6,
-		TEXTURE7:                                     gl.TEXTURE7,
-		TEXTURE8:                                     gl.TEXTURE8,
-		TEXTURE9:                                     gl.TEXTURE9,
-		TEXTURE10:                                    gl.TEXTURE10,
-		TEXTURE11:                                    gl.TEXTURE11,
-		TEXTURE12:                                    gl.TEXTURE12,
-		TEXTURE13:                                    gl.TEXTURE13,
-		TEXTURE14:                                    gl.TEXTURE14,
-		TEXTURE15:                                    gl.TEXTURE15,
-		TEXTURE16:                                    gl.TEXTURE16,
-		TEXTURE17:                                    gl.TEXTURE17,
-		TEXTURE18:                                    gl.TEXTURE18,
-		TEXTURE19:                                    gl.TEXTURE19,
-		TEXTURE20:                                    gl.TEXTURE20,
-		TEXTURE21:                                    gl.TEXTURE21,
-		TEXTURE22:                                    gl.TEXTURE22,
-		TEXTURE23:                                    gl.TEXTURE23,
-		TEXTURE24:                                    gl.TEXTURE24,
-		TEXTURE25:                                    gl.TEXTURE25,
-		TEXTURE26:                                    gl.TEXTURE26,
-		TEXTURE27:                                    gl.TEXTURE27,
-		TEXTURE28:                                    gl.TEXTURE28,
-		TEXTURE29:                                    gl.TEXTURE29,
-		TEXTURE30:                                    gl.TEXTURE30,
-		TEXTURE31:                                    gl.TEXTURE31,
-		TEXTURE_2D:                                   gl.TEXTURE_2D,
-		TEXTURE_BINDING_2D:                           gl.TEXTURE_BINDING_2D,
-		TEXTURE_BINDING_CUBE_MAP:                     gl.TEXTURE_BINDING_CUBE_MAP,
-		TEXTURE_CUBE_MAP:                             gl.TEXTURE_CUBE_MAP,
-		TEXTURE_CUBE_MAP_NEGATIVE_X:                  gl.TEXTURE_CUBE_MAP_NEGATIVE_X,
-		TEXTURE_CUBE_MAP_NEGATIVE_Y:                  gl.TEXTURE_CUBE_MAP_NEGATIVE_Y,
-		TEXTURE_CUBE_MAP_NEGATIVE_Z:                  gl.TEXTURE_CUBE_MAP_NEGATIVE_Z,
-		TEXTURE_CUBE_MAP_POSITIVE_X:                  gl.TEXTURE_CUBE_MAP_POSITIVE_X,
-		TEXTURE_CUBE_MAP_POSITIVE_Y:                  gl.TEXTURE_CUBE_MAP_POSITIVE_Y,
-		TEXTURE_CUBE_MAP_POSITIVE_Z:                  gl.TEXTURE_CUBE_MAP_POSITIVE_Z,
-		TEXTURE_MAG_FILTER:                           gl.TEXTURE_MAG_FILTER,
-		TEXTURE_MIN_FILTER:                           gl.TEXTURE_MIN_FILTER,
-		TEXTURE_WRAP_S:                               gl.TEXTURE_WRAP_S,
-		TEXTURE_WRAP_T:                               gl.TEXTURE_WRAP_T,
-		TRIANGLES:                                    gl.TRIANGLES,
-		TRIANGLE_FAN:                                 gl.TRIANGLE_FAN,
-		TRIANGLE_STRIP:                               gl.TRIANGLE_STRIP,
-		UNPACK_ALIGNMENT:                             gl.UNPACK_ALIGNMENT,
-		UNSIGNED_BYTE:                                gl.UNSIGNED_BYTE,
-		UNSIGNED_INT:                                 gl.UNSIGNED_INT,
-		UNSIGNED_SHORT:                               gl.UNSIGNED_SHORT,
-		UNSIGNED_SHORT_4_4_4_4:                       gl.UNSIGNED_SHORT_4_4_4_4,
-		UNSIGNED_SHORT_5_5_5_1:                       gl.UNSIGNED_SHORT_5_5_5_1,
-		UNSIGNED_SHORT_5_6_5:                         gl.UNSIGNED_SHORT_5_6_5,
-		VALIDATE_STATUS:                              gl.VALIDATE_STATUS,
-		VENDOR:                                       gl.VENDOR,
-		VERSION:                                      gl.VERSION,
-		VERTEX_ATTRIB_ARRAY_BUFFER_BINDING:           gl.VERTEX_ATTRIB_ARRAY_BUFFER_BINDING,
-		VERTEX_ATTRIB_ARRAY_ENABLED:                  gl.VERTEX_ATTRIB_ARRAY_ENABLED,
-		VERTEX_ATTRIB_ARRAY_NORMALIZED:               gl.VERTEX_ATTRIB_ARRAY_NORMALIZED,
-		VERTEX_ATTRIB_ARRAY_POINTER:                  gl.VERTEX_ATTRIB_ARRAY_POINTER,
-		VERTEX_ATTRIB_ARRAY_SIZE:                     gl.VERTEX_ATTRIB_ARRAY_SIZE,
-		VERTEX_ATTRIB_ARRAY_STRIDE:                   gl.VERTEX_ATTRIB_ARRAY_STRIDE,
-		VERTEX_ATTRIB_ARRAY_TYPE:                     gl.VERTEX_ATTRIB_ARRAY_TYPE,
-		VERTEX_SHADER:                                gl.VERTEX_SHADER,
-		VIEWPORT:                                     gl.VIEWPORT,
-		ZERO:                                         gl.ZERO,
-		TRUE:                                         gl.TRUE,
+	c := &Context{Constants: newConstants()}
+	c.loadEnumNames()
+	for _, option := range options {
+		option(c)
 	}
+	return c
 }
 
-func (c *Context) CreateShader(typ int) *Shader {
+func (c *Context) CreateShader(typ Enum) *Shader {
 	shader := &Shader{gl.CreateShader(uint32(typ))}
 	return shader
 }
@@ -672,12 +108,20 @@ func (c *Context) DeleteTexture(texture *Texture) {
 }
 
 // Returns a parameter from a shader object
-func (c *Context) GetShaderiv(shader *Shader, pname uint32) bool {
+func (c *Context) GetShaderiv(shader *Shader, pname Enum) bool {
 	var success int32
-	gl.GetShaderiv(shader.uint32, pname, &success)
+	gl.GetShaderiv(shader.uint32, uint32(pname), &success)
 	return success == int32(gl.TRUE)
 }
 
+// GetShaderParameteri returns the value of the shader parameter pname, such
+// as SHADER_TYPE, as an int.
+func (c *Context) GetShaderParameteri(shader *Shader, pname Enum) int {
+	var param int32
+	gl.GetShaderiv(shader.uint32, uint32(pname), &param)
+	return int(param)
+}
+
 // GetShaderInfoLog is a method you can call to get the compilation logs of a shader
 func (c *Context) GetShaderInfoLog(shader *Shader) string {
 	var maxLength int32
@@ -704,7 +148,7 @@ func (c *Context) BindAttribLocation(program *Program, index int, name string) {
 
 // Returns the value of the program parameter that corresponds to a supplied pname
 // which is interpreted as an int.
-func (c *Context) GetProgramParameteri(program *Program, pname int) int {
+func (c *Context) GetProgramParameteri(program *Program, pname Enum) int {
 	var success int32 = gl.FALSE
 	gl.GetProgramiv(program.uint32, uint32(pname), &success)
 	return int(success)
@@ -712,7 +156,7 @@ func (c *Context) GetProgramParameteri(program *Program, pname int) int {
 
 // Returns the value of the program parameter that corresponds to a supplied pname
 // which is interpreted as a bool.
-func (c *Context) GetProgramParameterb(program *Program, pname int) bool {
+func (c *Context) GetProgramParameterb(program *Program, pname Enum) bool {
 	var success int32 = gl.FALSE
 	gl.GetProgramiv(program.uint32, uint32(pname), &success)
 	return success == gl.TRUE
@@ -734,6 +178,9 @@ func (c *Context) AttachShader(program *Program, shader *Shader) {
 	gl.AttachShader(program.uint32, shader.uint32)
 }
 
+// LineStipple sets the pattern of dashed lines, drawn once LINE_STIPPLE is
+// enabled. It is only available on this backend, as OpenGL ES and WebGL have
+// no line stipple, and isn't part of the Renderer interface.
 func (c *Context) LineStipple(factor int32, pattern uint16) {
 	gl.LineStipple(factor, pattern)
 }
@@ -752,7 +199,7 @@ func (c *Context) CreateTexture() *Texture {
 	return &Texture{loc}
 }
 
-func (c *Context) BindTexture(target int, texture *Texture) {
+func (c *Context) BindTexture(target Enum, texture *Texture) {
 	if texture == nil {
 		gl.BindTexture(uint32(target), 0)
 		return
@@ -760,42 +207,91 @@ func (c *Context) BindTexture(target int, texture *Texture) {
 	gl.BindTexture(uint32(target), texture.uint32)
 }
 
-func (c *Context) ActiveTexture(target int) {
+func (c *Context) ActiveTexture(target Enum) {
 	gl.ActiveTexture(uint32(target))
 }
 
-func (c *Context) TexParameteri(target int, pname int, param int) {
+func (c *Context) TexParameteri(target, pname, param Enum) {
+	if c.unsupportedEnum(target) || c.unsupportedEnum(pname) || c.unsupportedTexParameter(pname, param) {
+		return
+	}
 	gl.TexParameteri(uint32(target), uint32(pname), int32(param))
 }
 
-func (c *Context) TexImage2D(target, level, internalFormat, format, kind int, data interface{}) {
-	var pix []uint8
-	width := 0
-	height := 0
+// TexImage2D loads the supplied image into a texture. data may be nil or any
+// image.Image, whose pixels are converted to format if needed. Sub-images are
+// uploaded in place using UNPACK_ROW_LENGTH. The pixel storage parameters are
+// restored after the upload.
+func (c *Context) TexImage2D(target Enum, level int, internalFormat, format, kind Enum, data interface{}) error {
 	if data == nil {
-		pix = nil
-	} else {
+		gl.TexImage2D(uint32(target), int32(level), int32(internalFormat), 0, 0, 0, uint32(format), uint32(kind), nil)
+		return nil
+	}
+	img, ok := data.(image.Image)
+	if !ok {
+		return fmt.Errorf("gl: image type unsupported: %T", data)
+	}
+	pix, stride, err := c.imagePixels(img, format, kind)
+	if err != nil {
+		return err
+	}
 
-		switch img := data.(type) {
-		case *image.NRGBA:
-			width = img.Bounds().Dx()
-			height = img.Bounds().Dy()
-			pix = img.Pix
-		case *image.RGBA:
-			width = img.Bounds().Dx()
-			height = img.Bounds().Dy()
-			pix = img.Pix
-		default:
-			panic(fmt.Errorf("Image type unsupported: %T", img))
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	bpp := c.bytesPerPixel(format, kind)
+	var rowLength int32
+	if stride != bpp*width {
+		if stride%bpp == 0 {
+			rowLength = int32(stride / bpp)
+		} else {
+			pix = packRows(pix, stride, bpp*width, height)
 		}
 	}
-	gl.TexImage2D(uint32(target), int32(level), int32(internalFormat), int32(width), int32(height), int32(0), uint32(format), uint32(kind), gl.Ptr(pix))
+
+	var ptr unsafe.Pointer
+	if len(pix) > 0 {
+		ptr = gl.Ptr(pix)
+	}
+	defer pixelStore(gl.UNPACK_ROW_LENGTH, rowLength)()
+	defer pixelStore(gl.UNPACK_ALIGNMENT, 1)()
+	gl.TexImage2D(uint32(target), int32(level), int32(internalFormat), int32(width), int32(height), 0, uint32(format), uint32(kind), ptr)
+	return nil
 }
 
-func (c *Context) TexImage2DEmpty(target, level, internalFormat, format, kind, width, height int) {
+func (c *Context) TexImage2DEmpty(target Enum, level int, internalFormat, format, kind Enum, width, height int) {
 	gl.TexImage2D(uint32(target), int32(level), int32(internalFormat), int32(width), int32(height), int32(0), uint32(format), uint32(kind), nil)
 }
 
+// TexSubImage2D replaces a width×height rectangle of the bound texture at
+// (xoffset, yoffset). data is a []byte of tightly packed rows, or an
+// image.Image whose top-left rectangle is converted to format and used. The
+// pixel storage parameters are restored after the upload.
+func (c *Context) TexSubImage2D(target Enum, level, xoffset, yoffset, width, height int, format, kind Enum, data interface{}) error {
+	pix, err := c.texturePixels(data, width, height, format, kind)
+	if err != nil {
+		return err
+	}
+	if len(pix) == 0 {
+		return nil
+	}
+	defer pixelStore(gl.UNPACK_ROW_LENGTH, 0)()
+	defer pixelStore(gl.UNPACK_ALIGNMENT, 1)()
+	gl.TexSubImage2D(uint32(target), int32(level), int32(xoffset), int32(yoffset), int32(width), int32(height), uint32(format), uint32(kind), gl.Ptr(pix))
+	return nil
+}
+
+// pixelStore sets the pixel storage parameter pname to param and returns a
+// func restoring its previous value, so that uploads and reads leave the
+// parameters set with PixelStorei as they were.
+func pixelStore(pname uint32, param int32) (restore func()) {
+	var previous int32
+	gl.GetIntegerv(pname, &previous)
+	if previous == param {
+		return func() {}
+	}
+	gl.PixelStorei(pname, param)
+	return func() { gl.PixelStorei(pname, previous) }
+}
+
 func (c *Context) GetAttribLocation(program *Program, name string) int {
 	return int(gl.GetAttribLocation(program.uint32, gl.Str(name+"\x00")))
 }
@@ -804,8 +300,21 @@ func (c *Context) GetUniformLocation(program *Program, name string) *UniformLoca
 	return &UniformLocation{gl.GetUniformLocation(program.uint32, gl.Str(name+"\x00"))}
 }
 
-func (c *Context) GetError() int {
-	return int(gl.GetError())
+func (c *Context) GetError() Enum {
+	if c.enumError {
+		c.enumError = false
+		return c.INVALID_ENUM
+	}
+	if c.valueError {
+		c.valueError = false
+		return c.INVALID_VALUE
+	}
+	return Enum(gl.GetError())
+}
+
+// invalidValue makes the next GetError return INVALID_VALUE.
+func (c *Context) invalidValue() {
+	c.valueError = true
 }
 
 func (c *Context) CreateBuffer() *Buffer {
@@ -819,7 +328,7 @@ func (c *Context) DeleteBuffer(buffer *Buffer) {
 	gl.DeleteBuffers(1, &[]uint32{buffer.uint32}[0])
 }
 
-func (c *Context) BindBuffer(target int, buffer *Buffer) {
+func (c *Context) BindBuffer(target Enum, buffer *Buffer) {
 	if buffer == nil {
 		gl.BindBuffer(uint32(target), 0)
 		return
@@ -827,7 +336,7 @@ func (c *Context) BindBuffer(target int, buffer *Buffer) {
 	gl.BindBuffer(uint32(target), buffer.uint32)
 }
 
-func (c *Context) BufferData(target int, data interface{}, usage int) {
+func (c *Context) BufferData(target Enum, data interface{}, usage Enum) {
 	s := uintptr(reflect.ValueOf(data).Len()) * reflect.TypeOf(data).Elem().Size()
 	gl.BufferData(uint32(target), int(s), gl.Ptr(data), uint32(usage))
 }
@@ -840,48 +349,139 @@ func (c *Context) DisableVertexAttribArray(index int) {
 	gl.DisableVertexAttribArray(uint32(index))
 }
 
-func (c *Context) VertexAttribPointer(index, size, typ int, normal bool, stride int, offset int) {
+func (c *Context) VertexAttribPointer(index, size int, typ Enum, normal bool, stride, offset int) {
 	gl.VertexAttribPointer(uint32(index), int32(size), uint32(typ), normal, int32(stride), gl.PtrOffset(offset))
 }
 
-func (c *Context) Enable(flag int) {
+// VertexAttrib1f sets the constant value of a generic vertex attribute, used
+// when its attribute array is disabled.
+func (c *Context) VertexAttrib1f(index int, x float32) {
+	gl.VertexAttrib1f(uint32(index), x)
+}
+
+// VertexAttrib2f sets the constant value of a generic vertex attribute, used
+// when its attribute array is disabled.
+func (c *Context) VertexAttrib2f(index int, x, y float32) {
+	gl.VertexAttrib2f(uint32(index), x, y)
+}
+
+// VertexAttrib3f sets the constant value of a generic vertex attribute, used
+// when its attribute array is disabled.
+func (c *Context) VertexAttrib3f(index int, x, y, z float32) {
+	gl.VertexAttrib3f(uint32(index), x, y, z)
+}
+
+// VertexAttrib4f sets the constant value of a generic vertex attribute, used
+// when its attribute array is disabled.
+func (c *Context) VertexAttrib4f(index int, x, y, z, w float32) {
+	gl.VertexAttrib4f(uint32(index), x, y, z, w)
+}
+
+// VertexAttrib1fv sets the constant value of a generic vertex attribute from
+// the first element of value.
+func (c *Context) VertexAttrib1fv(index int, value []float32) {
+	if !c.attribValues(1, value) {
+		return
+	}
+	gl.VertexAttrib1fv(uint32(index), &value[0])
+}
+
+// VertexAttrib2fv sets the constant value of a generic vertex attribute from
+// the first 2 values of value.
+func (c *Context) VertexAttrib2fv(index int, value []float32) {
+	if !c.attribValues(2, value) {
+		return
+	}
+	gl.VertexAttrib2fv(uint32(index), &value[0])
+}
+
+// VertexAttrib3fv sets the constant value of a generic vertex attribute from
+// the first 3 values of value.
+func (c *Context) VertexAttrib3fv(index int, value []float32) {
+	if !c.attribValues(3, value) {
+		return
+	}
+	gl.VertexAttrib3fv(uint32(index), &value[0])
+}
+
+// VertexAttrib4fv sets the constant value of a generic vertex attribute from
+// the first 4 values of value.
+func (c *Context) VertexAttrib4fv(index int, value []float32) {
+	if !c.attribValues(4, value) {
+		return
+	}
+	gl.VertexAttrib4fv(uint32(index), &value[0])
+}
+
+// GetVertexAttribi returns an integer parameter of the vertex attribute at index.
+func (c *Context) GetVertexAttribi(index int, pname Enum) int {
+	var param int32
+	gl.GetVertexAttribiv(uint32(index), uint32(pname), &param)
+	return int(param)
+}
+
+// GetVertexAttribfv returns the four floating point values of a vertex
+// attribute parameter such as CURRENT_VERTEX_ATTRIB. Scalar parameters are
+// returned in the first element.
+func (c *Context) GetVertexAttribfv(index int, pname Enum) []float32 {
+	params := make([]float32, 4)
+	gl.GetVertexAttribfv(uint32(index), uint32(pname), &params[0])
+	return params
+}
+
+func (c *Context) Enable(flag Enum) {
+	if c.unsupportedEnum(flag) {
+		return
+	}
 	gl.Enable(uint32(flag))
 }
 
-func (c *Context) Disable(flag int) {
+func (c *Context) Disable(flag Enum) {
+	if c.unsupportedEnum(flag) {
+		return
+	}
 	gl.Disable(uint32(flag))
 }
 
-func (c *Context) BlendFunc(src, dst int) {
+func (c *Context) BlendFunc(src, dst Enum) {
 	gl.BlendFunc(uint32(src), uint32(dst))
 }
 
-func (c *Context) BlendEquation(mode int) {
+func (c *Context) BlendEquation(mode Enum) {
 	gl.BlendEquation(uint32(mode))
 }
 
+// UniformMatrix2fv sets the values of a 2x2 matrix, or an array of them,
+// for a uniform variable. The number of matrices is derived from len(value),
+// which must be a multiple of 4.
 func (c *Context) UniformMatrix2fv(location *UniformLocation, transpose bool, value []float32) {
-	// TODO: count value of 1 is currently hardcoded.
-	//       Perhaps it should be len(value) / 16 or something else?
-	//       In OpenGL 2.1 it is a manually supplied parameter, but WebGL does not have it.
-	//       Not sure if WebGL automatically deduces it and supports count values greater than 1, or if 1 is always assumed.
-	gl.UniformMatrix2fv(location.int32, 1, transpose, &value[0])
+	count := c.matrixCount(2, value)
+	if count == 0 {
+		return
+	}
+	gl.UniformMatrix2fv(location.int32, int32(count), transpose, &value[0])
 }
 
+// UniformMatrix3fv sets the values of a 3x3 matrix, or an array of them,
+// for a uniform variable. The number of matrices is derived from len(value),
+// which must be a multiple of 9.
 func (c *Context) UniformMatrix3fv(location *UniformLocation, transpose bool, value []float32) {
-	// TODO: count value of 1 is currently hardcoded.
-	//       Perhaps it should be len(value) / 16 or something else?
-	//       In OpenGL 2.1 it is a manually supplied parameter, but WebGL does not have it.
-	//       Not sure if WebGL automatically deduces it and supports count values greater than 1, or if 1 is always assumed.
-	gl.UniformMatrix3fv(location.int32, 1, transpose, &value[0])
+	count := c.matrixCount(3, value)
+	if count == 0 {
+		return
+	}
+	gl.UniformMatrix3fv(location.int32, int32(count), transpose, &value[0])
 }
 
+// UniformMatrix4fv sets the values of a 4x4 matrix, or an array of them,
+// for a uniform variable. The number of matrices is derived from len(value),
+// which must be a multiple of 16.
 func (c *Context) UniformMatrix4fv(location *UniformLocation, transpose bool, value []float32) {
-	// TODO: count value of 1 is currently hardcoded.
-	//       Perhaps it should be len(value) / 16 or something else?
-	//       In OpenGL 2.1 it is a manually supplied parameter, but WebGL does not have it.
-	//       Not sure if WebGL automatically deduces it and supports count values greater than 1, or if 1 is always assumed.
-	gl.UniformMatrix4fv(location.int32, 1, transpose, &value[0])
+	count := c.matrixCount(4, value)
+	if count == 0 {
+		return
+	}
+	gl.UniformMatrix4fv(location.int32, int32(count), transpose, &value[0])
 }
 
 func (c *Context) UseProgram(program *Program) {
@@ -927,19 +527,123 @@ func (c *Context) Uniform4f(location *UniformLocation, x, y, z, w float32) {
 	gl.Uniform4f(location.int32, x, y, z, w)
 }
 
-func (c *Context) BufferSubData(target int, offset int, data interface{}) {
+func (c *Context) Uniform2i(location *UniformLocation, x, y int) {
+	gl.Uniform2i(location.int32, int32(x), int32(y))
+}
+
+func (c *Context) Uniform3i(location *UniformLocation, x, y, z int) {
+	gl.Uniform3i(location.int32, int32(x), int32(y), int32(z))
+}
+
+func (c *Context) Uniform4i(location *UniformLocation, x, y, z, w int) {
+	gl.Uniform4i(location.int32, int32(x), int32(y), int32(z), int32(w))
+}
+
+// Uniform1fv assigns an array of float values to a uniform variable
+// for the current program object.
+func (c *Context) Uniform1fv(location *UniformLocation, value []float32) {
+	count := c.vectorCount(1, len(value))
+	if count == 0 {
+		return
+	}
+	gl.Uniform1fv(location.int32, int32(count), &value[0])
+}
+
+// Uniform1iv assigns an array of int values to a uniform variable
+// for the current program object.
+func (c *Context) Uniform1iv(location *UniformLocation, value []int32) {
+	count := c.vectorCount(1, len(value))
+	if count == 0 {
+		return
+	}
+	gl.Uniform1iv(location.int32, int32(count), &value[0])
+}
+
+// Uniform2fv assigns an array of vec2 values to a uniform variable
+// for the current program object.
+func (c *Context) Uniform2fv(location *UniformLocation, value []float32) {
+	count := c.vectorCount(2, len(value))
+	if count == 0 {
+		return
+	}
+	gl.Uniform2fv(location.int32, int32(count), &value[0])
+}
+
+// Uniform2iv assigns an array of ivec2 values to a uniform variable
+// for the current program object.
+func (c *Context) Uniform2iv(location *UniformLocation, value []int32) {
+	count := c.vectorCount(2, len(value))
+	if count == 0 {
+		return
+	}
+	gl.Uniform2iv(location.int32, int32(count), &value[0])
+}
+
+// Uniform3fv assigns an array of vec3 values to a uniform variable
+// for the current program object.
+func (c *Context) Uniform3fv(location *UniformLocation, value []float32) {
+	count := c.vectorCount(3, len(value))
+	if count == 0 {
+		return
+	}
+	gl.Uniform3fv(location.int32, int32(count), &value[0])
+}
+
+// Uniform3iv assigns an array of ivec3 values to a uniform variable
+// for the current program object.
+func (c *Context) Uniform3iv(location *UniformLocation, value []int32) {
+	count := c.vectorCount(3, len(value))
+	if count == 0 {
+		return
+	}
+	gl.Uniform3iv(location.int32, int32(count), &value[0])
+}
+
+// Uniform4fv assigns an array of vec4 values to a uniform variable
+// for the current program object.
+func (c *Context) Uniform4fv(location *UniformLocation, value []float32) {
+	count := c.vectorCount(4, len(value))
+	if count == 0 {
+		return
+	}
+	gl.Uniform4fv(location.int32, int32(count), &value[0])
+}
+
+// Uniform4iv assigns an array of ivec4 values to a uniform variable
+// for the current program object.
+func (c *Context) Uniform4iv(location *UniformLocation, value []int32) {
+	count := c.vectorCount(4, len(value))
+	if count == 0 {
+		return
+	}
+	gl.Uniform4iv(location.int32, int32(count), &value[0])
+}
+
+func (c *Context) BufferSubData(target Enum, offset int, data interface{}) {
 	size := uintptr(reflect.ValueOf(data).Len()) * reflect.TypeOf(data).Elem().Size()
 	gl.BufferSubData(uint32(target), offset, int(size), gl.Ptr(data))
 }
 
-func (c *Context) DrawArrays(mode, first, count int) {
+func (c *Context) DrawArrays(mode Enum, first, count int) {
 	gl.DrawArrays(uint32(mode), int32(first), int32(count))
 }
 
-func (c *Context) DrawElements(mode, count, typ, offset int) {
+func (c *Context) DrawElements(mode Enum, count int, typ Enum, offset int) {
 	gl.DrawElements(uint32(mode), int32(count), uint32(typ), gl.PtrOffset(offset))
 }
 
+// ReadPixelsInto reads a rectangle of the currently bound framebuffer as RGBA
+// into pixels, top row first. pixels must hold at least 4*width*height bytes.
+func (c *Context) ReadPixelsInto(x, y, width, height int, pixels []byte) error {
+	if err := checkReadPixels(width, height, pixels); err != nil {
+		return err
+	}
+	defer pixelStore(gl.PACK_ALIGNMENT, 4)()
+	gl.ReadPixels(int32(x), int32(y), int32(width), int32(height), gl.RGBA, gl.UNSIGNED_BYTE, gl.Ptr(pixels))
+	flipRows(pixels, 4*width, height)
+	return nil
+}
+
 func (c *Context) ClearColor(r, g, b, a float32) {
 	gl.ClearColor(r, g, b, a)
 }
@@ -954,11 +658,145 @@ func (c *Context) GetViewport() [4]int32 {
 	return params
 }
 
+// GetParameterBool returns the first value of the state variable pname.
+func (c *Context) GetParameterBool(pname Enum) bool {
+	v := c.GetParameterBools(pname)
+	return len(v) > 0 && v[0]
+}
+
+// maxParameterSize is the number of values glGet may store for any pname but
+// COMPRESSED_TEXTURE_FORMATS, that of a 4×4 matrix. Values are read into
+// arrays of this size, so that pnames parameterSize doesn't know about can't
+// write past them.
+const maxParameterSize = 16
+
+// GetParameterBools returns the values of the state variable pname.
+func (c *Context) GetParameterBools(pname Enum) []bool {
+	if c.unsupportedEnum(pname) {
+		return nil
+	}
+	n := c.parameterSize(pname)
+	if n == 0 {
+		return nil
+	}
+	var scratch [maxParameterSize]bool
+	v := scratch[:]
+	if n > len(v) {
+		v = make([]bool, n)
+	}
+	gl.GetBooleanv(uint32(pname), &v[0])
+	return v[:n]
+}
+
+// GetParameterFloat returns the first value of the state variable pname.
+func (c *Context) GetParameterFloat(pname Enum) float32 {
+	v := c.GetParameterFloats(pname)
+	if len(v) == 0 {
+		return 0
+	}
+	return v[0]
+}
+
+// GetParameterFloats returns the values of the state variable pname.
+func (c *Context) GetParameterFloats(pname Enum) []float32 {
+	if c.unsupportedEnum(pname) {
+		return nil
+	}
+	n := c.parameterSize(pname)
+	if n == 0 {
+		return nil
+	}
+	var scratch [maxParameterSize]float32
+	v := scratch[:]
+	if n > len(v) {
+		v = make([]float32, n)
+	}
+	gl.GetFloatv(uint32(pname), &v[0])
+	return v[:n]
+}
+
+// GetParameterInt returns the first value of the state variable pname.
+// Object bindings such as CURRENT_PROGRAM are returned as the object's name.
+func (c *Context) GetParameterInt(pname Enum) int {
+	v := c.GetParameterInts(pname)
+	if len(v) == 0 {
+		return 0
+	}
+	return int(v[0])
+}
+
+// GetParameterInts returns the values of the state variable pname.
+func (c *Context) GetParameterInts(pname Enum) []int32 {
+	if c.unsupportedEnum(pname) {
+		return nil
+	}
+	n := c.parameterSize(pname)
+	if n == 0 {
+		return nil
+	}
+	var scratch [maxParameterSize]int32
+	v := scratch[:]
+	if n > len(v) {
+		v = make([]int32, n)
+	}
+	gl.GetIntegerv(uint32(pname), &v[0])
+	return v[:n]
+}
+
+// GetParameterString returns the string state variable pname, such as VENDOR
+// or SHADING_LANGUAGE_VERSION.
+func (c *Context) GetParameterString(pname Enum) string {
+	if c.unsupportedEnum(pname) {
+		return ""
+	}
+	str := gl.GetString(uint32(pname))
+	if str == nil {
+		return ""
+	}
+	return gl.GoStr(str)
+}
+
+// GetSupportedExtensions returns the names of the extensions supported by the
+// driver.
+func (c *Context) GetSupportedExtensions() []string {
+	return strings.Fields(c.GetParameterString(c.EXTENSIONS))
+}
+
+// Extensions returns the names of the supported extensions without their
+// "GL_" prefix, e.g. "EXT_texture_filter_anisotropic".
+func (c *Context) Extensions() []string {
+	return glExtensions(c.GetParameterString(c.EXTENSIONS))
+}
+
+// HasExtension reports whether the extension name is supported. The name may
+// be given with or without its "GL_" prefix.
+func (c *Context) HasExtension(name string) bool {
+	return containsExtension(c.Extensions(), name)
+}
+
+// Capabilities queries the limits and features of the current context.
+// OpenGL 2.1 has no MAX_VARYING_VECTORS, so it is derived from
+// MAX_VARYING_FLOATS.
+func (c *Context) Capabilities() Capabilities {
+	return Capabilities{
+		MaxTextureSize:         c.GetParameterInt(c.MAX_TEXTURE_SIZE),
+		MaxVertexAttribs:       c.GetParameterInt(c.MAX_VERTEX_ATTRIBS),
+		MaxTextureImageUnits:   c.GetParameterInt(c.MAX_TEXTURE_IMAGE_UNITS),
+		MaxVaryingVectors:      c.GetParameterInt(gl.MAX_VARYING_FLOATS) / 4,
+		MaxRenderbufferSize:    c.GetParameterInt(c.MAX_RENDERBUFFER_SIZE),
+		ShadingLanguageVersion: c.GetParameterString(c.SHADING_LANGUAGE_VERSION),
+		Vendor:                 c.GetParameterString(c.VENDOR),
+		Renderer:               c.GetParameterString(c.RENDERER),
+		Version:                c.GetParameterString(c.VERSION),
+		Extensions:             c.Extensions(),
+	}
+}
+
 func (c *Context) Scissor(x, y, width, height int) {
 	gl.Scissor(int32(x), int32(y), int32(width), int32(height))
 }
 
-func (c *Context) Clear(flags int) {
+func (c *Context) Clear(flags Enum) {
 	gl.Clear(uint32(flags))
 }
 
@@ -1000,7 +838,7 @@ func (c *Context) BindRenderBuffer(rb *RenderBuffer) {
 }
 
 // RenderBufferStorage establishes the data storage, format, and dimensions of a renderbuffer object's image.
-func (c *Context) RenderBufferStorage(internalFormat int, width, height int) {
+func (c *Context) RenderBufferStorage(internalFormat Enum, width, height int) {
 	gl.RenderbufferStorage(gl.RENDERBUFFER, uint32(internalFormat), int32(width), int32(height))
 }
 
@@ -1026,11 +864,259 @@ func (c *Context) BindFrameBuffer(fb *FrameBuffer) {
 }
 
 // FrameBufferTexture2D attaches a texture to a FrameBuffer
-func (c *Context) FrameBufferTexture2D(target, attachment, texTarget int, t *Texture, level int) {
+func (c *Context) FrameBufferTexture2D(target, attachment, texTarget Enum, t *Texture, level int) {
 	gl.FramebufferTexture2D(uint32(target), uint32(attachment), uint32(texTarget), t.uint32, int32(level))
 }
 
 // FrameBufferRenderBuffer attaches a RenderBuffer object to a FrameBuffer object.
-func (c *Context) FrameBufferRenderBuffer(target, attachment int, rb *RenderBuffer) {
+func (c *Context) FrameBufferRenderBuffer(target, attachment Enum, rb *RenderBuffer) {
 	gl.FramebufferRenderbuffer(uint32(target), uint32(attachment), gl.RENDERBUFFER, rb.uint32)
 }
+
+// BlendColor sets the constant color used by the CONSTANT_COLOR and
+// CONSTANT_ALPHA blend factors.
+func (c *Context) BlendColor(r, g, b, a float32) {
+	gl.BlendColor(r, g, b, a)
+}
+
+func (c *Context) BlendEquationSeparate(modeRGB, modeAlpha Enum) {
+	gl.BlendEquationSeparate(uint32(modeRGB), uint32(modeAlpha))
+}
+
+func (c *Context) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha Enum) {
+	gl.BlendFuncSeparate(uint32(srcRGB), uint32(dstRGB), uint32(srcAlpha), uint32(dstAlpha))
+}
+
+func (c *Context) DepthFunc(fun Enum) {
+	gl.DepthFunc(uint32(fun))
+}
+
+func (c *Context) SampleCoverage(value float32, invert bool) {
+	gl.SampleCoverage(value, invert)
+}
+
+// StencilFunc sets the front and back function and reference value for
+// stencil testing.
+func (c *Context) StencilFunc(function Enum, ref, mask int) {
+	gl.StencilFunc(uint32(function), int32(ref), uint32(mask))
+}
+
+// StencilFuncSeparate sets the front and/or back function and reference value
+// for stencil testing.
+func (c *Context) StencilFuncSeparate(face, function Enum, ref, mask int) {
+	gl.StencilFuncSeparate(uint32(face), uint32(function), int32(ref), uint32(mask))
+}
+
+// StencilOp sets both the front and back-facing stencil test actions.
+func (c *Context) StencilOp(fail, zfail, zpass Enum) {
+	gl.StencilOp(uint32(fail), uint32(zfail), uint32(zpass))
+}
+
+// StencilOpSeparate sets the front and/or back-facing stencil test actions.
+func (c *Context) StencilOpSeparate(face, fail, zfail, zpass Enum) {
+	gl.StencilOpSeparate(uint32(face), uint32(fail), uint32(zfail), uint32(zpass))
+}
+
+// StencilMask controls the writing of individual bits in the front and back
+// stencil planes.
+func (c *Context) StencilMask(mask int) {
+	gl.StencilMask(uint32(mask))
+}
+
+// StencilMaskSeparate controls the writing of individual bits in the front
+// and/or back stencil planes.
+func (c *Context) StencilMaskSeparate(face Enum, mask int) {
+	gl.StencilMaskSeparate(uint32(face), uint32(mask))
+}
+
+// CheckFramebufferStatus returns whether the currently bound FrameBuffer is
+// complete. If not complete, returns the reason why.
+func (c *Context) CheckFramebufferStatus(target Enum) Enum {
+	return Enum(gl.CheckFramebufferStatus(uint32(target)))
+}
+
+func (c *Context) ClearDepth(depth float32) {
+	gl.ClearDepth(float64(depth))
+}
+
+// ClearStencil specifies the index used when clearing the stencil buffer.
+func (c *Context) ClearStencil(s int) {
+	gl.ClearStencil(int32(s))
+}
+
+func (c *Context) ColorMask(r, g, b, a bool) {
+	gl.ColorMask(r, g, b, a)
+}
+
+func (c *Context) DepthMask(flag bool) {
+	gl.DepthMask(flag)
+}
+
+// IsFramebuffer returns true if fb is a valid FrameBuffer object.
+func (c *Context) IsFramebuffer(fb *FrameBuffer) bool {
+	return gl.IsFramebuffer(fb.uint32)
+}
+
+// GetBufferParameter returns a parameter of the buffer bound to target.
+func (c *Context) GetBufferParameter(target, pname Enum) int {
+	var param int32
+	gl.GetBufferParameteriv(uint32(target), uint32(pname), &param)
+	return int(param)
+}
+
+// IsBuffer returns true if buffer is a valid Buffer object.
+func (c *Context) IsBuffer(buffer *Buffer) bool {
+	return gl.IsBuffer(buffer.uint32)
+}
+
+func (c *Context) DepthRange(zNear, zFar float32) {
+	gl.DepthRange(float64(zNear), float64(zFar))
+}
+
+func (c *Context) CullFace(mode Enum) {
+	gl.CullFace(uint32(mode))
+}
+
+func (c *Context) FrontFace(mode Enum) {
+	gl.FrontFace(uint32(mode))
+}
+
+func (c *Context) PolygonOffset(factor, units float32) {
+	gl.PolygonOffset(factor, units)
+}
+
+func (c *Context) DetachShader(program *Program, shader *Shader) {
+	gl.DetachShader(program.uint32, shader.uint32)
+}
+
+// GetAttachedShaders returns the shaders attached to program.
+func (c *Context) GetAttachedShaders(program *Program) []*Shader {
+	var count int32
+	gl.GetProgramiv(program.uint32, gl.ATTACHED_SHADERS, &count)
+	if count == 0 {
+		return nil
+	}
+
+	ids := make([]uint32, count)
+	gl.GetAttachedShaders(program.uint32, count, &count, &ids[0])
+
+	shaders := make([]*Shader, count)
+	for i := range shaders {
+		shaders[i] = &Shader{ids[i]}
+	}
+	return shaders
+}
+
+// GetShaderSource returns the source code string associated with a shader.
+func (c *Context) GetShaderSource(shader *Shader) string {
+	var length int32
+	gl.GetShaderiv(shader.uint32, gl.SHADER_SOURCE_LENGTH, &length)
+	if length == 0 {
+		return ""
+	}
+
+	source := make([]byte, length)
+	gl.GetShaderSource(shader.uint32, length, &length, &source[0])
+
+	return string(source[:length])
+}
+
+// IsProgram returns true if program is a valid Program object.
+func (c *Context) IsProgram(program *Program) bool {
+	return gl.IsProgram(program.uint32)
+}
+
+// IsShader returns true if shader is a valid Shader object.
+func (c *Context) IsShader(shader *Shader) bool {
+	return gl.IsShader(shader.uint32)
+}
+
+func (c *Context) CopyTexImage2D(target Enum, level int, internal Enum, x, y, w, h, border int) {
+	gl.CopyTexImage2D(uint32(target), int32(level), uint32(internal), int32(x), int32(y), int32(w), int32(h), int32(border))
+}
+
+func (c *Context) CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, w, h int) {
+	gl.CopyTexSubImage2D(uint32(target), int32(level), int32(xoffset), int32(yoffset), int32(x), int32(y), int32(w), int32(h))
+}
+
+func (c *Context) GenerateMipmap(target Enum) {
+	gl.GenerateMipmap(uint32(target))
+}
+
+// IsTexture returns true if texture is a valid Texture object.
+func (c *Context) IsTexture(texture *Texture) bool {
+	return gl.IsTexture(texture.uint32)
+}
+
+func (c *Context) Finish() {
+	gl.Finish()
+}
+
+func (c *Context) Flush() {
+	gl.Flush()
+}
+
+// IsContextLost always returns false, a desktop context can't be lost.
+func (c *Context) IsContextLost() bool {
+	return false
+}
+
+func (c *Context) IsEnabled(cap Enum) bool {
+	if c.unsupportedEnum(cap) {
+		return false
+	}
+	return gl.IsEnabled(uint32(cap))
+}
+
+func (c *Context) PixelStorei(pname Enum, param int) {
+	if c.unsupportedEnum(pname) {
+		return
+	}
+	gl.PixelStorei(uint32(pname), int32(param))
+}
+
+// GetActiveAttrib returns the name, size and type of the active attribute at
+// index in program.
+func (c *Context) GetActiveAttrib(program *Program, index int) (name string, size int, typ Enum) {
+	var maxLength int32
+	gl.GetProgramiv(program.uint32, gl.ACTIVE_ATTRIBUTE_MAX_LENGTH, &maxLength)
+	if maxLength == 0 {
+		return "", 0, 0
+	}
+
+	var length, s int32
+	var t uint32
+	buf := make([]byte, maxLength)
+	gl.GetActiveAttrib(program.uint32, uint32(index), maxLength, &length, &s, &t, &buf[0])
+
+	return string(buf[:length]), int(s), Enum(t)
+}
+
+// GetActiveUniform returns the name, size and type of the active uniform at
+// index in program.
+func (c *Context) GetActiveUniform(program *Program, index int) (name string, size int, typ Enum) {
+	var maxLength int32
+	gl.GetProgramiv(program.uint32, gl.ACTIVE_UNIFORM_MAX_LENGTH, &maxLength)
+	if maxLength == 0 {
+		return "", 0, 0
+	}
+
+	var length, s int32
+	var t uint32
+	buf := make([]byte, maxLength)
+	gl.GetActiveUniform(program.uint32, uint32(index), maxLength, &length, &s, &t, &buf[0])
+
+	return string(buf[:length]), int(s), Enum(t)
+}
+
+// GetRenderbufferParameter returns a parameter of the currently bound RenderBuffer.
+func (c *Context) GetRenderbufferParameter(target, pname Enum) int {
+	var param int32
+	gl.GetRenderbufferParameteriv(uint32(target), uint32(pname), &param)
+	return int(param)
+}
+
+// IsRenderbuffer returns true if rb is a valid RenderBuffer object.
+func (c *Context) IsRenderbuffer(rb *RenderBuffer) bool {
+	return gl.IsRenderbuffer(rb.uint32)
+}
@@ -0,0 +1,165 @@
+//go:build (darwin || linux || windows) && !ios && !android && !js && !nogl && !softgl
+// +build darwin linux windows
+// +build !ios
+// +build !android
+// +build !js
+// +build !nogl
+// +build !softgl
+
+package gl
+
+import (
+	"fmt"
+	"unsafe"
+
+	"github.com/go-gl/gl/v2.1/gl"
+)
+
+// DebugSeverity is the severity of a driver debug message, ordered from least
+// to most severe.
+type DebugSeverity int
+
+const (
+	DebugSeverityNotification DebugSeverity = iota
+	DebugSeverityLow
+	DebugSeverityMedium
+	DebugSeverityHigh
+)
+
+// debugSeverities maps each DebugSeverity to its GL value.
+var debugSeverities = [...]uint32{
+	DebugSeverityNotification: gl.DEBUG_SEVERITY_NOTIFICATION,
+	DebugSeverityLow:          gl.DEBUG_SEVERITY_LOW,
+	DebugSeverityMedium:       gl.DEBUG_SEVERITY_MEDIUM,
+	DebugSeverityHigh:         gl.DEBUG_SEVERITY_HIGH,
+}
+
+func (s DebugSeverity) String() string {
+	switch s {
+	case DebugSeverityNotification:
+		return "notification"
+	case DebugSeverityLow:
+		return "low"
+	case DebugSeverityMedium:
+		return "medium"
+	case DebugSeverityHigh:
+		return "high"
+	}
+	return fmt.Sprintf("DebugSeverity(%d)", int(s))
+}
+
+// DebugMessage is a message from the driver's debug output.
+type DebugMessage struct {
+	// Source and Type are the DEBUG_SOURCE_* and DEBUG_TYPE_* values
+	// reported by the driver.
+	Source int
+	Type   int
+
+	ID       uint32
+	Severity DebugSeverity
+	Message  string
+}
+
+func (m DebugMessage) String() string {
+	return fmt.Sprintf("gl: %s severity %s %s message 0x%X: %s", m.Severity, debugSourceName(m.Source), debugTypeName(m.Type), m.ID, m.Message)
+}
+
+// DebugOutput returns a ContextOption that routes the messages of the
+// KHR_debug or ARB_debug_output extension to callback. Messages less severe
+// than minSeverity are filtered out by the driver.
+//
+// Drivers may call callback from another thread, some time after the call
+// that caused the message. When synchronous is set, callback is instead
+// called before the faulting call returns, so a stack trace taken in callback
+// points at it; this slows rendering down.
+//
+// The option does nothing if the driver supports neither extension. Most
+// drivers only report all messages from a debug context, which is requested
+// when creating the window.
+func DebugOutput(callback func(DebugMessage), minSeverity DebugSeverity, synchronous bool) ContextOption {
+	return func(c *Context) {
+		proc := func(source, typ, id, severity uint32, length int32, message string, userParam unsafe.Pointer) {
+			m := DebugMessage{
+				Source:   int(source),
+				Type:     int(typ),
+				ID:       id,
+				Severity: debugSeverity(severity),
+				Message:  message,
+			}
+			if m.Severity >= minSeverity {
+				callback(m)
+			}
+		}
+
+		switch {
+		case c.HasExtension("KHR_debug"):
+			gl.Enable(gl.DEBUG_OUTPUT)
+			gl.DebugMessageCallback(proc, nil)
+			for s := DebugSeverityNotification; s < minSeverity && int(s) < len(debugSeverities); s++ {
+				gl.DebugMessageControl(gl.DONT_CARE, gl.DONT_CARE, debugSeverities[s], 0, nil, false)
+			}
+		case c.HasExtension("ARB_debug_output"):
+			gl.DebugMessageCallbackARB(proc, nil)
+			// ARB_debug_output has no notification severity.
+			for s := DebugSeverityLow; s < minSeverity && int(s) < len(debugSeverities); s++ {
+				gl.DebugMessageControlARB(gl.DONT_CARE, gl.DONT_CARE, debugSeverities[s], 0, nil, false)
+			}
+		default:
+			return
+		}
+
+		if synchronous {
+			gl.Enable(gl.DEBUG_OUTPUT_SYNCHRONOUS)
+		} else {
+			gl.Disable(gl.DEBUG_OUTPUT_SYNCHRONOUS)
+		}
+	}
+}
+
+// debugSeverity converts a GL debug severity to a DebugSeverity.
+func debugSeverity(severity uint32) DebugSeverity {
+	for s, v := range debugSeverities {
+		if v == severity {
+			return DebugSeverity(s)
+		}
+	}
+	return DebugSeverityNotification
+}
+
+func debugSourceName(source int) string {
+	switch source {
+	case gl.DEBUG_SOURCE_API:
+		return "api"
+	case gl.DEBUG_SOURCE_WINDOW_SYSTEM:
+		return "window system"
+	case gl.DEBUG_SOURCE_SHADER_COMPILER:
+		return "shader compiler"
+	case gl.DEBUG_SOURCE_THIRD_PARTY:
+		return "third party"
+	case gl.DEBUG_SOURCE_APPLICATION:
+		return "application"
+	}
+	return "other"
+}
+
+func debugTypeName(typ int) string {
+	switch typ {
+	case gl.DEBUG_TYPE_ERROR:
+		return "error"
+	case gl.DEBUG_TYPE_DEPRECATED_BEHAVIOR:
+		return "deprecated behavior"
+	case gl.DEBUG_TYPE_UNDEFINED_BEHAVIOR:
+		return "undefined behavior"
+	case gl.DEBUG_TYPE_PORTABILITY:
+		return "portability"
+	case gl.DEBUG_TYPE_PERFORMANCE:
+		return "performance"
+	case gl.DEBUG_TYPE_MARKER:
+		return "marker"
+	case gl.DEBUG_TYPE_PUSH_GROUP:
+		return "push group"
+	case gl.DEBUG_TYPE_POP_GROUP:
+		return "pop group"
+	}
+	return "other"
+}
@@ -0,0 +1,1207 @@
+//go:build softgl && !nogl
+// +build softgl,!nogl
+
+// The softgl backend renders in software, in pure Go, so that rendering can be
+// tested on machines without a GPU. It implements OpenGL ES 2.0 closely
+// enough to draw engo scenes, and reports misuse through GetError like a
+// driver would. Shaders are written in Go and registered for the GLSL source
+// they replace, see RegisterVertexShader.
+//
+// Textures are 2D only and sampled from their base level, colors are stored
+// with 8 bits per channel, depth as float32 and stencil with 8 bits.
+
+package gl
+
+type Texture struct{ uint32 }
+type Buffer struct{ uint32 }
+type FrameBuffer struct{ uint32 }
+type RenderBuffer struct{ uint32 }
+type Program struct{ uint32 }
+type UniformLocation struct{ int32 }
+type Shader struct{ uint32 }
+
+var _ Renderer = (*Context)(nil)
+
+// Limits of the softgl backend, reported by Capabilities.
+const (
+	softMaxVertexAttribs    = 16
+	softMaxTextureUnits     = 16
+	softMaxVaryingVectors   = 16
+	softMaxTextureSize      = 4096
+	softMaxRenderbufferSize = 4096
+	softMaxViewportSize     = 8192
+	softMaxLineWidth        = 16
+	softMaxPointSize        = 64
+)
+
+type Context struct {
+	Constants
+
+	// enumError is set when a method was passed an unsupported constant, and
+	// reported as INVALID_ENUM by the next GetError.
+	enumError bool
+
+	// err is the first error raised since GetError was last called.
+	err Enum
+
+	// Objects by name. Names are unique across all kinds of objects.
+	lastID        uint32
+	buffers       map[uint32]*softBuffer
+	textures      map[uint32]*softTexture
+	renderbuffers map[uint32]*softRenderbuffer
+	framebuffers  map[uint32]*softFramebuffer
+	shaders       map[uint32]*softShader
+	programs      map[uint32]*softProgram
+
+	vertexFuncs   map[string]VertexShaderFunc
+	fragmentFuncs map[string]FragmentShaderFunc
+
+	// screen is the default framebuffer.
+	screen softSurface
+
+	// Bindings.
+	arrayBuffer   *softBuffer
+	elementBuffer *softBuffer
+	activeTexture int
+	textureUnits  [softMaxTextureUnits]*softTexture
+	renderbuffer  *softRenderbuffer
+	framebuffer   *softFramebuffer
+	program       *softProgram
+	attribs       [softMaxVertexAttribs]softAttrib
+
+	enabled        map[Enum]bool
+	viewport       [4]int
+	scissorBox     [4]int
+	depthRange     [2]float32
+	clearColor     [4]float32
+	clearDepth     float32
+	clearStencil   int
+	colorMask      [4]bool
+	depthMask      bool
+	depthFunc      Enum
+	blendColor     [4]float32
+	blendEquation  [2]Enum // RGB, alpha
+	blendFunc      [4]Enum // source RGB, destination RGB, source alpha, destination alpha
+	stencil        [2]softStencil
+	cullFace       Enum
+	frontFace      Enum
+	lineWidth      float32
+	polygonOffset  [2]float32 // factor, units
+	sampleCoverage float32
+	sampleInvert   bool
+
+	unpackAlignment        int
+	packAlignment          int
+	unpackFlipY            bool
+	unpackPremultiplyAlpha bool
+}
+
+// softStencil is the stencil state of front or back facing primitives.
+type softStencil struct {
+	function           Enum
+	ref                int
+	valueMask          int
+	writeMask          int
+	fail, zfail, zpass Enum
+}
+
+// softBuffer is a buffer object.
+type softBuffer struct {
+	id    uint32
+	data  []byte
+	usage Enum
+}
+
+// softAttrib is the state of a generic vertex attribute.
+type softAttrib struct {
+	enabled    bool
+	size       int
+	typ        Enum
+	normalized bool
+	stride     int
+	offset     int
+	buffer     *softBuffer
+
+	// current is the value used when the array is disabled.
+	current [4]float32
+}
+
+// NewContext returns a Context rendering to a default framebuffer of the
+// given size, with depth and stencil buffers.
+func NewContext(width, height int) *Context {
+	c := &Context{
+		Constants:     newConstants(),
+		buffers:       make(map[uint32]*softBuffer),
+		textures:      make(map[uint32]*softTexture),
+		renderbuffers: make(map[uint32]*softRenderbuffer),
+		framebuffers:  make(map[uint32]*softFramebuffer),
+		shaders:       make(map[uint32]*softShader),
+		programs:      make(map[uint32]*softProgram),
+		vertexFuncs:   make(map[string]VertexShaderFunc),
+		fragmentFuncs: make(map[string]FragmentShaderFunc),
+
+		enabled:         make(map[Enum]bool),
+		viewport:        [4]int{0, 0, width, height},
+		scissorBox:      [4]int{0, 0, width, height},
+		depthRange:      [2]float32{0, 1},
+		clearDepth:      1,
+		colorMask:       [4]bool{true, true, true, true},
+		depthMask:       true,
+		lineWidth:       1,
+		sampleCoverage:  1,
+		unpackAlignment: 4,
+		packAlignment:   4,
+	}
+	c.err = c.NO_ERROR
+	c.enabled[c.DITHER] = true
+	c.depthFunc = c.LESS
+	c.blendEquation = [2]Enum{c.FUNC_ADD, c.FUNC_ADD}
+	c.blendFunc = [4]Enum{c.ONE, c.ZERO, c.ONE, c.ZERO}
+	for i := range c.stencil {
+		c.stencil[i] = softStencil{
+			function:  c.ALWAYS,
+			valueMask: 0xFF,
+			writeMask: 0xFF,
+			fail:      c.KEEP,
+			zfail:     c.KEEP,
+			zpass:     c.KEEP,
+		}
+	}
+	c.cullFace = c.BACK
+	c.frontFace = c.CCW
+	for i := range c.attribs {
+		c.attribs[i] = softAttrib{size: 4, typ: c.FLOAT, current: [4]float32{0, 0, 0, 1}}
+	}
+	c.screen = newSoftSurface(width, height)
+	c.loadEnumNames()
+	return c
+}
+
+// Resize resizes the default framebuffer, discarding its contents. The
+// viewport and scissor box are left alone, as when resizing a window.
+func (c *Context) Resize(width, height int) {
+	c.screen = newSoftSurface(width, height)
+}
+
+// setError records code as the result of the next GetError, unless an error
+// is already pending. As in OpenGL, the call that raised it has no effect.
+func (c *Context) setError(code Enum) {
+	if c.err == c.NO_ERROR {
+		c.err = code
+	}
+}
+
+// newID returns an unused object name.
+func (c *Context) newID() uint32 {
+	c.lastID++
+	return c.lastID
+}
+
+func (c *Context) GetError() Enum {
+	if c.enumError {
+		c.enumError = false
+		return c.INVALID_ENUM
+	}
+	err := c.err
+	c.err = c.NO_ERROR
+	return err
+}
+
+// invalidValue raises INVALID_VALUE.
+func (c *Context) invalidValue() {
+	c.setError(c.INVALID_VALUE)
+}
+
+// IsContextLost returns false, a software context can't be lost.
+func (c *Context) IsContextLost() bool {
+	return false
+}
+
+// Finish does nothing, drawing is done by the time a call returns.
+func (c *Context) Finish() {}
+
+// Flush does nothing, drawing is done by the time a call returns.
+func (c *Context) Flush() {}
+
+// capability reports whether cap can be enabled, raising INVALID_ENUM if not.
+func (c *Context) capability(cap Enum) bool {
+	if c.unsupportedEnum(cap) {
+		return false
+	}
+	switch cap {
+	case c.BLEND, c.CULL_FACE, c.DEPTH_TEST, c.DITHER, c.POLYGON_OFFSET_FILL,
+		c.SAMPLE_ALPHA_TO_COVERAGE, c.SAMPLE_COVERAGE, c.SCISSOR_TEST, c.STENCIL_TEST, c.MULTISAMPLE:
+		return true
+	}
+	c.setError(c.INVALID_ENUM)
+	return false
+}
+
+func (c *Context) Enable(flag Enum) {
+	if c.capability(flag) {
+		c.enabled[flag] = true
+	}
+}
+
+func (c *Context) Disable(flag Enum) {
+	if c.capability(flag) {
+		c.enabled[flag] = false
+	}
+}
+
+func (c *Context) IsEnabled(cap Enum) bool {
+	return c.capability(cap) && c.enabled[cap]
+}
+
+func (c *Context) PixelStorei(pname Enum, param int) {
+	if c.unsupportedEnum(pname) {
+		return
+	}
+	switch pname {
+	case c.UNPACK_ALIGNMENT, c.PACK_ALIGNMENT:
+		if param != 1 && param != 2 && param != 4 && param != 8 {
+			c.setError(c.INVALID_VALUE)
+			return
+		}
+		if pname == c.UNPACK_ALIGNMENT {
+			c.unpackAlignment = param
+		} else {
+			c.packAlignment = param
+		}
+	case c.UNPACK_FLIP_Y_WEBGL:
+		c.unpackFlipY = param != 0
+	case c.UNPACK_PREMULTIPLY_ALPHA_WEBGL:
+		c.unpackPremultiplyAlpha = param != 0
+	case c.UNPACK_COLORSPACE_CONVERSION_WEBGL:
+		// Images are never color converted.
+	default:
+		c.setError(c.INVALID_ENUM)
+	}
+}
+
+// PerFragment ---------------------------------------------------------------
+
+func (c *Context) BlendColor(r, g, b, a float32) {
+	c.blendColor = [4]float32{clamp01(r), clamp01(g), clamp01(b), clamp01(a)}
+}
+
+func (c *Context) BlendEquation(mode Enum) {
+	c.BlendEquationSeparate(mode, mode)
+}
+
+func (c *Context) BlendEquationSeparate(modeRGB, modeAlpha Enum) {
+	if !c.isBlendEquation(modeRGB) || !c.isBlendEquation(modeAlpha) {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	c.blendEquation = [2]Enum{modeRGB, modeAlpha}
+}
+
+func (c *Context) isBlendEquation(mode Enum) bool {
+	switch mode {
+	case c.FUNC_ADD, c.FUNC_SUBTRACT, c.FUNC_REVERSE_SUBTRACT:
+		return true
+	}
+	return false
+}
+
+func (c *Context) BlendFunc(src, dst Enum) {
+	c.BlendFuncSeparate(src, dst, src, dst)
+}
+
+func (c *Context) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha Enum) {
+	for _, f := range []Enum{srcRGB, dstRGB, srcAlpha, dstAlpha} {
+		if !c.isBlendFactor(f) {
+			c.setError(c.INVALID_ENUM)
+			return
+		}
+	}
+	if dstRGB == c.SRC_ALPHA_SATURATE || dstAlpha == c.SRC_ALPHA_SATURATE {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	c.blendFunc = [4]Enum{srcRGB, dstRGB, srcAlpha, dstAlpha}
+}
+
+func (c *Context) isBlendFactor(f Enum) bool {
+	switch f {
+	case c.ZERO, c.ONE, c.SRC_COLOR, c.ONE_MINUS_SRC_COLOR, c.DST_COLOR, c.ONE_MINUS_DST_COLOR,
+		c.SRC_ALPHA, c.ONE_MINUS_SRC_ALPHA, c.DST_ALPHA, c.ONE_MINUS_DST_ALPHA,
+		c.CONSTANT_COLOR, c.ONE_MINUS_CONSTANT_COLOR, c.CONSTANT_ALPHA, c.ONE_MINUS_CONSTANT_ALPHA,
+		c.SRC_ALPHA_SATURATE:
+		return true
+	}
+	return false
+}
+
+func (c *Context) DepthFunc(fun Enum) {
+	if !c.isCompareFunc(fun) {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	c.depthFunc = fun
+}
+
+func (c *Context) isCompareFunc(f Enum) bool {
+	switch f {
+	case c.NEVER, c.LESS, c.EQUAL, c.LEQUAL, c.GREATER, c.NOTEQUAL, c.GEQUAL, c.ALWAYS:
+		return true
+	}
+	return false
+}
+
+// SampleCoverage only records its arguments, there is no multisampling.
+func (c *Context) SampleCoverage(value float32, invert bool) {
+	c.sampleCoverage = clamp01(value)
+	c.sampleInvert = invert
+}
+
+func (c *Context) StencilFunc(function Enum, ref, mask int) {
+	c.StencilFuncSeparate(c.FRONT_AND_BACK, function, ref, mask)
+}
+
+func (c *Context) StencilFuncSeparate(face, function Enum, ref, mask int) {
+	if !c.isCompareFunc(function) {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	c.stencilFaces(face, func(s *softStencil) {
+		s.function, s.ref, s.valueMask = function, ref, mask
+	})
+}
+
+func (c *Context) StencilOp(fail, zfail, zpass Enum) {
+	c.StencilOpSeparate(c.FRONT_AND_BACK, fail, zfail, zpass)
+}
+
+func (c *Context) StencilOpSeparate(face, fail, zfail, zpass Enum) {
+	for _, op := range []Enum{fail, zfail, zpass} {
+		switch op {
+		case c.KEEP, c.ZERO, c.REPLACE, c.INCR, c.INCR_WRAP, c.DECR, c.DECR_WRAP, c.INVERT:
+		default:
+			c.setError(c.INVALID_ENUM)
+			return
+		}
+	}
+	c.stencilFaces(face, func(s *softStencil) {
+		s.fail, s.zfail, s.zpass = fail, zfail, zpass
+	})
+}
+
+// stencilFaces calls fn with the stencil state of the faces selected by face.
+func (c *Context) stencilFaces(face Enum, fn func(s *softStencil)) {
+	switch face {
+	case c.FRONT:
+		fn(&c.stencil[0])
+	case c.BACK:
+		fn(&c.stencil[1])
+	case c.FRONT_AND_BACK:
+		fn(&c.stencil[0])
+		fn(&c.stencil[1])
+	default:
+		c.setError(c.INVALID_ENUM)
+	}
+}
+
+// FrameBuffer ---------------------------------------------------------------
+
+func (c *Context) ClearColor(r, g, b, a float32) {
+	c.clearColor = [4]float32{clamp01(r), clamp01(g), clamp01(b), clamp01(a)}
+}
+
+func (c *Context) ClearDepth(depth float32) {
+	c.clearDepth = clamp01(depth)
+}
+
+func (c *Context) ClearStencil(s int) {
+	c.clearStencil = s
+}
+
+func (c *Context) ColorMask(r, g, b, a bool) {
+	c.colorMask = [4]bool{r, g, b, a}
+}
+
+func (c *Context) DepthMask(flag bool) {
+	c.depthMask = flag
+}
+
+func (c *Context) StencilMask(mask int) {
+	c.StencilMaskSeparate(c.FRONT_AND_BACK, mask)
+}
+
+func (c *Context) StencilMaskSeparate(face Enum, mask int) {
+	c.stencilFaces(face, func(s *softStencil) {
+		s.writeMask = mask
+	})
+}
+
+// Buffer --------------------------------------------------------------------
+
+func (c *Context) CreateBuffer() *Buffer {
+	b := &softBuffer{id: c.newID()}
+	b.usage = c.STATIC_DRAW
+	c.buffers[b.id] = b
+	return &Buffer{b.id}
+}
+
+func (c *Context) DeleteBuffer(buffer *Buffer) {
+	b := c.buffers[bufferID(buffer)]
+	if b == nil {
+		return
+	}
+	delete(c.buffers, b.id)
+	if c.arrayBuffer == b {
+		c.arrayBuffer = nil
+	}
+	if c.elementBuffer == b {
+		c.elementBuffer = nil
+	}
+	for i := range c.attribs {
+		if c.attribs[i].buffer == b {
+			c.attribs[i].buffer = nil
+		}
+	}
+}
+
+func (c *Context) IsBuffer(buffer *Buffer) bool {
+	return c.buffers[bufferID(buffer)] != nil
+}
+
+func (c *Context) BindBuffer(target Enum, buffer *Buffer) {
+	var b *softBuffer
+	if id := bufferID(buffer); id != 0 {
+		if b = c.buffers[id]; b == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	switch target {
+	case c.ARRAY_BUFFER:
+		c.arrayBuffer = b
+	case c.ELEMENT_ARRAY_BUFFER:
+		c.elementBuffer = b
+	default:
+		c.setError(c.INVALID_ENUM)
+	}
+}
+
+// boundBuffer returns the buffer bound to target, raising an error and
+// returning nil if there is none.
+func (c *Context) boundBuffer(target Enum) *softBuffer {
+	var b *softBuffer
+	switch target {
+	case c.ARRAY_BUFFER:
+		b = c.arrayBuffer
+	case c.ELEMENT_ARRAY_BUFFER:
+		b = c.elementBuffer
+	default:
+		c.setError(c.INVALID_ENUM)
+		return nil
+	}
+	if b == nil {
+		c.setError(c.INVALID_OPERATION)
+	}
+	return b
+}
+
+// BufferData replaces the data of the bound buffer with the slice data, or
+// with data zero bytes if it is an int.
+func (c *Context) BufferData(target Enum, data interface{}, usage Enum) {
+	b := c.boundBuffer(target)
+	if b == nil {
+		return
+	}
+	switch usage {
+	case c.STREAM_DRAW, c.STATIC_DRAW, c.DYNAMIC_DRAW:
+	default:
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	if size, ok := data.(int); ok {
+		if size < 0 {
+			c.setError(c.INVALID_VALUE)
+			return
+		}
+		b.data, b.usage = make([]byte, size), usage
+		return
+	}
+	p, err := bufferBytes(data)
+	if err != nil {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	b.data, b.usage = p, usage
+}
+
+func (c *Context) BufferSubData(target Enum, offset int, data interface{}) {
+	b := c.boundBuffer(target)
+	if b == nil {
+		return
+	}
+	p, err := bufferBytes(data)
+	if err != nil || offset < 0 || offset+len(p) > len(b.data) {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	copy(b.data[offset:], p)
+}
+
+func (c *Context) GetBufferParameter(target, pname Enum) int {
+	b := c.boundBuffer(target)
+	if b == nil {
+		return 0
+	}
+	switch pname {
+	case c.BUFFER_SIZE:
+		return len(b.data)
+	case c.BUFFER_USAGE:
+		return int(b.usage)
+	}
+	c.setError(c.INVALID_ENUM)
+	return 0
+}
+
+func bufferID(buffer *Buffer) uint32 {
+	if buffer == nil {
+		return 0
+	}
+	return buffer.uint32
+}
+
+// View ----------------------------------------------------------------------
+
+func (c *Context) DepthRange(zNear, zFar float32) {
+	c.depthRange = [2]float32{clamp01(zNear), clamp01(zFar)}
+}
+
+func (c *Context) Scissor(x, y, width, height int) {
+	if width < 0 || height < 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	c.scissorBox = [4]int{x, y, width, height}
+}
+
+func (c *Context) Viewport(x, y, width, height int) {
+	if width < 0 || height < 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	c.viewport = [4]int{x, y, minInt(width, softMaxViewportSize), minInt(height, softMaxViewportSize)}
+}
+
+func (c *Context) GetViewport() [4]int32 {
+	return [4]int32{int32(c.viewport[0]), int32(c.viewport[1]), int32(c.viewport[2]), int32(c.viewport[3])}
+}
+
+// Rasterization -------------------------------------------------------------
+
+func (c *Context) CullFace(mode Enum) {
+	switch mode {
+	case c.FRONT, c.BACK, c.FRONT_AND_BACK:
+		c.cullFace = mode
+	default:
+		c.setError(c.INVALID_ENUM)
+	}
+}
+
+func (c *Context) FrontFace(mode Enum) {
+	switch mode {
+	case c.CW, c.CCW:
+		c.frontFace = mode
+	default:
+		c.setError(c.INVALID_ENUM)
+	}
+}
+
+// LineWidth sets the width of lines, in pixels across their minor axis.
+func (c *Context) LineWidth(width float32) {
+	if width <= 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	c.lineWidth = width
+}
+
+func (c *Context) PolygonOffset(factor, units float32) {
+	c.polygonOffset = [2]float32{factor, units}
+}
+
+// Uniforms and Attributes ---------------------------------------------------
+
+// attrib returns the generic vertex attribute at index, raising
+// INVALID_VALUE and returning nil if it is out of range.
+func (c *Context) attrib(index int) *softAttrib {
+	if index < 0 || index >= len(c.attribs) {
+		c.setError(c.INVALID_VALUE)
+		return nil
+	}
+	return &c.attribs[index]
+}
+
+func (c *Context) EnableVertexAttribArray(index int) {
+	if a := c.attrib(index); a != nil {
+		a.enabled = true
+	}
+}
+
+func (c *Context) DisableVertexAttribArray(index int) {
+	if a := c.attrib(index); a != nil {
+		a.enabled = false
+	}
+}
+
+// VertexAttribPointer sources the attribute at index from the bound
+// ARRAY_BUFFER. Client side arrays are not supported.
+func (c *Context) VertexAttribPointer(index, size int, typ Enum, normal bool, stride, offset int) {
+	a := c.attrib(index)
+	if a == nil {
+		return
+	}
+	if size < 1 || size > 4 || stride < 0 || stride > 255 || offset < 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	if c.componentSize(typ) == 0 {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	if c.arrayBuffer == nil {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	*a = softAttrib{
+		enabled:    a.enabled,
+		size:       size,
+		typ:        typ,
+		normalized: normal,
+		stride:     stride,
+		offset:     offset,
+		buffer:     c.arrayBuffer,
+		current:    a.current,
+	}
+}
+
+func (c *Context) VertexAttrib1f(index int, x float32) {
+	c.VertexAttrib4f(index, x, 0, 0, 1)
+}
+
+func (c *Context) VertexAttrib2f(index int, x, y float32) {
+	c.VertexAttrib4f(index, x, y, 0, 1)
+}
+
+func (c *Context) VertexAttrib3f(index int, x, y, z float32) {
+	c.VertexAttrib4f(index, x, y, z, 1)
+}
+
+func (c *Context) VertexAttrib4f(index int, x, y, z, w float32) {
+	if a := c.attrib(index); a != nil {
+		a.current = [4]float32{x, y, z, w}
+	}
+}
+
+func (c *Context) VertexAttrib1fv(index int, value []float32) {
+	c.vertexAttribv(index, 1, value)
+}
+
+func (c *Context) VertexAttrib2fv(index int, value []float32) {
+	c.vertexAttribv(index, 2, value)
+}
+
+func (c *Context) VertexAttrib3fv(index int, value []float32) {
+	c.vertexAttribv(index, 3, value)
+}
+
+func (c *Context) VertexAttrib4fv(index int, value []float32) {
+	c.vertexAttribv(index, 4, value)
+}
+
+func (c *Context) vertexAttribv(index, n int, value []float32) {
+	if !c.attribValues(n, value) {
+		return
+	}
+	v := [4]float32{0, 0, 0, 1}
+	copy(v[:n], value)
+	c.VertexAttrib4f(index, v[0], v[1], v[2], v[3])
+}
+
+func (c *Context) GetVertexAttribi(index int, pname Enum) int {
+	a := c.attrib(index)
+	if a == nil {
+		return 0
+	}
+	switch pname {
+	case c.VERTEX_ATTRIB_ARRAY_ENABLED:
+		return boolInt(a.enabled)
+	case c.VERTEX_ATTRIB_ARRAY_SIZE:
+		return a.size
+	case c.VERTEX_ATTRIB_ARRAY_STRIDE:
+		return a.stride
+	case c.VERTEX_ATTRIB_ARRAY_TYPE:
+		return int(a.typ)
+	case c.VERTEX_ATTRIB_ARRAY_NORMALIZED:
+		return boolInt(a.normalized)
+	case c.VERTEX_ATTRIB_ARRAY_BUFFER_BINDING:
+		if a.buffer == nil {
+			return 0
+		}
+		return int(a.buffer.id)
+	case c.VERTEX_ATTRIB_ARRAY_POINTER:
+		return a.offset
+	}
+	c.setError(c.INVALID_ENUM)
+	return 0
+}
+
+func (c *Context) GetVertexAttribfv(index int, pname Enum) []float32 {
+	params := make([]float32, 4)
+	if pname == c.CURRENT_VERTEX_ATTRIB {
+		if a := c.attrib(index); a != nil {
+			copy(params, a.current[:])
+		}
+		return params
+	}
+	params[0] = float32(c.GetVertexAttribi(index, pname))
+	return params
+}
+
+// RenderBuffer --------------------------------------------------------------
+
+// softRenderbuffer is a renderbuffer object, holding a color, depth or
+// stencil image depending on its format.
+type softRenderbuffer struct {
+	id     uint32
+	format Enum
+	width  int
+	height int
+
+	color   []byte
+	depth   []float32
+	stencil []byte
+}
+
+func (c *Context) CreateRenderBuffer() *RenderBuffer {
+	rb := &softRenderbuffer{id: c.newID()}
+	rb.format = c.RGBA4
+	c.renderbuffers[rb.id] = rb
+	return &RenderBuffer{rb.id}
+}
+
+func (c *Context) DeleteRenderBuffer(rb *RenderBuffer) {
+	r := c.renderbuffers[renderbufferID(rb)]
+	if r == nil {
+		return
+	}
+	delete(c.renderbuffers, r.id)
+	if c.renderbuffer == r {
+		c.renderbuffer = nil
+	}
+	if fb := c.framebuffer; fb != nil {
+		fb.detach(func(a softAttachment) bool { return a.renderbuffer == r })
+	}
+}
+
+func (c *Context) IsRenderbuffer(rb *RenderBuffer) bool {
+	return c.renderbuffers[renderbufferID(rb)] != nil
+}
+
+func (c *Context) BindRenderBuffer(rb *RenderBuffer) {
+	var r *softRenderbuffer
+	if id := renderbufferID(rb); id != 0 {
+		if r = c.renderbuffers[id]; r == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	c.renderbuffer = r
+}
+
+// RenderBufferStorage allocates the image of the bound renderbuffer, cleared
+// to zero.
+func (c *Context) RenderBufferStorage(internalFormat Enum, width, height int) {
+	r := c.renderbuffer
+	if r == nil {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	if width < 0 || height < 0 || width > softMaxRenderbufferSize || height > softMaxRenderbufferSize {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	*r = softRenderbuffer{id: r.id, format: internalFormat, width: width, height: height}
+	switch internalFormat {
+	case c.RGBA4, c.RGB565, c.RGB5_A1, c.RGBA8:
+		r.color = make([]byte, 4*width*height)
+	case c.DEPTH_COMPONENT16:
+		r.depth = make([]float32, width*height)
+	case c.STENCIL_INDEX8:
+		r.stencil = make([]byte, width*height)
+	case c.DEPTH_STENCIL:
+		r.depth = make([]float32, width*height)
+		r.stencil = make([]byte, width*height)
+	default:
+		*r = softRenderbuffer{id: r.id, format: c.RGBA4}
+		c.setError(c.INVALID_ENUM)
+	}
+}
+
+func (c *Context) GetRenderbufferParameter(target, pname Enum) int {
+	if target != c.RENDERBUFFER {
+		c.setError(c.INVALID_ENUM)
+		return 0
+	}
+	r := c.renderbuffer
+	if r == nil {
+		c.setError(c.INVALID_OPERATION)
+		return 0
+	}
+	switch pname {
+	case c.RENDERBUFFER_WIDTH:
+		return r.width
+	case c.RENDERBUFFER_HEIGHT:
+		return r.height
+	case c.RENDERBUFFER_INTERNAL_FORMAT:
+		return int(r.format)
+	case c.RENDERBUFFER_RED_SIZE, c.RENDERBUFFER_GREEN_SIZE, c.RENDERBUFFER_BLUE_SIZE, c.RENDERBUFFER_ALPHA_SIZE:
+		if r.color != nil {
+			return 8
+		}
+		return 0
+	case c.RENDERBUFFER_DEPTH_SIZE:
+		if r.depth != nil {
+			return 32
+		}
+		return 0
+	case c.RENDERBUFFER_STENCIL_SIZE:
+		if r.stencil != nil {
+			return 8
+		}
+		return 0
+	}
+	c.setError(c.INVALID_ENUM)
+	return 0
+}
+
+func renderbufferID(rb *RenderBuffer) uint32 {
+	if rb == nil {
+		return 0
+	}
+	return rb.uint32
+}
+
+// State ---------------------------------------------------------------------
+
+// parameter returns the values of the state variable pname, or nil after
+// raising INVALID_ENUM if there is no such variable.
+func (c *Context) parameter(pname Enum) []float32 {
+	if c.unsupportedEnum(pname) {
+		return nil
+	}
+	front, back := c.stencil[0], c.stencil[1]
+	switch pname {
+	case c.ACTIVE_TEXTURE:
+		return ints(int(c.TEXTURE0) + c.activeTexture)
+	case c.ALIASED_LINE_WIDTH_RANGE:
+		return []float32{1, softMaxLineWidth}
+	case c.ALIASED_POINT_SIZE_RANGE:
+		return []float32{1, softMaxPointSize}
+	case c.ARRAY_BUFFER_BINDING:
+		return ints(objectID(c.arrayBuffer))
+	case c.BLEND, c.CULL_FACE, c.DEPTH_TEST, c.DITHER, c.POLYGON_OFFSET_FILL,
+		c.SAMPLE_ALPHA_TO_COVERAGE, c.SAMPLE_COVERAGE, c.SCISSOR_TEST, c.STENCIL_TEST:
+		return ints(boolInt(c.enabled[pname]))
+	case c.BLEND_COLOR:
+		return c.blendColor[:]
+	case c.BLEND_EQUATION_RGB:
+		return ints(int(c.blendEquation[0]))
+	case c.BLEND_EQUATION_ALPHA:
+		return ints(int(c.blendEquation[1]))
+	case c.BLEND_SRC_RGB:
+		return ints(int(c.blendFunc[0]))
+	case c.BLEND_DST_RGB:
+		return ints(int(c.blendFunc[1]))
+	case c.BLEND_SRC_ALPHA:
+		return ints(int(c.blendFunc[2]))
+	case c.BLEND_DST_ALPHA:
+		return ints(int(c.blendFunc[3]))
+	case c.RED_BITS, c.GREEN_BITS, c.BLUE_BITS, c.STENCIL_BITS, c.SUBPIXEL_BITS:
+		return ints(8)
+	case c.DEPTH_BITS:
+		return ints(32)
+	case c.COLOR_CLEAR_VALUE:
+		return c.clearColor[:]
+	case c.COLOR_WRITEMASK:
+		return ints(boolInt(c.colorMask[0]), boolInt(c.colorMask[1]), boolInt(c.colorMask[2]), boolInt(c.colorMask[3]))
+	case c.COMPRESSED_TEXTURE_FORMATS:
+		return []float32{}
+	case c.CULL_FACE_MODE:
+		return ints(int(c.cullFace))
+	case c.CURRENT_PROGRAM:
+		return ints(objectID(c.program))
+	case c.DEPTH_CLEAR_VALUE:
+		return []float32{c.clearDepth}
+	case c.DEPTH_FUNC:
+		return ints(int(c.depthFunc))
+	case c.DEPTH_RANGE:
+		return c.depthRange[:]
+	case c.DEPTH_WRITEMASK:
+		return ints(boolInt(c.depthMask))
+	case c.ELEMENT_ARRAY_BUFFER_BINDING:
+		return ints(objectID(c.elementBuffer))
+	case c.FRAMEBUFFER_BINDING:
+		return ints(objectID(c.framebuffer))
+	case c.FRONT_FACE:
+		return ints(int(c.frontFace))
+	case c.LINE_WIDTH:
+		return []float32{c.lineWidth}
+	case c.MAX_COMBINED_TEXTURE_IMAGE_UNITS, c.MAX_TEXTURE_IMAGE_UNITS, c.MAX_VERTEX_TEXTURE_IMAGE_UNITS:
+		return ints(softMaxTextureUnits)
+	case c.MAX_CUBE_MAP_TEXTURE_SIZE:
+		return ints(0)
+	case c.MAX_FRAGMENT_UNIFORM_VECTORS, c.MAX_VERTEX_UNIFORM_VECTORS:
+		return ints(1024)
+	case c.MAX_RENDERBUFFER_SIZE:
+		return ints(softMaxRenderbufferSize)
+	case c.MAX_TEXTURE_SIZE:
+		return ints(softMaxTextureSize)
+	case c.MAX_VARYING_VECTORS:
+		return ints(softMaxVaryingVectors)
+	case c.MAX_VERTEX_ATTRIBS:
+		return ints(softMaxVertexAttribs)
+	case c.MAX_VIEWPORT_DIMS:
+		return ints(softMaxViewportSize, softMaxViewportSize)
+	case c.NUM_COMPRESSED_TEXTURE_FORMATS, c.SAMPLE_BUFFERS, c.SAMPLES:
+		return ints(0)
+	case c.PACK_ALIGNMENT:
+		return ints(c.packAlignment)
+	case c.POLYGON_OFFSET_FACTOR:
+		return []float32{c.polygonOffset[0]}
+	case c.POLYGON_OFFSET_UNITS:
+		return []float32{c.polygonOffset[1]}
+	case c.RENDERBUFFER_BINDING:
+		return ints(objectID(c.renderbuffer))
+	case c.SAMPLE_COVERAGE_INVERT:
+		return ints(boolInt(c.sampleInvert))
+	case c.SAMPLE_COVERAGE_VALUE:
+		return []float32{c.sampleCoverage}
+	case c.SCISSOR_BOX:
+		return ints(c.scissorBox[:]...)
+	case c.STENCIL_BACK_FAIL:
+		return ints(int(back.fail))
+	case c.STENCIL_BACK_FUNC:
+		return ints(int(back.function))
+	case c.STENCIL_BACK_PASS_DEPTH_FAIL:
+		return ints(int(back.zfail))
+	case c.STENCIL_BACK_PASS_DEPTH_PASS:
+		return ints(int(back.zpass))
+	case c.STENCIL_BACK_REF:
+		return ints(back.ref)
+	case c.STENCIL_BACK_VALUE_MASK:
+		return ints(back.valueMask)
+	case c.STENCIL_BACK_WRITEMASK:
+		return ints(back.writeMask)
+	case c.STENCIL_CLEAR_VALUE:
+		return ints(c.clearStencil)
+	case c.STENCIL_FAIL:
+		return ints(int(front.fail))
+	case c.STENCIL_FUNC:
+		return ints(int(front.function))
+	case c.STENCIL_PASS_DEPTH_FAIL:
+		return ints(int(front.zfail))
+	case c.STENCIL_PASS_DEPTH_PASS:
+		return ints(int(front.zpass))
+	case c.STENCIL_REF:
+		return ints(front.ref)
+	case c.STENCIL_VALUE_MASK:
+		return ints(front.valueMask)
+	case c.STENCIL_WRITEMASK:
+		return ints(front.writeMask)
+	case c.TEXTURE_BINDING_2D:
+		return ints(objectID(c.textureUnits[c.activeTexture]))
+	case c.TEXTURE_BINDING_CUBE_MAP:
+		return ints(0)
+	case c.UNPACK_ALIGNMENT:
+		return ints(c.unpackAlignment)
+	case c.UNPACK_FLIP_Y_WEBGL:
+		return ints(boolInt(c.unpackFlipY))
+	case c.UNPACK_PREMULTIPLY_ALPHA_WEBGL:
+		return ints(boolInt(c.unpackPremultiplyAlpha))
+	case c.VIEWPORT:
+		return ints(c.viewport[:]...)
+	}
+	c.setError(c.INVALID_ENUM)
+	return nil
+}
+
+// GetParameterBool returns the first value of the state variable pname.
+func (c *Context) GetParameterBool(pname Enum) bool {
+	v := c.GetParameterBools(pname)
+	return len(v) > 0 && v[0]
+}
+
+// GetParameterBools returns the values of the state variable pname.
+func (c *Context) GetParameterBools(pname Enum) []bool {
+	p := c.parameter(pname)
+	if p == nil {
+		return nil
+	}
+	v := make([]bool, len(p))
+	for i := range p {
+		v[i] = p[i] != 0
+	}
+	return v
+}
+
+// GetParameterFloat returns the first value of the state variable pname.
+func (c *Context) GetParameterFloat(pname Enum) float32 {
+	v := c.GetParameterFloats(pname)
+	if len(v) == 0 {
+		return 0
+	}
+	return v[0]
+}
+
+// GetParameterFloats returns the values of the state variable pname.
+func (c *Context) GetParameterFloats(pname Enum) []float32 {
+	p := c.parameter(pname)
+	if p == nil {
+		return nil
+	}
+	return append([]float32(nil), p...)
+}
+
+// GetParameterInt returns the first value of the state variable pname.
+// Object bindings such as CURRENT_PROGRAM are returned as the object's name.
+func (c *Context) GetParameterInt(pname Enum) int {
+	v := c.GetParameterInts(pname)
+	if len(v) == 0 {
+		return 0
+	}
+	return int(v[0])
+}
+
+// GetParameterInts returns the values of the state variable pname.
+func (c *Context) GetParameterInts(pname Enum) []int32 {
+	p := c.parameter(pname)
+	if p == nil {
+		return nil
+	}
+	v := make([]int32, len(p))
+	for i := range p {
+		v[i] = int32(p[i])
+	}
+	return v
+}
+
+// GetParameterString returns the string state variable pname, such as VENDOR
+// or SHADING_LANGUAGE_VERSION.
+func (c *Context) GetParameterString(pname Enum) string {
+	if c.unsupportedEnum(pname) {
+		return ""
+	}
+	switch pname {
+	case c.VENDOR:
+		return "EngoEngine"
+	case c.RENDERER:
+		return "softgl"
+	case c.VERSION:
+		return "OpenGL ES 2.0 (softgl)"
+	case c.SHADING_LANGUAGE_VERSION:
+		return "OpenGL ES GLSL ES 1.00 (softgl)"
+	case c.EXTENSIONS:
+		return ""
+	}
+	c.setError(c.INVALID_ENUM)
+	return ""
+}
+
+// GetSupportedExtensions returns an empty list, softgl has no extensions.
+func (c *Context) GetSupportedExtensions() []string {
+	return []string{}
+}
+
+// Extensions returns an empty list, softgl has no extensions.
+func (c *Context) Extensions() []string {
+	return []string{}
+}
+
+// HasExtension returns false, softgl has no extensions.
+func (c *Context) HasExtension(name string) bool {
+	return false
+}
+
+// Capabilities reports the limits of the softgl backend.
+func (c *Context) Capabilities() Capabilities {
+	return Capabilities{
+		MaxTextureSize:         softMaxTextureSize,
+		MaxVertexAttribs:       softMaxVertexAttribs,
+		MaxTextureImageUnits:   softMaxTextureUnits,
+		MaxVaryingVectors:      softMaxVaryingVectors,
+		MaxRenderbufferSize:    softMaxRenderbufferSize,
+		ShadingLanguageVersion: c.GetParameterString(c.SHADING_LANGUAGE_VERSION),
+		Vendor:                 c.GetParameterString(c.VENDOR),
+		Renderer:               c.GetParameterString(c.RENDERER),
+		Version:                c.GetParameterString(c.VERSION),
+		Extensions:             c.Extensions(),
+	}
+}
+
+// ints converts integer state to the float32 values returned by parameter.
+func ints(v ...int) []float32 {
+	f := make([]float32, len(v))
+	for i := range v {
+		f[i] = float32(v[i])
+	}
+	return f
+}
+
+// objectID returns the name of a bound object, or 0 if none is bound.
+func objectID(o interface{}) int {
+	switch o := o.(type) {
+	case *softBuffer:
+		if o != nil {
+			return int(o.id)
+		}
+	case *softTexture:
+		if o != nil {
+			return int(o.id)
+		}
+	case *softRenderbuffer:
+		if o != nil {
+			return int(o.id)
+		}
+	case *softFramebuffer:
+		if o != nil {
+			return int(o.id)
+		}
+	case *softProgram:
+		if o != nil {
+			return int(o.id)
+		}
+	}
+	return 0
+}
+
+func minInt(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+func clamp01(v float32) float32 {
+	if v < 0 {
+		return 0
+	}
+	if v > 1 {
+		return 1
+	}
+	return v
+}
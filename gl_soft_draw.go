@@ -0,0 +1,717 @@
+//go:build softgl && !nogl
+// +build softgl,!nogl
+
+package gl
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// softVertex is a vertex as output by the vertex shader, in clip coordinates.
+type softVertex struct {
+	position  [4]float32
+	varyings  []float32
+	pointSize float32
+}
+
+// softWindowVertex is a vertex in window coordinates. invW is 1/w of its clip
+// coordinates, used to interpolate varyings with perspective correction.
+type softWindowVertex struct {
+	x, y, z  float32
+	invW     float32
+	varyings []float32
+}
+
+// componentSize returns the size in bytes of a vertex attribute component of
+// type typ, or 0 if typ isn't a valid type.
+func (c *Context) componentSize(typ Enum) int {
+	switch typ {
+	case c.BYTE, c.UNSIGNED_BYTE:
+		return 1
+	case c.SHORT, c.UNSIGNED_SHORT:
+		return 2
+	case c.FLOAT, c.INT, c.UNSIGNED_INT:
+		return 4
+	}
+	return 0
+}
+
+// isDrawMode reports whether mode is a primitive type.
+func (c *Context) isDrawMode(mode Enum) bool {
+	switch mode {
+	case c.POINTS, c.LINES, c.LINE_LOOP, c.LINE_STRIP, c.TRIANGLES, c.TRIANGLE_STRIP, c.TRIANGLE_FAN:
+		return true
+	}
+	return false
+}
+
+func (c *Context) DrawArrays(mode Enum, first, count int) {
+	if !c.isDrawMode(mode) {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	if first < 0 || count < 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	indices := make([]int, count)
+	for i := range indices {
+		indices[i] = first + i
+	}
+	c.draw(mode, indices)
+}
+
+// DrawElements draws count vertices whose indices are read from the bound
+// ELEMENT_ARRAY_BUFFER starting at offset.
+func (c *Context) DrawElements(mode Enum, count int, typ Enum, offset int) {
+	if !c.isDrawMode(mode) {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	if count < 0 || offset < 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	var size int
+	switch typ {
+	case c.UNSIGNED_BYTE, c.UNSIGNED_SHORT, c.UNSIGNED_INT:
+		size = c.componentSize(typ)
+	default:
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	b := c.elementBuffer
+	if b == nil || offset%size != 0 || offset+count*size > len(b.data) {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	indices := make([]int, count)
+	for i := range indices {
+		data := b.data[offset+i*size:]
+		switch size {
+		case 1:
+			indices[i] = int(data[0])
+		case 2:
+			indices[i] = int(binary.LittleEndian.Uint16(data))
+		default:
+			indices[i] = int(binary.LittleEndian.Uint32(data))
+		}
+	}
+	c.draw(mode, indices)
+}
+
+// draw runs the current program on the vertices at indices and rasterizes the
+// primitives they make up.
+func (c *Context) draw(mode Enum, indices []int) {
+	p := c.program
+	if p == nil || !c.checkAttribs(p, indices) {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	s, ok := c.surface()
+	if !ok || len(indices) == 0 {
+		return
+	}
+
+	// Vertices are shaded once however many primitives share them.
+	shaded := make(map[int]*softVertex, len(indices))
+	vertex := func(i int) *softVertex {
+		index := indices[i]
+		if v := shaded[index]; v != nil {
+			return v
+		}
+		v := c.shadeVertex(p, index)
+		shaded[index] = v
+		return v
+	}
+
+	r := &softRasterizer{c: c, program: p, surface: s}
+	r.x0, r.y0, r.x1, r.y1 = c.drawRect(s)
+	r.varyings = make([]float32, 4*len(p.varyings))
+
+	n := len(indices)
+	switch mode {
+	case c.POINTS:
+		for i := 0; i < n; i++ {
+			r.point(vertex(i))
+		}
+	case c.LINES:
+		for i := 0; i+1 < n; i += 2 {
+			r.line(vertex(i), vertex(i+1))
+		}
+	case c.LINE_STRIP, c.LINE_LOOP:
+		for i := 0; i+1 < n; i++ {
+			r.line(vertex(i), vertex(i+1))
+		}
+		if mode == c.LINE_LOOP && n > 2 {
+			r.line(vertex(n-1), vertex(0))
+		}
+	case c.TRIANGLES:
+		for i := 0; i+2 < n; i += 3 {
+			r.triangle(vertex(i), vertex(i+1), vertex(i+2))
+		}
+	case c.TRIANGLE_STRIP:
+		for i := 0; i+2 < n; i++ {
+			if i%2 == 0 {
+				r.triangle(vertex(i), vertex(i+1), vertex(i+2))
+			} else {
+				r.triangle(vertex(i+1), vertex(i), vertex(i+2))
+			}
+		}
+	case c.TRIANGLE_FAN:
+		for i := 1; i+1 < n; i++ {
+			r.triangle(vertex(0), vertex(i), vertex(i+1))
+		}
+	}
+}
+
+// checkAttribs reports whether the enabled arrays of the attributes of p hold
+// all the vertices at indices.
+func (c *Context) checkAttribs(p *softProgram, indices []int) bool {
+	max := -1
+	for _, i := range indices {
+		max = maxInt(max, i)
+	}
+	if max < 0 {
+		return true
+	}
+	for _, loc := range p.attribLocs {
+		a := &c.attribs[loc]
+		if !a.enabled {
+			continue
+		}
+		if a.buffer == nil {
+			return false
+		}
+		size := a.size * c.componentSize(a.typ)
+		if a.offset+max*c.attribStride(a)+size > len(a.buffer.data) {
+			return false
+		}
+	}
+	return true
+}
+
+// attribStride returns the distance in bytes between the values of a.
+func (c *Context) attribStride(a *softAttrib) int {
+	if a.stride != 0 {
+		return a.stride
+	}
+	return a.size * c.componentSize(a.typ)
+}
+
+// shadeVertex runs the vertex shader of p on the vertex at index.
+func (c *Context) shadeVertex(p *softProgram, index int) *softVertex {
+	state := &ShaderState{
+		PointSize: 1,
+		c:         c,
+		program:   p,
+		attribs:   make([][4]float32, len(p.attribs)),
+		varyings:  make([]float32, 4*len(p.varyings)),
+	}
+	for i, loc := range p.attribLocs {
+		state.attribs[i] = c.fetchAttrib(&c.attribs[loc], index)
+	}
+	var position [4]float32
+	if p.vertex != nil {
+		position = p.vertex(state)
+	}
+	return &softVertex{position: position, varyings: state.varyings, pointSize: state.PointSize}
+}
+
+// fetchAttrib returns the value of the attribute a for the vertex at index.
+func (c *Context) fetchAttrib(a *softAttrib, index int) [4]float32 {
+	if !a.enabled {
+		return a.current
+	}
+	v := [4]float32{0, 0, 0, 1}
+	size := c.componentSize(a.typ)
+	data := a.buffer.data[a.offset+index*c.attribStride(a):]
+	for i := 0; i < a.size; i++ {
+		v[i] = c.component(data[i*size:], a.typ, a.normalized)
+	}
+	return v
+}
+
+// component decodes a little endian vertex attribute component of type typ,
+// mapping integers to [0, 1] or [-1, 1] if normalized is set.
+func (c *Context) component(data []byte, typ Enum, normalized bool) float32 {
+	var v, max float64
+	switch typ {
+	case c.FLOAT:
+		return math.Float32frombits(binary.LittleEndian.Uint32(data))
+	case c.BYTE:
+		v, max = float64(int8(data[0])), math.MaxInt8
+	case c.UNSIGNED_BYTE:
+		v, max = float64(data[0]), math.MaxUint8
+	case c.SHORT:
+		v, max = float64(int16(binary.LittleEndian.Uint16(data))), math.MaxInt16
+	case c.UNSIGNED_SHORT:
+		v, max = float64(binary.LittleEndian.Uint16(data)), math.MaxUint16
+	case c.INT:
+		v, max = float64(int32(binary.LittleEndian.Uint32(data))), math.MaxInt32
+	default:
+		v, max = float64(binary.LittleEndian.Uint32(data)), math.MaxUint32
+	}
+	if normalized {
+		v = math.Max(v/max, -1)
+	}
+	return float32(v)
+}
+
+// softRasterizer rasterizes the primitives of a draw call into a surface.
+type softRasterizer struct {
+	c       *Context
+	program *softProgram
+	surface softSurface
+
+	// x0, y0, x1 and y1 bound the pixels that may be written.
+	x0, y0, x1, y1 int
+
+	// varyings holds the interpolated varyings of the current fragment.
+	varyings []float32
+}
+
+// clipPlanes are the planes primitives are clipped against, as dot products
+// with the clip coordinates that are positive inside: near, far and w > 0.
+// Primitives aren't clipped to the sides of the view volume, the rasterizer
+// only visits pixels inside the surface.
+var clipPlanes = [][4]float32{
+	{0, 0, 1, 1},
+	{0, 0, -1, 1},
+	{0, 0, 0, 1},
+}
+
+// clipEpsilon keeps clipped vertices strictly in front of the eye.
+const clipEpsilon = 1e-6
+
+func clipDistance(plane [4]float32, v *softVertex) float32 {
+	d := plane[0]*v.position[0] + plane[1]*v.position[1] + plane[2]*v.position[2] + plane[3]*v.position[3]
+	if plane[3] == 1 && plane[2] == 0 {
+		d -= clipEpsilon
+	}
+	return d
+}
+
+// lerpVertex returns the vertex at t between a and b.
+func lerpVertex(a, b *softVertex, t float32) *softVertex {
+	v := &softVertex{varyings: make([]float32, len(a.varyings)), pointSize: a.pointSize}
+	for i := range v.position {
+		v.position[i] = a.position[i] + t*(b.position[i]-a.position[i])
+	}
+	for i := range v.varyings {
+		v.varyings[i] = a.varyings[i] + t*(b.varyings[i]-a.varyings[i])
+	}
+	return v
+}
+
+// clipPolygon clips a convex polygon against clipPlanes.
+func clipPolygon(polygon []*softVertex) []*softVertex {
+	for _, plane := range clipPlanes {
+		var out []*softVertex
+		for i, a := range polygon {
+			b := polygon[(i+1)%len(polygon)]
+			da, db := clipDistance(plane, a), clipDistance(plane, b)
+			if da >= 0 {
+				out = append(out, a)
+			}
+			if (da >= 0) != (db >= 0) {
+				out = append(out, lerpVertex(a, b, da/(da-db)))
+			}
+		}
+		if len(out) < 3 {
+			return nil
+		}
+		polygon = out
+	}
+	return polygon
+}
+
+// window transforms v from clip to window coordinates.
+func (r *softRasterizer) window(v *softVertex) softWindowVertex {
+	c := r.c
+	invW := 1 / v.position[3]
+	x, y, z := v.position[0]*invW, v.position[1]*invW, v.position[2]*invW
+	vp, n, f := c.viewport, c.depthRange[0], c.depthRange[1]
+	return softWindowVertex{
+		x:        float32(vp[0]) + (x+1)*float32(vp[2])/2,
+		y:        float32(vp[1]) + (y+1)*float32(vp[3])/2,
+		z:        n + (z+1)*(f-n)/2,
+		invW:     invW,
+		varyings: v.varyings,
+	}
+}
+
+func (r *softRasterizer) triangle(a, b, d *softVertex) {
+	polygon := clipPolygon([]*softVertex{a, b, d})
+	if polygon == nil {
+		return
+	}
+	vertices := make([]softWindowVertex, len(polygon))
+	for i, v := range polygon {
+		vertices[i] = r.window(v)
+	}
+
+	// All the triangles of the clipped polygon face the same way.
+	area := edge(vertices[0], vertices[1], vertices[2].x, vertices[2].y)
+	for i := 3; area == 0 && i < len(vertices); i++ {
+		area = edge(vertices[0], vertices[i-1], vertices[i].x, vertices[i].y)
+	}
+	if area == 0 {
+		return
+	}
+	c := r.c
+	front := area > 0 == (c.frontFace == c.CCW)
+	if c.enabled[c.CULL_FACE] {
+		if c.cullFace == c.FRONT_AND_BACK || front == (c.cullFace == c.FRONT) {
+			return
+		}
+	}
+	for i := 1; i+1 < len(vertices); i++ {
+		r.fillTriangle(vertices[0], vertices[i], vertices[i+1], front)
+	}
+}
+
+// edge returns twice the signed area of the triangle (a, b, (x, y)), positive
+// if it is counterclockwise.
+func edge(a, b softWindowVertex, x, y float32) float32 {
+	return (b.x-a.x)*(y-a.y) - (b.y-a.y)*(x-a.x)
+}
+
+// fillTriangle rasterizes a triangle in window coordinates. Pixels whose
+// center lies on an edge belong to the triangle if the edge is a top or left
+// edge, so that triangles sharing an edge don't both cover its pixels.
+func (r *softRasterizer) fillTriangle(a, b, d softWindowVertex, front bool) {
+	area := edge(a, b, d.x, d.y)
+	if area == 0 {
+		return
+	}
+	if area < 0 {
+		b, d, area = d, b, -area
+	}
+	x0 := maxInt(r.x0, int(math.Floor(float64(min3(a.x, b.x, d.x)))))
+	y0 := maxInt(r.y0, int(math.Floor(float64(min3(a.y, b.y, d.y)))))
+	x1 := minInt(r.x1, int(math.Ceil(float64(max3(a.x, b.x, d.x)))))
+	y1 := minInt(r.y1, int(math.Ceil(float64(max3(a.y, b.y, d.y)))))
+
+	c := r.c
+	var offset float32
+	if c.enabled[c.POLYGON_OFFSET_FILL] {
+		// The depth slope of the triangle's plane.
+		dzdx := ((b.z-a.z)*(d.y-a.y) - (d.z-a.z)*(b.y-a.y)) / area
+		dzdy := ((d.z-a.z)*(b.x-a.x) - (b.z-a.z)*(d.x-a.x)) / area
+		slope := float32(math.Max(math.Abs(float64(dzdx)), math.Abs(float64(dzdy))))
+		offset = c.polygonOffset[0]*slope + c.polygonOffset[1]/(1<<24)
+	}
+
+	for y := y0; y < y1; y++ {
+		py := float32(y) + 0.5
+		for x := x0; x < x1; x++ {
+			px := float32(x) + 0.5
+			w0, w1, w2 := edge(b, d, px, py), edge(d, a, px, py), edge(a, b, px, py)
+			if !inside(w0, b, d) || !inside(w1, d, a) || !inside(w2, a, b) {
+				continue
+			}
+			l0, l1, l2 := w0/area, w1/area, w2/area
+			z := l0*a.z + l1*b.z + l2*d.z + offset
+
+			// Varyings are interpolated linearly in clip space, which
+			// takes dividing the barycentric weights by w.
+			p0, p1, p2 := l0*a.invW, l1*b.invW, l2*d.invW
+			invW := p0 + p1 + p2
+			p0, p1, p2 = p0/invW, p1/invW, p2/invW
+			for i := range r.varyings {
+				r.varyings[i] = p0*a.varyings[i] + p1*b.varyings[i] + p2*d.varyings[i]
+			}
+			r.fragment(x, y, z, invW, front, [2]float32{})
+		}
+	}
+}
+
+// inside reports whether a pixel center whose edge function for the edge
+// from a to b is w belongs to a counterclockwise triangle.
+func inside(w float32, a, b softWindowVertex) bool {
+	if w != 0 {
+		return w > 0
+	}
+	dx, dy := b.x-a.x, b.y-a.y
+	return dy < 0 || dy == 0 && dx < 0
+}
+
+func min3(a, b, c float32) float32 {
+	return float32(math.Min(float64(a), math.Min(float64(b), float64(c))))
+}
+
+func max3(a, b, c float32) float32 {
+	return float32(math.Max(float64(a), math.Max(float64(b), float64(c))))
+}
+
+// line rasterizes a line segment, lineWidth pixels wide along its minor axis.
+func (r *softRasterizer) line(a, b *softVertex) {
+	for _, plane := range clipPlanes {
+		da, db := clipDistance(plane, a), clipDistance(plane, b)
+		switch {
+		case da < 0 && db < 0:
+			return
+		case da < 0:
+			a = lerpVertex(a, b, da/(da-db))
+		case db < 0:
+			b = lerpVertex(a, b, da/(da-db))
+		}
+	}
+	wa, wb := r.window(a), r.window(b)
+
+	dx, dy := wb.x-wa.x, wb.y-wa.y
+	xMajor := math.Abs(float64(dx)) >= math.Abs(float64(dy))
+	start, end, length := wa.x, wb.x, dx
+	if !xMajor {
+		start, end, length = wa.y, wb.y, dy
+	}
+	if length == 0 {
+		return
+	}
+	if start > end {
+		start, end = end, start
+	}
+	width := maxInt(1, int(r.c.lineWidth+0.5))
+
+	for i := int(math.Floor(float64(start) + 0.5)); float32(i)+0.5 < end; i++ {
+		center := float32(i) + 0.5
+		if center < start {
+			continue
+		}
+		t := (center - wa.x) / dx
+		if !xMajor {
+			t = (center - wa.y) / dy
+		}
+		z := wa.z + t*(wb.z-wa.z)
+		pa, pb := (1-t)*wa.invW, t*wb.invW
+		invW := pa + pb
+		pa, pb = pa/invW, pb/invW
+		for j := range r.varyings {
+			r.varyings[j] = pa*wa.varyings[j] + pb*wb.varyings[j]
+		}
+
+		var minor float32
+		if xMajor {
+			minor = wa.y + t*dy
+		} else {
+			minor = wa.x + t*dx
+		}
+		first := int(math.Floor(float64(minor))) - (width-1)/2
+		for k := first; k < first+width; k++ {
+			if xMajor {
+				r.clippedFragment(i, k, z, invW, [2]float32{})
+			} else {
+				r.clippedFragment(k, i, z, invW, [2]float32{})
+			}
+		}
+	}
+}
+
+// point rasterizes a point as a square of gl_PointSize pixels.
+func (r *softRasterizer) point(v *softVertex) {
+	for _, plane := range clipPlanes {
+		if clipDistance(plane, v) < 0 {
+			return
+		}
+	}
+	w := r.window(v)
+	copy(r.varyings, v.varyings)
+	size := float32(math.Min(math.Max(float64(v.pointSize), 1), softMaxPointSize))
+	left, bottom := w.x-size/2, w.y-size/2
+	x0, y0 := int(math.Floor(float64(left)+0.5)), int(math.Floor(float64(bottom)+0.5))
+	x1, y1 := int(math.Floor(float64(left+size)+0.5)), int(math.Floor(float64(bottom+size)+0.5))
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			// gl_PointCoord has its origin at the top left of the point.
+			coord := [2]float32{(float32(x) + 0.5 - left) / size, 1 - (float32(y)+0.5-bottom)/size}
+			r.clippedFragment(x, y, w.z, w.invW, coord)
+		}
+	}
+}
+
+// clippedFragment calls fragment if the pixel at (x, y) may be written.
+func (r *softRasterizer) clippedFragment(x, y int, z, invW float32, pointCoord [2]float32) {
+	if x >= r.x0 && x < r.x1 && y >= r.y0 && y < r.y1 {
+		r.fragment(x, y, z, invW, true, pointCoord)
+	}
+}
+
+// fragment runs the fragment shader for the pixel at (x, y) with the
+// interpolated varyings, and writes the result through the stencil test,
+// depth test, blending and color mask.
+func (r *softRasterizer) fragment(x, y int, z, invW float32, front bool, pointCoord [2]float32) {
+	c, s := r.c, r.surface
+	state := &ShaderState{
+		FragCoord:   [4]float32{float32(x) + 0.5, float32(y) + 0.5, z, invW},
+		FrontFacing: front,
+		PointCoord:  pointCoord,
+		PointSize:   1,
+		c:           c,
+		program:     r.program,
+		varyings:    r.varyings,
+	}
+	var color [4]float32
+	if r.program.fragment != nil {
+		var discard bool
+		if color, discard = r.program.fragment(state); discard {
+			return
+		}
+	}
+
+	i := y*s.width + x
+	stencil := &c.stencil[0]
+	if !front {
+		stencil = &c.stencil[1]
+	}
+	stencilTest := c.enabled[c.STENCIL_TEST] && s.stencil != nil
+	if stencilTest {
+		ref, stored := stencil.ref&stencil.valueMask, int(s.stencil[i])&stencil.valueMask
+		if !c.compare(stencil.function, float32(ref), float32(stored)) {
+			c.stencilOp(s.stencil, i, stencil, stencil.fail)
+			return
+		}
+	}
+	if c.enabled[c.DEPTH_TEST] && s.depth != nil {
+		z = clamp01(z)
+		if !c.compare(c.depthFunc, z, s.depth[i]) {
+			if stencilTest {
+				c.stencilOp(s.stencil, i, stencil, stencil.zfail)
+			}
+			return
+		}
+		if c.depthMask {
+			s.depth[i] = z
+		}
+	}
+	if stencilTest {
+		c.stencilOp(s.stencil, i, stencil, stencil.zpass)
+	}
+
+	if s.color == nil {
+		return
+	}
+	pixel := s.color[4*i : 4*i+4]
+	for ch := range color {
+		color[ch] = clamp01(color[ch])
+	}
+	if c.enabled[c.BLEND] {
+		color = c.blend(color, pixel)
+	}
+	for ch, v := range color {
+		if c.colorMask[ch] {
+			pixel[ch] = colorByte(v)
+		}
+	}
+}
+
+// compare applies the comparison function fn to a and b, as in a fn b.
+func (c *Context) compare(fn Enum, a, b float32) bool {
+	switch fn {
+	case c.NEVER:
+		return false
+	case c.LESS:
+		return a < b
+	case c.EQUAL:
+		return a == b
+	case c.LEQUAL:
+		return a <= b
+	case c.GREATER:
+		return a > b
+	case c.NOTEQUAL:
+		return a != b
+	case c.GEQUAL:
+		return a >= b
+	}
+	return true
+}
+
+// stencilOp applies op to the stencil value at i, honouring the write mask.
+func (c *Context) stencilOp(values []byte, i int, s *softStencil, op Enum) {
+	v := int(values[i])
+	switch op {
+	case c.KEEP:
+		return
+	case c.ZERO:
+		v = 0
+	case c.REPLACE:
+		v = s.ref
+	case c.INCR:
+		v = minInt(v+1, 0xFF)
+	case c.DECR:
+		v = maxInt(v-1, 0)
+	case c.INVERT:
+		v = ^v
+	case c.INCR_WRAP:
+		v++
+	case c.DECR_WRAP:
+		v--
+	}
+	values[i] = byte(int(values[i])&^s.writeMask | v&s.writeMask)
+}
+
+// blend blends the fragment color src with the color of the pixel dst.
+func (c *Context) blend(src [4]float32, pixel []byte) [4]float32 {
+	var dst [4]float32
+	for ch := range dst {
+		dst[ch] = float32(pixel[ch]) / 255
+	}
+	var out [4]float32
+	for ch := range out {
+		srcFactor, dstFactor, equation := c.blendFunc[0], c.blendFunc[1], c.blendEquation[0]
+		if ch == 3 {
+			srcFactor, dstFactor, equation = c.blendFunc[2], c.blendFunc[3], c.blendEquation[1]
+		}
+		s := src[ch] * c.blendFactor(srcFactor, src, dst, ch)
+		d := dst[ch] * c.blendFactor(dstFactor, src, dst, ch)
+		switch equation {
+		case c.FUNC_SUBTRACT:
+			out[ch] = clamp01(s - d)
+		case c.FUNC_REVERSE_SUBTRACT:
+			out[ch] = clamp01(d - s)
+		default:
+			out[ch] = clamp01(s + d)
+		}
+	}
+	return out
+}
+
+// blendFactor returns the blend factor f for the channel ch.
+func (c *Context) blendFactor(f Enum, src, dst [4]float32, ch int) float32 {
+	constant := c.blendColor
+	switch f {
+	case c.ZERO:
+		return 0
+	case c.SRC_COLOR:
+		return src[ch]
+	case c.ONE_MINUS_SRC_COLOR:
+		return 1 - src[ch]
+	case c.DST_COLOR:
+		return dst[ch]
+	case c.ONE_MINUS_DST_COLOR:
+		return 1 - dst[ch]
+	case c.SRC_ALPHA:
+		return src[3]
+	case c.ONE_MINUS_SRC_ALPHA:
+		return 1 - src[3]
+	case c.DST_ALPHA:
+		return dst[3]
+	case c.ONE_MINUS_DST_ALPHA:
+		return 1 - dst[3]
+	case c.CONSTANT_COLOR:
+		return constant[ch]
+	case c.ONE_MINUS_CONSTANT_COLOR:
+		return 1 - constant[ch]
+	case c.CONSTANT_ALPHA:
+		return constant[3]
+	case c.ONE_MINUS_CONSTANT_ALPHA:
+		return 1 - constant[3]
+	case c.SRC_ALPHA_SATURATE:
+		if ch == 3 {
+			return 1
+		}
+		return float32(math.Min(float64(src[3]), float64(1-dst[3])))
+	}
+	return 1
+}
@@ -0,0 +1,355 @@
+//go:build softgl && !nogl
+// +build softgl,!nogl
+
+package gl
+
+import (
+	"errors"
+)
+
+// softSurface is the set of images drawing writes to: the default
+// framebuffer or the attachments of a framebuffer object. Images are stored
+// bottom row first, like OpenGL stores them.
+type softSurface struct {
+	width  int
+	height int
+
+	// color holds 4 bytes per pixel in RGBA order, depth values in [0, 1]
+	// and stencil 8 bit values. Each is nil if the surface has no such
+	// image.
+	color   []byte
+	depth   []float32
+	stencil []byte
+}
+
+// newSoftSurface returns a surface with all three images, with the depth
+// buffer cleared to 1.
+func newSoftSurface(width, height int) softSurface {
+	width, height = maxInt(width, 0), maxInt(height, 0)
+	s := softSurface{
+		width:   width,
+		height:  height,
+		color:   make([]byte, 4*width*height),
+		depth:   make([]float32, width*height),
+		stencil: make([]byte, width*height),
+	}
+	for i := range s.depth {
+		s.depth[i] = 1
+	}
+	return s
+}
+
+// softAttachment is an image attached to a framebuffer object, either a
+// texture or a renderbuffer.
+type softAttachment struct {
+	texture      *softTexture
+	renderbuffer *softRenderbuffer
+}
+
+func (a softAttachment) attached() bool {
+	return a.texture != nil || a.renderbuffer != nil
+}
+
+// size returns the size of the attached image.
+func (a softAttachment) size() (width, height int) {
+	if a.texture != nil {
+		return a.texture.width, a.texture.height
+	}
+	if a.renderbuffer != nil {
+		return a.renderbuffer.width, a.renderbuffer.height
+	}
+	return 0, 0
+}
+
+// softFramebuffer is a framebuffer object.
+type softFramebuffer struct {
+	id      uint32
+	color   softAttachment
+	depth   softAttachment
+	stencil softAttachment
+}
+
+// detach removes the attachments for which match returns true.
+func (fb *softFramebuffer) detach(match func(a softAttachment) bool) {
+	for _, a := range []*softAttachment{&fb.color, &fb.depth, &fb.stencil} {
+		if a.attached() && match(*a) {
+			*a = softAttachment{}
+		}
+	}
+}
+
+func (c *Context) CreateFrameBuffer() *FrameBuffer {
+	fb := &softFramebuffer{id: c.newID()}
+	c.framebuffers[fb.id] = fb
+	return &FrameBuffer{fb.id}
+}
+
+func (c *Context) DeleteFrameBuffer(fb *FrameBuffer) {
+	f := c.framebuffers[framebufferID(fb)]
+	if f == nil {
+		return
+	}
+	delete(c.framebuffers, f.id)
+	if c.framebuffer == f {
+		c.framebuffer = nil
+	}
+}
+
+func (c *Context) IsFramebuffer(fb *FrameBuffer) bool {
+	return c.framebuffers[framebufferID(fb)] != nil
+}
+
+// BindFrameBuffer binds a framebuffer, or the default framebuffer if fb is
+// nil.
+func (c *Context) BindFrameBuffer(fb *FrameBuffer) {
+	var f *softFramebuffer
+	if id := framebufferID(fb); id != 0 {
+		if f = c.framebuffers[id]; f == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	c.framebuffer = f
+}
+
+// attachmentPoint returns the attachments of the bound framebuffer selected
+// by target and attachment, raising an error and returning nil if there are
+// none.
+func (c *Context) attachmentPoint(target, attachment Enum) []*softAttachment {
+	if target != c.FRAMEBUFFER {
+		c.setError(c.INVALID_ENUM)
+		return nil
+	}
+	fb := c.framebuffer
+	if fb == nil {
+		c.setError(c.INVALID_OPERATION)
+		return nil
+	}
+	switch attachment {
+	case c.COLOR_ATTACHMENT0:
+		return []*softAttachment{&fb.color}
+	case c.DEPTH_ATTACHMENT:
+		return []*softAttachment{&fb.depth}
+	case c.STENCIL_ATTACHMENT:
+		return []*softAttachment{&fb.stencil}
+	case c.DEPTH_STENCIL_ATTACHMENT:
+		return []*softAttachment{&fb.depth, &fb.stencil}
+	}
+	c.setError(c.INVALID_ENUM)
+	return nil
+}
+
+// FrameBufferTexture2D attaches level 0 of a texture to the bound framebuffer,
+// or detaches the attachment if t is nil.
+func (c *Context) FrameBufferTexture2D(target, attachment, texTarget Enum, t *Texture, level int) {
+	points := c.attachmentPoint(target, attachment)
+	if points == nil {
+		return
+	}
+	var tex *softTexture
+	if id := textureID(t); id != 0 {
+		if texTarget != c.TEXTURE_2D {
+			c.setError(c.INVALID_ENUM)
+			return
+		}
+		if level != 0 {
+			c.setError(c.INVALID_VALUE)
+			return
+		}
+		if tex = c.textures[id]; tex == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	for _, p := range points {
+		*p = softAttachment{texture: tex}
+	}
+}
+
+// FrameBufferRenderBuffer attaches a renderbuffer to the bound framebuffer,
+// or detaches the attachment if rb is nil.
+func (c *Context) FrameBufferRenderBuffer(target, attachment Enum, rb *RenderBuffer) {
+	points := c.attachmentPoint(target, attachment)
+	if points == nil {
+		return
+	}
+	var r *softRenderbuffer
+	if id := renderbufferID(rb); id != 0 {
+		if r = c.renderbuffers[id]; r == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	for _, p := range points {
+		*p = softAttachment{renderbuffer: r}
+	}
+}
+
+func (c *Context) CheckFramebufferStatus(target Enum) Enum {
+	if target != c.FRAMEBUFFER {
+		c.setError(c.INVALID_ENUM)
+		return 0
+	}
+	return c.framebufferStatus()
+}
+
+// framebufferStatus returns the completeness status of the bound framebuffer.
+func (c *Context) framebufferStatus() Enum {
+	fb := c.framebuffer
+	if fb == nil {
+		return c.FRAMEBUFFER_COMPLETE
+	}
+	if !fb.color.attached() && !fb.depth.attached() && !fb.stencil.attached() {
+		return c.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT
+	}
+
+	valid := []bool{
+		!fb.color.attached() || fb.color.texture != nil || fb.color.renderbuffer.color != nil,
+		!fb.depth.attached() || fb.depth.renderbuffer != nil && fb.depth.renderbuffer.depth != nil,
+		!fb.stencil.attached() || fb.stencil.renderbuffer != nil && fb.stencil.renderbuffer.stencil != nil,
+	}
+	width, height := -1, -1
+	for i, a := range []softAttachment{fb.color, fb.depth, fb.stencil} {
+		if !a.attached() {
+			continue
+		}
+		w, h := a.size()
+		if !valid[i] || w == 0 || h == 0 {
+			return c.FRAMEBUFFER_INCOMPLETE_ATTACHMENT
+		}
+		if width >= 0 && (w != width || h != height) {
+			return c.FRAMEBUFFER_INCOMPLETE_DIMENSIONS
+		}
+		width, height = w, h
+	}
+	return c.FRAMEBUFFER_COMPLETE
+}
+
+// surface returns the images of the bound framebuffer. It raises
+// INVALID_FRAMEBUFFER_OPERATION and returns false if the framebuffer is
+// incomplete.
+func (c *Context) surface() (softSurface, bool) {
+	fb := c.framebuffer
+	if fb == nil {
+		return c.screen, true
+	}
+	if c.framebufferStatus() != c.FRAMEBUFFER_COMPLETE {
+		c.setError(c.INVALID_FRAMEBUFFER_OPERATION)
+		return softSurface{}, false
+	}
+
+	var s softSurface
+	for _, a := range []softAttachment{fb.color, fb.depth, fb.stencil} {
+		if a.attached() {
+			s.width, s.height = a.size()
+		}
+	}
+	if t := fb.color.texture; t != nil {
+		s.color = t.pix
+	} else if r := fb.color.renderbuffer; r != nil {
+		s.color = r.color
+	}
+	if r := fb.depth.renderbuffer; r != nil {
+		s.depth = r.depth
+	}
+	if r := fb.stencil.renderbuffer; r != nil {
+		s.stencil = r.stencil
+	}
+	return s, true
+}
+
+// drawRect returns the rectangle of s drawing may write to, limited by the
+// scissor box when the scissor test is enabled.
+func (c *Context) drawRect(s softSurface) (x0, y0, x1, y1 int) {
+	x0, y0, x1, y1 = 0, 0, s.width, s.height
+	if c.enabled[c.SCISSOR_TEST] {
+		b := c.scissorBox
+		x0, y0 = maxInt(x0, b[0]), maxInt(y0, b[1])
+		x1, y1 = minInt(x1, b[0]+b[2]), minInt(y1, b[1]+b[3])
+	}
+	return x0, y0, x1, y1
+}
+
+func (c *Context) Clear(flags Enum) {
+	if flags&^(c.COLOR_BUFFER_BIT|c.DEPTH_BUFFER_BIT|c.STENCIL_BUFFER_BIT) != 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	s, ok := c.surface()
+	if !ok {
+		return
+	}
+
+	var color [4]byte
+	for i, v := range c.clearColor {
+		color[i] = colorByte(v)
+	}
+	stencilMask := c.stencil[0].writeMask
+	x0, y0, x1, y1 := c.drawRect(s)
+	for y := y0; y < y1; y++ {
+		for x := x0; x < x1; x++ {
+			i := y*s.width + x
+			if flags&c.COLOR_BUFFER_BIT != 0 && s.color != nil {
+				for ch := 0; ch < 4; ch++ {
+					if c.colorMask[ch] {
+						s.color[4*i+ch] = color[ch]
+					}
+				}
+			}
+			if flags&c.DEPTH_BUFFER_BIT != 0 && s.depth != nil && c.depthMask {
+				s.depth[i] = c.clearDepth
+			}
+			if flags&c.STENCIL_BUFFER_BIT != 0 && s.stencil != nil {
+				s.stencil[i] = byte(int(s.stencil[i])&^stencilMask | c.clearStencil&stencilMask)
+			}
+		}
+	}
+}
+
+// errNoColorBuffer is returned by ReadPixelsInto when the bound framebuffer
+// has no color image to read.
+var errNoColorBuffer = errors.New("gl: ReadPixelsInto: the framebuffer has no color buffer")
+
+// ReadPixelsInto reads a rectangle of the currently bound framebuffer as RGBA
+// into pixels, top row first. pixels must hold at least 4*width*height bytes.
+// Pixels outside the framebuffer are left untouched.
+func (c *Context) ReadPixelsInto(x, y, width, height int, pixels []byte) error {
+	if err := checkReadPixels(width, height, pixels); err != nil {
+		return err
+	}
+	s, ok := c.surface()
+	if !ok {
+		return errors.New("gl: ReadPixelsInto: the framebuffer is incomplete")
+	}
+	if s.color == nil {
+		c.setError(c.INVALID_OPERATION)
+		return errNoColorBuffer
+	}
+	for row := 0; row < height; row++ {
+		sy := y + row
+		if sy < 0 || sy >= s.height {
+			continue
+		}
+		dst := pixels[4*width*(height-1-row):]
+		for col := 0; col < width; col++ {
+			sx := x + col
+			if sx < 0 || sx >= s.width {
+				continue
+			}
+			copy(dst[4*col:4*col+4], s.color[4*(sy*s.width+sx):])
+		}
+	}
+	return nil
+}
+
+func framebufferID(fb *FrameBuffer) uint32 {
+	if fb == nil {
+		return 0
+	}
+	return fb.uint32
+}
+
+// colorByte converts a color channel to 8 bits.
+func colorByte(v float32) byte {
+	return byte(clamp01(v)*255 + 0.5)
+}
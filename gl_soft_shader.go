@@ -0,0 +1,888 @@
+//go:build softgl && !nogl
+// +build softgl,!nogl
+
+package gl
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// VertexShaderFunc is a vertex shader written in Go. It reads the attributes
+// of a vertex and the uniforms of the program from s, sets the varyings the
+// fragment shader reads and returns gl_Position.
+type VertexShaderFunc func(s *ShaderState) (position [4]float32)
+
+// FragmentShaderFunc is a fragment shader written in Go. It reads the
+// interpolated varyings and the uniforms of the program from s and returns
+// gl_FragColor, or discard set to drop the fragment.
+type FragmentShaderFunc func(s *ShaderState) (color [4]float32, discard bool)
+
+// RegisterVertexShader makes vertex shaders whose source is source run fn.
+// The GLSL source is still scanned for its attribute, uniform and varying
+// declarations, which make up the program's interface, but its body isn't
+// interpreted. Shaders without a registered function fail to compile.
+func (c *Context) RegisterVertexShader(source string, fn VertexShaderFunc) {
+	c.vertexFuncs[source] = fn
+}
+
+// RegisterFragmentShader makes fragment shaders whose source is source run
+// fn, see RegisterVertexShader.
+func (c *Context) RegisterFragmentShader(source string, fn FragmentShaderFunc) {
+	c.fragmentFuncs[source] = fn
+}
+
+// ShaderState is what a Go shader reads its inputs from and writes its
+// varyings to. Variables are looked up by their name in the GLSL source;
+// unknown names read as zero.
+type ShaderState struct {
+	// FragCoord is gl_FragCoord and FrontFacing gl_FrontFacing, set for
+	// fragment shaders.
+	FragCoord   [4]float32
+	FrontFacing bool
+
+	// PointCoord is gl_PointCoord, set for fragment shaders drawing POINTS.
+	PointCoord [2]float32
+
+	// PointSize is gl_PointSize, which vertex shaders drawing POINTS may
+	// set. It is 1 by default.
+	PointSize float32
+
+	c        *Context
+	program  *softProgram
+	attribs  [][4]float32
+	varyings []float32
+}
+
+// Attrib returns the value of an attribute of the vertex, with missing
+// components filled from (0, 0, 0, 1).
+func (s *ShaderState) Attrib(name string) [4]float32 {
+	if i, ok := s.program.attribIndex[name]; ok && s.attribs != nil {
+		return s.attribs[i]
+	}
+	return [4]float32{}
+}
+
+// Uniform returns the value of a uniform, all elements of an array uniform
+// one after the other and matrices in column major order. Integer, boolean
+// and sampler uniforms are returned as floats. The slice must not be
+// modified.
+func (s *ShaderState) Uniform(name string) []float32 {
+	if u := s.program.uniformIndex[name]; u != nil {
+		return u.value
+	}
+	return nil
+}
+
+// Varying returns the value of a varying: as set by the vertex shader in a
+// vertex shader, interpolated across the primitive in a fragment shader.
+func (s *ShaderState) Varying(name string) [4]float32 {
+	var v [4]float32
+	if i, ok := s.program.varyingIndex[name]; ok {
+		copy(v[:], s.varyings[4*i:4*i+4])
+	}
+	return v
+}
+
+// SetVarying sets a varying of the vertex.
+func (s *ShaderState) SetVarying(name string, v [4]float32) {
+	if i, ok := s.program.varyingIndex[name]; ok {
+		copy(s.varyings[4*i:4*i+4], v[:])
+	}
+}
+
+// Texture2D samples the texture bound to the unit of the sampler uniform at
+// the texture coordinates (u, v), like texture2D in GLSL.
+func (s *ShaderState) Texture2D(sampler string, u, v float32) [4]float32 {
+	value := s.Uniform(sampler)
+	if len(value) == 0 {
+		return [4]float32{0, 0, 0, 1}
+	}
+	unit := int(value[0])
+	if unit < 0 || unit >= len(s.c.textureUnits) {
+		return [4]float32{0, 0, 0, 1}
+	}
+	return s.c.sample(s.c.textureUnits[unit], u, v)
+}
+
+// softVariable is an attribute, uniform or varying declared by a shader.
+type softVariable struct {
+	qualifier string
+	name      string
+	typ       Enum
+
+	// size is the length of an array, 1 for other variables, and
+	// components the number of floats of each element.
+	size       int
+	components int
+}
+
+// softShader is a shader object.
+type softShader struct {
+	id       uint32
+	typ      Enum
+	source   string
+	compiled bool
+	log      string
+
+	variables []softVariable
+	vertex    VertexShaderFunc
+	fragment  FragmentShaderFunc
+}
+
+// softUniform is an active uniform of a program and its value.
+type softUniform struct {
+	softVariable
+	value []float32
+}
+
+// softLocation is the element of a uniform a UniformLocation refers to.
+type softLocation struct {
+	uniform *softUniform
+	element int
+}
+
+// softProgram is a program object. The fields after log are set by a
+// successful link.
+type softProgram struct {
+	id        uint32
+	shaders   []*softShader
+	bindings  map[string]int
+	linked    bool
+	validated bool
+	log       string
+
+	vertex       VertexShaderFunc
+	fragment     FragmentShaderFunc
+	attribs      []softVariable
+	attribLocs   []int
+	attribIndex  map[string]int
+	uniforms     []*softUniform
+	uniformIndex map[string]*softUniform
+	locations    []softLocation
+	varyings     []softVariable
+	varyingIndex map[string]int
+}
+
+func (c *Context) CreateShader(typ Enum) *Shader {
+	if typ != c.VERTEX_SHADER && typ != c.FRAGMENT_SHADER {
+		c.setError(c.INVALID_ENUM)
+		return &Shader{0}
+	}
+	s := &softShader{id: c.newID(), typ: typ}
+	c.shaders[s.id] = s
+	return &Shader{s.id}
+}
+
+// shader returns the shader object, raising INVALID_VALUE and returning nil if
+// there is none.
+func (c *Context) shader(shader *Shader) *softShader {
+	var s *softShader
+	if shader != nil {
+		s = c.shaders[shader.uint32]
+	}
+	if s == nil {
+		c.setError(c.INVALID_VALUE)
+	}
+	return s
+}
+
+// DeleteShader deletes the shader. Programs it is attached to keep using it.
+func (c *Context) DeleteShader(shader *Shader) {
+	if shader != nil {
+		delete(c.shaders, shader.uint32)
+	}
+}
+
+func (c *Context) IsShader(shader *Shader) bool {
+	return shader != nil && c.shaders[shader.uint32] != nil
+}
+
+func (c *Context) ShaderSource(shader *Shader, source string) {
+	if s := c.shader(shader); s != nil {
+		s.source = source
+	}
+}
+
+func (c *Context) GetShaderSource(shader *Shader) string {
+	if s := c.shader(shader); s != nil {
+		return s.source
+	}
+	return ""
+}
+
+// CompileShader looks up the Go function registered for the shader's source
+// and scans the source for the declarations of its attributes, uniforms and
+// varyings.
+func (c *Context) CompileShader(shader *Shader) {
+	s := c.shader(shader)
+	if s == nil {
+		return
+	}
+	s.compiled, s.log = false, ""
+	s.vertex, s.fragment = nil, nil
+
+	variables, err := c.parseDeclarations(s.typ, s.source)
+	if err != nil {
+		s.log = err.Error()
+		return
+	}
+	if s.typ == c.VERTEX_SHADER {
+		s.vertex = c.vertexFuncs[s.source]
+	} else {
+		s.fragment = c.fragmentFuncs[s.source]
+	}
+	if s.vertex == nil && s.fragment == nil {
+		s.log = "ERROR: 0:1: softgl: no Go function is registered for the source of this shader\n"
+		return
+	}
+	s.variables, s.compiled = variables, true
+}
+
+// Returns a parameter from a shader object
+func (c *Context) GetShaderiv(shader *Shader, pname Enum) bool {
+	return c.GetShaderParameteri(shader, pname) != 0
+}
+
+// GetShaderParameteri returns the value of the shader parameter pname, such
+// as SHADER_TYPE, as an int.
+func (c *Context) GetShaderParameteri(shader *Shader, pname Enum) int {
+	s := c.shader(shader)
+	if s == nil {
+		return 0
+	}
+	switch pname {
+	case c.SHADER_TYPE:
+		return int(s.typ)
+	case c.COMPILE_STATUS:
+		return boolInt(s.compiled)
+	case c.DELETE_STATUS:
+		return boolInt(c.shaders[s.id] == nil)
+	case c.INFO_LOG_LENGTH:
+		return infoLogLength(s.log)
+	case c.SHADER_SOURCE_LENGTH:
+		return infoLogLength(s.source)
+	}
+	c.setError(c.INVALID_ENUM)
+	return 0
+}
+
+func (c *Context) GetShaderInfoLog(shader *Shader) string {
+	if s := c.shader(shader); s != nil {
+		return s.log
+	}
+	return ""
+}
+
+func (c *Context) CreateProgram() *Program {
+	p := &softProgram{id: c.newID(), bindings: make(map[string]int)}
+	c.programs[p.id] = p
+	return &Program{p.id}
+}
+
+// programObject returns the program object, raising INVALID_VALUE and returning nil
+// if there is none.
+func (c *Context) programObject(program *Program) *softProgram {
+	var p *softProgram
+	if program != nil {
+		p = c.programs[program.uint32]
+	}
+	if p == nil {
+		c.setError(c.INVALID_VALUE)
+	}
+	return p
+}
+
+// DeleteProgram deletes the program. If it is in use, it stays in use until
+// another program is.
+func (c *Context) DeleteProgram(program *Program) {
+	if program != nil {
+		delete(c.programs, program.uint32)
+	}
+}
+
+func (c *Context) IsProgram(program *Program) bool {
+	return program != nil && c.programs[program.uint32] != nil
+}
+
+func (c *Context) AttachShader(program *Program, shader *Shader) {
+	p, s := c.programObject(program), c.shader(shader)
+	if p == nil || s == nil {
+		return
+	}
+	for _, attached := range p.shaders {
+		if attached.typ == s.typ {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	p.shaders = append(p.shaders, s)
+}
+
+func (c *Context) DetachShader(program *Program, shader *Shader) {
+	p := c.programObject(program)
+	if p == nil || shader == nil {
+		return
+	}
+	for i, s := range p.shaders {
+		if s.id == shader.uint32 {
+			p.shaders = append(p.shaders[:i], p.shaders[i+1:]...)
+			return
+		}
+	}
+	c.setError(c.INVALID_OPERATION)
+}
+
+func (c *Context) GetAttachedShaders(program *Program) []*Shader {
+	p := c.programObject(program)
+	if p == nil {
+		return nil
+	}
+	shaders := make([]*Shader, len(p.shaders))
+	for i, s := range p.shaders {
+		shaders[i] = &Shader{s.id}
+	}
+	return shaders
+}
+
+// BindAttribLocation binds the attribute name to index, taking effect at the
+// next link.
+func (c *Context) BindAttribLocation(program *Program, index int, name string) {
+	p := c.programObject(program)
+	if p == nil {
+		return
+	}
+	if index < 0 || index >= softMaxVertexAttribs {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	if strings.HasPrefix(name, "gl_") {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	p.bindings[name] = index
+}
+
+// LinkProgram matches the declarations of the attached shaders, assigns
+// attribute and uniform locations and resets the uniforms to 0.
+func (c *Context) LinkProgram(program *Program) {
+	p := c.programObject(program)
+	if p == nil {
+		return
+	}
+	linked := *p
+	linked.linked, linked.validated = false, false
+	if err := c.link(&linked); err != nil {
+		p.linked, p.validated = false, false
+		p.log = "ERROR: 0:0: " + err.Error() + "\n"
+		return
+	}
+	linked.linked, linked.log = true, ""
+	*p = linked
+}
+
+func (c *Context) link(p *softProgram) error {
+	var vs, fs *softShader
+	for _, s := range p.shaders {
+		if s.typ == c.VERTEX_SHADER {
+			vs = s
+		} else {
+			fs = s
+		}
+	}
+	if vs == nil || fs == nil {
+		return fmt.Errorf("softgl: a vertex and a fragment shader must be attached")
+	}
+	if !vs.compiled || !fs.compiled {
+		return fmt.Errorf("softgl: attached shaders must be compiled")
+	}
+	p.vertex, p.fragment = vs.vertex, fs.fragment
+	p.attribs, p.attribLocs, p.attribIndex = nil, nil, make(map[string]int)
+	p.uniforms, p.uniformIndex, p.locations = nil, make(map[string]*softUniform), nil
+	p.varyings, p.varyingIndex = nil, make(map[string]int)
+
+	vertexVaryings := make(map[string]softVariable)
+	for _, v := range vs.variables {
+		switch v.qualifier {
+		case "attribute":
+			p.attribIndex[v.name] = len(p.attribs)
+			p.attribs = append(p.attribs, v)
+		case "varying":
+			vertexVaryings[v.name] = v
+			p.varyingIndex[v.name] = len(p.varyings)
+			p.varyings = append(p.varyings, v)
+		}
+	}
+	if len(p.varyings) > softMaxVaryingVectors {
+		return fmt.Errorf("softgl: too many varyings")
+	}
+	for _, v := range fs.variables {
+		if v.qualifier != "varying" {
+			continue
+		}
+		if vv, ok := vertexVaryings[v.name]; !ok || vv.typ != v.typ {
+			return fmt.Errorf("softgl: varying %s isn't declared with the same type by the vertex shader", v.name)
+		}
+	}
+
+	for _, s := range []*softShader{vs, fs} {
+		for _, v := range s.variables {
+			if v.qualifier != "uniform" {
+				continue
+			}
+			if u := p.uniformIndex[v.name]; u != nil {
+				if u.typ != v.typ || u.size != v.size {
+					return fmt.Errorf("softgl: uniform %s is declared with different types", v.name)
+				}
+				continue
+			}
+			u := &softUniform{softVariable: v, value: make([]float32, v.size*v.components)}
+			p.uniformIndex[v.name] = u
+			p.uniforms = append(p.uniforms, u)
+			for i := 0; i < v.size; i++ {
+				p.locations = append(p.locations, softLocation{uniform: u, element: i})
+			}
+		}
+	}
+
+	var used [softMaxVertexAttribs]bool
+	p.attribLocs = make([]int, len(p.attribs))
+	for i, a := range p.attribs {
+		p.attribLocs[i] = -1
+		if loc, ok := p.bindings[a.name]; ok {
+			p.attribLocs[i], used[loc] = loc, true
+		}
+	}
+	next := 0
+	for i := range p.attribs {
+		if p.attribLocs[i] >= 0 {
+			continue
+		}
+		for next < len(used) && used[next] {
+			next++
+		}
+		if next == len(used) {
+			return fmt.Errorf("softgl: too many attributes")
+		}
+		p.attribLocs[i], used[next] = next, true
+	}
+	return nil
+}
+
+// UseProgram makes program the current program, or unsets it if program is
+// nil.
+func (c *Context) UseProgram(program *Program) {
+	if program == nil || program.uint32 == 0 {
+		c.program = nil
+		return
+	}
+	p := c.programObject(program)
+	if p == nil {
+		return
+	}
+	if !p.linked {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	c.program = p
+}
+
+// ValidateProgram marks the program as valid if it is linked.
+func (c *Context) ValidateProgram(program *Program) {
+	if p := c.programObject(program); p != nil {
+		p.validated = p.linked
+	}
+}
+
+func (c *Context) GetProgramParameteri(program *Program, pname Enum) int {
+	p := c.programObject(program)
+	if p == nil {
+		return 0
+	}
+	switch pname {
+	case c.LINK_STATUS:
+		return boolInt(p.linked)
+	case c.VALIDATE_STATUS:
+		return boolInt(p.validated)
+	case c.DELETE_STATUS:
+		return boolInt(c.programs[p.id] == nil)
+	case c.ATTACHED_SHADERS:
+		return len(p.shaders)
+	case c.ACTIVE_ATTRIBUTES:
+		return len(p.attribs)
+	case c.ACTIVE_UNIFORMS:
+		return len(p.uniforms)
+	case c.INFO_LOG_LENGTH:
+		return infoLogLength(p.log)
+	}
+	c.setError(c.INVALID_ENUM)
+	return 0
+}
+
+func (c *Context) GetProgramParameterb(program *Program, pname Enum) bool {
+	return c.GetProgramParameteri(program, pname) != 0
+}
+
+func (c *Context) GetProgramInfoLog(program *Program) string {
+	if p := c.programObject(program); p != nil {
+		return p.log
+	}
+	return ""
+}
+
+// linkedProgram returns the program if it is linked, raising an error and
+// returning nil otherwise.
+func (c *Context) linkedProgram(program *Program) *softProgram {
+	p := c.programObject(program)
+	if p != nil && !p.linked {
+		c.setError(c.INVALID_OPERATION)
+		return nil
+	}
+	return p
+}
+
+func (c *Context) GetAttribLocation(program *Program, name string) int {
+	p := c.linkedProgram(program)
+	if p == nil {
+		return -1
+	}
+	if i, ok := p.attribIndex[name]; ok {
+		return p.attribLocs[i]
+	}
+	return -1
+}
+
+func (c *Context) GetActiveAttrib(program *Program, index int) (name string, size int, typ Enum) {
+	p := c.linkedProgram(program)
+	if p == nil {
+		return "", 0, 0
+	}
+	if index < 0 || index >= len(p.attribs) {
+		c.setError(c.INVALID_VALUE)
+		return "", 0, 0
+	}
+	a := p.attribs[index]
+	return a.name, a.size, a.typ
+}
+
+func (c *Context) GetActiveUniform(program *Program, index int) (name string, size int, typ Enum) {
+	p := c.linkedProgram(program)
+	if p == nil {
+		return "", 0, 0
+	}
+	if index < 0 || index >= len(p.uniforms) {
+		c.setError(c.INVALID_VALUE)
+		return "", 0, 0
+	}
+	u := p.uniforms[index]
+	name = u.name
+	if u.size > 1 {
+		name += "[0]"
+	}
+	return name, u.size, u.typ
+}
+
+// GetUniformLocation returns the location of a uniform or of an element of
+// an array uniform, e.g. "lights[2]". The location of unknown uniforms is -1,
+// and setting it does nothing.
+func (c *Context) GetUniformLocation(program *Program, name string) *UniformLocation {
+	p := c.linkedProgram(program)
+	if p == nil {
+		return &UniformLocation{-1}
+	}
+	element := 0
+	if i := strings.IndexByte(name, '['); i >= 0 && strings.HasSuffix(name, "]") {
+		n, err := strconv.Atoi(name[i+1 : len(name)-1])
+		if err != nil || n < 0 {
+			return &UniformLocation{-1}
+		}
+		name, element = name[:i], n
+	}
+	for loc, l := range p.locations {
+		if l.uniform.name == name && l.element == element {
+			return &UniformLocation{int32(loc)}
+		}
+	}
+	return &UniformLocation{-1}
+}
+
+// Kinds of values accepted by setUniform.
+const (
+	softFloatUniform = iota
+	softIntUniform
+	softMatrixUniform
+)
+
+// setUniform stores the values, count elements of n components, at location
+// of the current program. As in OpenGL, values that don't match the type of
+// the uniform raise INVALID_OPERATION.
+func (c *Context) setUniform(location *UniformLocation, kind, n int, values []float32) {
+	if c.vectorCount(n, len(values)) == 0 {
+		return
+	}
+	p := c.program
+	if p == nil {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	if location == nil || location.int32 == -1 {
+		return
+	}
+	if location.int32 < 0 || int(location.int32) >= len(p.locations) {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	l := p.locations[location.int32]
+	u := l.uniform
+
+	var ok bool
+	switch c.uniformKind(u.typ) {
+	case softFloatUniform:
+		ok = kind == softFloatUniform
+	case softIntUniform:
+		ok = kind == softIntUniform || kind == softFloatUniform && u.typ != c.INT && u.typ != c.INT_VEC2 && u.typ != c.INT_VEC3 && u.typ != c.INT_VEC4
+	case softMatrixUniform:
+		ok = kind == softMatrixUniform
+	}
+	count := len(values) / n
+	if !ok || u.components != n || count > 1 && u.size == 1 {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	if u.typ == c.SAMPLER_2D || u.typ == c.SAMPLER_CUBE {
+		for _, v := range values {
+			if v < 0 || int(v) >= softMaxTextureUnits {
+				c.setError(c.INVALID_VALUE)
+				return
+			}
+		}
+	}
+	count = minInt(count, u.size-l.element)
+	copy(u.value[l.element*n:], values[:count*n])
+}
+
+// uniformKind returns which kind of Uniform method sets a uniform of type typ.
+// Booleans and samplers are set like integers.
+func (c *Context) uniformKind(typ Enum) int {
+	switch typ {
+	case c.FLOAT, c.FLOAT_VEC2, c.FLOAT_VEC3, c.FLOAT_VEC4:
+		return softFloatUniform
+	case c.FLOAT_MAT2, c.FLOAT_MAT3, c.FLOAT_MAT4:
+		return softMatrixUniform
+	}
+	return softIntUniform
+}
+
+func (c *Context) setUniformInts(location *UniformLocation, n int, values []int32) {
+	f := make([]float32, len(values))
+	for i, v := range values {
+		f[i] = float32(v)
+	}
+	c.setUniform(location, softIntUniform, n, f)
+}
+
+func (c *Context) setUniformMatrix(location *UniformLocation, n int, transpose bool, value []float32) {
+	if c.matrixCount(n, value) == 0 {
+		return
+	}
+	if transpose {
+		value = transposeMatrices(n, value)
+	}
+	c.setUniform(location, softMatrixUniform, n*n, value)
+}
+
+func (c *Context) Uniform1f(location *UniformLocation, x float32) {
+	c.setUniform(location, softFloatUniform, 1, []float32{x})
+}
+
+func (c *Context) Uniform2f(location *UniformLocation, x, y float32) {
+	c.setUniform(location, softFloatUniform, 2, []float32{x, y})
+}
+
+func (c *Context) Uniform3f(location *UniformLocation, x, y, z float32) {
+	c.setUniform(location, softFloatUniform, 3, []float32{x, y, z})
+}
+
+func (c *Context) Uniform4f(location *UniformLocation, x, y, z, w float32) {
+	c.setUniform(location, softFloatUniform, 4, []float32{x, y, z, w})
+}
+
+func (c *Context) Uniform1i(location *UniformLocation, x int) {
+	c.setUniformInts(location, 1, []int32{int32(x)})
+}
+
+// Uniform1iTexture sets a sampler uniform to the name of tex, like the
+// desktop backend does.
+func (c *Context) Uniform1iTexture(location *UniformLocation, tex *Texture) {
+	c.setUniformInts(location, 1, []int32{int32(textureID(tex))})
+}
+
+func (c *Context) Uniform2i(location *UniformLocation, x, y int) {
+	c.setUniformInts(location, 2, []int32{int32(x), int32(y)})
+}
+
+func (c *Context) Uniform3i(location *UniformLocation, x, y, z int) {
+	c.setUniformInts(location, 3, []int32{int32(x), int32(y), int32(z)})
+}
+
+func (c *Context) Uniform4i(location *UniformLocation, x, y, z, w int) {
+	c.setUniformInts(location, 4, []int32{int32(x), int32(y), int32(z), int32(w)})
+}
+
+func (c *Context) Uniform1fv(location *UniformLocation, value []float32) {
+	c.setUniform(location, softFloatUniform, 1, value)
+}
+
+func (c *Context) Uniform2fv(location *UniformLocation, value []float32) {
+	c.setUniform(location, softFloatUniform, 2, value)
+}
+
+func (c *Context) Uniform3fv(location *UniformLocation, value []float32) {
+	c.setUniform(location, softFloatUniform, 3, value)
+}
+
+func (c *Context) Uniform4fv(location *UniformLocation, value []float32) {
+	c.setUniform(location, softFloatUniform, 4, value)
+}
+
+func (c *Context) Uniform1iv(location *UniformLocation, value []int32) {
+	c.setUniformInts(location, 1, value)
+}
+
+func (c *Context) Uniform2iv(location *UniformLocation, value []int32) {
+	c.setUniformInts(location, 2, value)
+}
+
+func (c *Context) Uniform3iv(location *UniformLocation, value []int32) {
+	c.setUniformInts(location, 3, value)
+}
+
+func (c *Context) Uniform4iv(location *UniformLocation, value []int32) {
+	c.setUniformInts(location, 4, value)
+}
+
+func (c *Context) UniformMatrix2fv(location *UniformLocation, transpose bool, value []float32) {
+	c.setUniformMatrix(location, 2, transpose, value)
+}
+
+func (c *Context) UniformMatrix3fv(location *UniformLocation, transpose bool, value []float32) {
+	c.setUniformMatrix(location, 3, transpose, value)
+}
+
+func (c *Context) UniformMatrix4fv(location *UniformLocation, transpose bool, value []float32) {
+	c.setUniformMatrix(location, 4, transpose, value)
+}
+
+var (
+	// glslComment matches the comments of a GLSL source.
+	glslComment = regexp.MustCompile(`(?s)//[^\n]*|/\*.*?\*/`)
+
+	// glslDeclaration matches the declaration of attributes, uniforms and
+	// varyings: qualifier, type and the list of names.
+	glslDeclaration = regexp.MustCompile(`\b(attribute|uniform|varying)\s+(?:(?:lowp|mediump|highp)\s+)?(\w+)\s+([^;]+);`)
+
+	// glslName matches a name in a declaration, with its array size.
+	glslName = regexp.MustCompile(`^(\w+)\s*(?:\[\s*(\d+)\s*\])?$`)
+)
+
+// parseDeclarations scans the source of a shader of type typ for its
+// attribute, uniform and varying declarations. Errors are formatted like a
+// driver's info log.
+func (c *Context) parseDeclarations(typ Enum, source string) ([]softVariable, error) {
+	// Blank out comments, keeping their newlines so lines are still counted
+	// right.
+	source = glslComment.ReplaceAllStringFunc(source, func(comment string) string {
+		return strings.Map(func(r rune) rune {
+			if r == '\n' {
+				return r
+			}
+			return ' '
+		}, comment)
+	})
+
+	var variables []softVariable
+	for _, m := range glslDeclaration.FindAllStringSubmatchIndex(source, -1) {
+		line := strings.Count(source[:m[0]], "\n") + 1
+		qualifier, typeName, names := source[m[2]:m[3]], source[m[4]:m[5]], source[m[6]:m[7]]
+		fail := func(format string, args ...interface{}) error {
+			return fmt.Errorf("ERROR: 0:%d: %s\n", line, fmt.Sprintf(format, args...))
+		}
+
+		if qualifier == "attribute" && typ != c.VERTEX_SHADER {
+			return nil, fail("attributes can only be declared in vertex shaders")
+		}
+		vtyp, components := c.glslType(typeName)
+		if components == 0 {
+			return nil, fail("unsupported type %s", typeName)
+		}
+		if qualifier != "uniform" && (components > 4 || vtyp != c.FLOAT && vtyp != c.FLOAT_VEC2 && vtyp != c.FLOAT_VEC3 && vtyp != c.FLOAT_VEC4) {
+			return nil, fail("softgl: %s %s can only be float or vec", qualifier, typeName)
+		}
+
+		for _, name := range strings.Split(names, ",") {
+			n := glslName.FindStringSubmatch(strings.TrimSpace(name))
+			if n == nil {
+				return nil, fail("softgl: can't parse the declaration of %q", strings.TrimSpace(name))
+			}
+			size := 1
+			if n[2] != "" {
+				size, _ = strconv.Atoi(n[2])
+				if size == 0 || qualifier != "uniform" {
+					return nil, fail("softgl: invalid array %s", n[1])
+				}
+			}
+			variables = append(variables, softVariable{
+				qualifier:  qualifier,
+				name:       n[1],
+				typ:        vtyp,
+				size:       size,
+				components: components,
+			})
+		}
+	}
+	return variables, nil
+}
+
+// glslType returns the type constant of a GLSL type and its number of
+// components, or 0 components if the type isn't supported.
+func (c *Context) glslType(name string) (typ Enum, components int) {
+	switch name {
+	case "float":
+		return c.FLOAT, 1
+	case "vec2":
+		return c.FLOAT_VEC2, 2
+	case "vec3":
+		return c.FLOAT_VEC3, 3
+	case "vec4":
+		return c.FLOAT_VEC4, 4
+	case "int":
+		return c.INT, 1
+	case "ivec2":
+		return c.INT_VEC2, 2
+	case "ivec3":
+		return c.INT_VEC3, 3
+	case "ivec4":
+		return c.INT_VEC4, 4
+	case "bool":
+		return c.BOOL, 1
+	case "bvec2":
+		return c.BOOL_VEC2, 2
+	case "bvec3":
+		return c.BOOL_VEC3, 3
+	case "bvec4":
+		return c.BOOL_VEC4, 4
+	case "mat2":
+		return c.FLOAT_MAT2, 4
+	case "mat3":
+		return c.FLOAT_MAT3, 9
+	case "mat4":
+		return c.FLOAT_MAT4, 16
+	case "sampler2D":
+		return c.SAMPLER_2D, 1
+	case "samplerCube":
+		return c.SAMPLER_CUBE, 1
+	}
+	return 0, 0
+}
@@ -0,0 +1,177 @@
+//go:build softgl && !nogl
+// +build softgl,!nogl
+
+package gl
+
+import (
+	"image/color"
+	"testing"
+)
+
+const (
+	testVertexShader = `attribute vec3 position;
+void main() {
+	gl_Position = vec4(position, 1.0);
+}`
+	testFragmentShader = `precision mediump float;
+uniform vec4 color;
+void main() {
+	gl_FragColor = color;
+}`
+)
+
+// newTestContext returns a 4×4 Context using a program that draws the
+// triangles of the position attribute with the color uniform, and the
+// location of the uniform.
+func newTestContext(t *testing.T) (*Context, *UniformLocation) {
+	c := NewContext(4, 4)
+	c.RegisterVertexShader(testVertexShader, func(s *ShaderState) [4]float32 {
+		p := s.Attrib("position")
+		return [4]float32{p[0], p[1], p[2], 1}
+	})
+	c.RegisterFragmentShader(testFragmentShader, func(s *ShaderState) ([4]float32, bool) {
+		u := s.Uniform("color")
+		return [4]float32{u[0], u[1], u[2], u[3]}, false
+	})
+	program, err := c.BuildProgram(testVertexShader, testFragmentShader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.UseProgram(program)
+	c.BindBuffer(c.ARRAY_BUFFER, c.CreateBuffer())
+	position := c.GetAttribLocation(program, "position")
+	c.EnableVertexAttribArray(position)
+	c.VertexAttribPointer(position, 3, c.FLOAT, false, 0, 0)
+	return c, c.GetUniformLocation(program, "color")
+}
+
+// drawColor draws the triangles of vertices, given as x, y, z, with the
+// color (r, g, b, a) set through the uniform location loc.
+func drawColor(t *testing.T, c *Context, loc *UniformLocation, vertices []float32, r, g, b, a float32) {
+	t.Helper()
+	c.BufferData(c.ARRAY_BUFFER, vertices, c.STATIC_DRAW)
+	c.Uniform4f(loc, r, g, b, a)
+	c.DrawArrays(c.TRIANGLES, 0, len(vertices)/3)
+	if err := c.GetError(); err != c.NO_ERROR {
+		t.Fatalf("drawing raised %s", c.ErrorName(err))
+	}
+}
+
+// quad returns the vertices of two triangles covering the viewport at depth z.
+func quad(z float32) []float32 {
+	return []float32{
+		-1, -1, z, 1, -1, z, -1, 1, z,
+		-1, 1, z, 1, -1, z, 1, 1, z,
+	}
+}
+
+// lowerLeft is a triangle covering the pixels below the diagonal from the
+// top-left to the bottom-right corner.
+var lowerLeft = []float32{-1, -1, 0, 1, -1, 0, -1, 1, 0}
+
+// checkPixel checks the color of the pixel at (x, y), counted from the
+// bottom-left corner like OpenGL does, allowing each channel to be off by
+// one for rounding.
+func checkPixel(t *testing.T, c *Context, x, y int, want color.RGBA) {
+	t.Helper()
+	img, err := c.ReadPixels(x, y, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := img.RGBAAt(0, 0)
+	diff := func(a, b uint8) bool { return int(a)-int(b) > 1 || int(b)-int(a) > 1 }
+	if diff(got.R, want.R) || diff(got.G, want.G) || diff(got.B, want.B) || diff(got.A, want.A) {
+		t.Errorf("pixel (%d, %d) is %v, want %v", x, y, got, want)
+	}
+}
+
+var (
+	black = color.RGBA{0, 0, 0, 0}
+	red   = color.RGBA{255, 0, 0, 255}
+	green = color.RGBA{0, 255, 0, 255}
+	blue  = color.RGBA{0, 0, 255, 255}
+)
+
+func TestSoftTriangle(t *testing.T) {
+	c, loc := newTestContext(t)
+	drawColor(t, c, loc, lowerLeft, 1, 0, 0, 1)
+	checkPixel(t, c, 0, 0, red)
+	checkPixel(t, c, 2, 0, red)
+	checkPixel(t, c, 0, 2, red)
+	checkPixel(t, c, 3, 3, black)
+	checkPixel(t, c, 3, 2, black)
+}
+
+func TestSoftBlending(t *testing.T) {
+	c, loc := newTestContext(t)
+	c.ClearColor(0, 0, 1, 1)
+	c.Clear(c.COLOR_BUFFER_BIT)
+	c.Enable(c.BLEND)
+	c.BlendFunc(c.SRC_ALPHA, c.ONE_MINUS_SRC_ALPHA)
+	drawColor(t, c, loc, quad(0), 1, 0, 0, 0.5)
+	checkPixel(t, c, 1, 1, color.RGBA{128, 0, 128, 191})
+}
+
+func TestSoftDepth(t *testing.T) {
+	c, loc := newTestContext(t)
+	c.Enable(c.DEPTH_TEST)
+	c.Clear(c.COLOR_BUFFER_BIT | c.DEPTH_BUFFER_BIT)
+	drawColor(t, c, loc, quad(0.5), 1, 0, 0, 1)
+	drawColor(t, c, loc, quad(0.8), 0, 1, 0, 1)
+	checkPixel(t, c, 1, 1, red)
+	drawColor(t, c, loc, quad(-0.5), 0, 0, 1, 1)
+	checkPixel(t, c, 1, 1, blue)
+
+	c.Disable(c.DEPTH_TEST)
+	drawColor(t, c, loc, quad(0.8), 0, 1, 0, 1)
+	checkPixel(t, c, 1, 1, green)
+}
+
+func TestSoftStencil(t *testing.T) {
+	c, loc := newTestContext(t)
+	c.Enable(c.STENCIL_TEST)
+	c.ClearStencil(0)
+	c.Clear(c.COLOR_BUFFER_BIT | c.STENCIL_BUFFER_BIT)
+
+	c.StencilFunc(c.ALWAYS, 1, 0xFF)
+	c.StencilOp(c.KEEP, c.KEEP, c.REPLACE)
+	c.ColorMask(false, false, false, false)
+	drawColor(t, c, loc, lowerLeft, 1, 0, 0, 1)
+	checkPixel(t, c, 0, 0, black)
+
+	c.StencilFunc(c.EQUAL, 1, 0xFF)
+	c.StencilOp(c.KEEP, c.KEEP, c.KEEP)
+	c.ColorMask(true, true, true, true)
+	drawColor(t, c, loc, quad(0), 0, 1, 0, 1)
+	checkPixel(t, c, 0, 0, green)
+	checkPixel(t, c, 3, 3, black)
+}
+
+func TestSoftReadPixelsOrientation(t *testing.T) {
+	c, loc := newTestContext(t)
+	// Red bottom half, green top half.
+	drawColor(t, c, loc, quad(0), 0, 1, 0, 1)
+	drawColor(t, c, loc, []float32{
+		-1, -1, 0, 1, -1, 0, -1, 0, 0,
+		-1, 0, 0, 1, -1, 0, 1, 0, 0,
+	}, 1, 0, 0, 1)
+
+	img, err := c.ReadPixels(0, 0, 4, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := img.RGBAAt(0, 0); got != green {
+		t.Errorf("top-left pixel of the image is %v, want %v", got, green)
+	}
+	if got := img.RGBAAt(0, 3); got != red {
+		t.Errorf("bottom-left pixel of the image is %v, want %v", got, red)
+	}
+
+	img, err = c.ReadPixels(0, 1, 4, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if top, bottom := img.RGBAAt(0, 0), img.RGBAAt(0, 1); top != green || bottom != red {
+		t.Errorf("rows 1 and 2 read as %v above %v, want %v above %v", top, bottom, green, red)
+	}
+}
@@ -0,0 +1,428 @@
+//go:build softgl && !nogl
+// +build softgl,!nogl
+
+package gl
+
+import (
+	"fmt"
+	"image"
+	"math"
+)
+
+// softTexture is a 2D texture object. Only its base level is stored, as RGBA
+// with 8 bits per channel, bottom row first.
+type softTexture struct {
+	id     uint32
+	width  int
+	height int
+	format Enum
+	pix    []byte
+
+	minFilter Enum
+	magFilter Enum
+	wrapS     Enum
+	wrapT     Enum
+
+	// mipmapped is set once the texture has mipmap levels.
+	mipmapped bool
+}
+
+func (c *Context) CreateTexture() *Texture {
+	t := &softTexture{id: c.newID()}
+	t.format = c.RGBA
+	t.minFilter, t.magFilter = c.NEAREST_MIPMAP_LINEAR, c.LINEAR
+	t.wrapS, t.wrapT = c.REPEAT, c.REPEAT
+	c.textures[t.id] = t
+	return &Texture{t.id}
+}
+
+// DeleteTexture deletes the texture, unbinding it from every texture unit and
+// from the bound framebuffer.
+func (c *Context) DeleteTexture(texture *Texture) {
+	t := c.textures[textureID(texture)]
+	if t == nil {
+		return
+	}
+	delete(c.textures, t.id)
+	for i := range c.textureUnits {
+		if c.textureUnits[i] == t {
+			c.textureUnits[i] = nil
+		}
+	}
+	if fb := c.framebuffer; fb != nil {
+		fb.detach(func(a softAttachment) bool { return a.texture == t })
+	}
+}
+
+func (c *Context) IsTexture(texture *Texture) bool {
+	return c.textures[textureID(texture)] != nil
+}
+
+func (c *Context) ActiveTexture(target Enum) {
+	unit := int(target - c.TEXTURE0)
+	if unit < 0 || unit >= softMaxTextureUnits {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	c.activeTexture = unit
+}
+
+func (c *Context) BindTexture(target Enum, texture *Texture) {
+	if target != c.TEXTURE_2D {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	var t *softTexture
+	if id := textureID(texture); id != 0 {
+		if t = c.textures[id]; t == nil {
+			c.setError(c.INVALID_OPERATION)
+			return
+		}
+	}
+	c.textureUnits[c.activeTexture] = t
+}
+
+// boundTexture returns the texture bound to target on the active unit,
+// raising an error and returning nil if there is none.
+func (c *Context) boundTexture(target Enum) *softTexture {
+	if target != c.TEXTURE_2D {
+		c.setError(c.INVALID_ENUM)
+		return nil
+	}
+	t := c.textureUnits[c.activeTexture]
+	if t == nil {
+		c.setError(c.INVALID_OPERATION)
+	}
+	return t
+}
+
+func (c *Context) TexParameteri(target, pname, param Enum) {
+	if c.unsupportedEnum(target) || c.unsupportedEnum(pname) || c.unsupportedTexParameter(pname, param) {
+		return
+	}
+	t := c.boundTexture(target)
+	if t == nil {
+		return
+	}
+	switch pname {
+	case c.TEXTURE_MIN_FILTER:
+		switch param {
+		case c.NEAREST, c.LINEAR, c.NEAREST_MIPMAP_NEAREST, c.LINEAR_MIPMAP_NEAREST,
+			c.NEAREST_MIPMAP_LINEAR, c.LINEAR_MIPMAP_LINEAR:
+			t.minFilter = param
+			return
+		}
+	case c.TEXTURE_MAG_FILTER:
+		switch param {
+		case c.NEAREST, c.LINEAR:
+			t.magFilter = param
+			return
+		}
+	case c.TEXTURE_WRAP_S, c.TEXTURE_WRAP_T:
+		switch param {
+		case c.REPEAT, c.CLAMP_TO_EDGE, c.MIRRORED_REPEAT:
+			if pname == c.TEXTURE_WRAP_S {
+				t.wrapS = param
+			} else {
+				t.wrapT = param
+			}
+			return
+		}
+	}
+	c.setError(c.INVALID_ENUM)
+}
+
+// TexImage2D loads the supplied image into the bound texture. data may be nil
+// or any image.Image, whose pixels are converted to format first, so that the
+// texture holds what a driver would have stored. Levels other than 0 are only
+// checked, mipmaps are never sampled.
+func (c *Context) TexImage2D(target Enum, level int, internalFormat, format, kind Enum, data interface{}) error {
+	if data == nil {
+		c.texImage(target, level, format, kind, 0, 0, nil)
+		return nil
+	}
+	img, ok := data.(image.Image)
+	if !ok {
+		return fmt.Errorf("gl: image type unsupported: %T", data)
+	}
+	pix, stride, err := c.imagePixels(img, format, kind)
+	if err != nil {
+		return err
+	}
+	width, height := img.Bounds().Dx(), img.Bounds().Dy()
+	pix = packRows(pix, stride, c.bytesPerPixel(format, kind)*width, height)
+	c.texImage(target, level, format, kind, width, height, pix)
+	return nil
+}
+
+// TexImage2DEmpty allocates a width×height image for the bound texture,
+// cleared to transparent black.
+func (c *Context) TexImage2DEmpty(target Enum, level int, internalFormat, format, kind Enum, width, height int) {
+	c.texImage(target, level, format, kind, width, height, nil)
+}
+
+// texImage replaces the image of the bound texture with the tightly packed
+// rows in pix, or with zeros if pix is nil.
+func (c *Context) texImage(target Enum, level int, format, kind Enum, width, height int, pix []byte) {
+	t := c.boundTexture(target)
+	if t == nil {
+		return
+	}
+	if level < 0 || width < 0 || height < 0 || width > softMaxTextureSize || height > softMaxTextureSize {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	if c.bytesPerPixel(format, kind) == 0 {
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	if level > 0 {
+		t.mipmapped = true
+		return
+	}
+	t.width, t.height, t.format = width, height, format
+	t.pix = make([]byte, 4*width*height)
+	t.mipmapped = false
+	if pix != nil {
+		c.unpackPixels(t.pix, width, 0, 0, width, height, format, kind, pix)
+	}
+}
+
+// TexSubImage2D replaces a width×height rectangle of the bound texture at
+// (xoffset, yoffset). data is a []byte of tightly packed rows, or an
+// image.Image whose top-left rectangle is converted to format and used.
+func (c *Context) TexSubImage2D(target Enum, level, xoffset, yoffset, width, height int, format, kind Enum, data interface{}) error {
+	pix, err := c.texturePixels(data, width, height, format, kind)
+	if err != nil {
+		return err
+	}
+	t := c.boundTexture(target)
+	if t == nil {
+		return nil
+	}
+	if level != 0 {
+		return nil
+	}
+	if xoffset < 0 || yoffset < 0 || width < 0 || height < 0 || xoffset+width > t.width || yoffset+height > t.height {
+		c.setError(c.INVALID_VALUE)
+		return nil
+	}
+	if c.bytesPerPixel(format, kind) == 0 {
+		c.setError(c.INVALID_ENUM)
+		return nil
+	}
+	c.unpackPixels(t.pix, t.width, xoffset, yoffset, width, height, format, kind, pix)
+	return nil
+}
+
+// unpackPixels converts width×height tightly packed pixels of the given format
+// and type to RGBA, and stores them at (x, y) in dst, an image stride pixels
+// wide. The first row of src is stored at y unless UNPACK_FLIP_Y_WEBGL is set.
+func (c *Context) unpackPixels(dst []byte, stride, x, y, width, height int, format, kind Enum, src []byte) {
+	bpp := c.bytesPerPixel(format, kind)
+	for row := 0; row < height; row++ {
+		dy := y + row
+		if c.unpackFlipY {
+			dy = y + height - 1 - row
+		}
+		for col := 0; col < width; col++ {
+			p := src[bpp*(row*width+col):]
+			rgba := c.unpackPixel(p, format, kind)
+			if c.unpackPremultiplyAlpha {
+				for i := 0; i < 3; i++ {
+					rgba[i] = byte((int(rgba[i])*int(rgba[3]) + 127) / 255)
+				}
+			}
+			copy(dst[4*(dy*stride+x+col):], rgba[:])
+		}
+	}
+}
+
+// unpackPixel converts the pixel at the start of p to RGBA.
+func (c *Context) unpackPixel(p []byte, format, kind Enum) [4]byte {
+	switch kind {
+	case c.UNSIGNED_SHORT_5_6_5:
+		v := uint16(p[0]) | uint16(p[1])<<8
+		return [4]byte{expandBits(v>>11, 5), expandBits(v>>5, 6), expandBits(v, 5), 255}
+	case c.UNSIGNED_SHORT_4_4_4_4:
+		v := uint16(p[0]) | uint16(p[1])<<8
+		return [4]byte{expandBits(v>>12, 4), expandBits(v>>8, 4), expandBits(v>>4, 4), expandBits(v, 4)}
+	case c.UNSIGNED_SHORT_5_5_5_1:
+		v := uint16(p[0]) | uint16(p[1])<<8
+		return [4]byte{expandBits(v>>11, 5), expandBits(v>>6, 5), expandBits(v>>1, 5), expandBits(v, 1)}
+	}
+	switch format {
+	case c.ALPHA:
+		return [4]byte{0, 0, 0, p[0]}
+	case c.LUMINANCE:
+		return [4]byte{p[0], p[0], p[0], 255}
+	case c.LUMINANCE_ALPHA:
+		return [4]byte{p[0], p[0], p[0], p[1]}
+	case c.RGB:
+		return [4]byte{p[0], p[1], p[2], 255}
+	}
+	return [4]byte{p[0], p[1], p[2], p[3]}
+}
+
+// expandBits scales the low bits of v to 8 bits.
+func expandBits(v uint16, bits uint) byte {
+	max := uint16(1)<<bits - 1
+	return byte((uint32(v&max)*255 + uint32(max)/2) / uint32(max))
+}
+
+// CopyTexImage2D replaces the image of the bound texture with a rectangle of
+// the bound framebuffer.
+func (c *Context) CopyTexImage2D(target Enum, level int, internal Enum, x, y, w, h, border int) {
+	if border != 0 {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	switch internal {
+	case c.ALPHA, c.LUMINANCE, c.LUMINANCE_ALPHA, c.RGB, c.RGBA:
+	default:
+		c.setError(c.INVALID_ENUM)
+		return
+	}
+	c.texImage(target, level, internal, c.UNSIGNED_BYTE, w, h, nil)
+	if level == 0 {
+		c.CopyTexSubImage2D(target, level, 0, 0, x, y, w, h)
+	}
+}
+
+// CopyTexSubImage2D replaces a rectangle of the bound texture at (xoffset,
+// yoffset) with a rectangle of the bound framebuffer. Channels missing from
+// the texture's format are reset as if the pixels had been uploaded with it.
+func (c *Context) CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, w, h int) {
+	t := c.boundTexture(target)
+	if t == nil || level != 0 {
+		return
+	}
+	if xoffset < 0 || yoffset < 0 || w < 0 || h < 0 || xoffset+w > t.width || yoffset+h > t.height {
+		c.setError(c.INVALID_VALUE)
+		return
+	}
+	s, ok := c.surface()
+	if !ok {
+		return
+	}
+	if s.color == nil {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	for row := 0; row < h; row++ {
+		for col := 0; col < w; col++ {
+			sx, sy := x+col, y+row
+			var p [4]byte
+			if sx >= 0 && sx < s.width && sy >= 0 && sy < s.height {
+				copy(p[:], s.color[4*(sy*s.width+sx):])
+			}
+			switch t.format {
+			case c.ALPHA:
+				p = [4]byte{0, 0, 0, p[3]}
+			case c.LUMINANCE:
+				p = [4]byte{p[0], p[0], p[0], 255}
+			case c.LUMINANCE_ALPHA:
+				p = [4]byte{p[0], p[0], p[0], p[3]}
+			case c.RGB:
+				p[3] = 255
+			}
+			copy(t.pix[4*((yoffset+row)*t.width+xoffset+col):], p[:])
+		}
+	}
+}
+
+// GenerateMipmap marks the bound texture as mipmapped, which makes it complete
+// with a mipmap minification filter. The levels themselves aren't computed.
+func (c *Context) GenerateMipmap(target Enum) {
+	t := c.boundTexture(target)
+	if t == nil {
+		return
+	}
+	if !isPowerOfTwo(t.width) || !isPowerOfTwo(t.height) {
+		c.setError(c.INVALID_OPERATION)
+		return
+	}
+	t.mipmapped = true
+}
+
+// complete reports whether t can be sampled. As in OpenGL ES 2.0, textures
+// without an image, textures whose filter needs mipmaps they lack, and non
+// power of two textures that use mipmaps or don't clamp to the edge sample as
+// opaque black.
+func (c *Context) complete(t *softTexture) bool {
+	if t == nil || t.width == 0 || t.height == 0 {
+		return false
+	}
+	mipmaps := t.minFilter != c.NEAREST && t.minFilter != c.LINEAR
+	if mipmaps && !t.mipmapped {
+		return false
+	}
+	if !isPowerOfTwo(t.width) || !isPowerOfTwo(t.height) {
+		return !mipmaps && t.wrapS == c.CLAMP_TO_EDGE && t.wrapT == c.CLAMP_TO_EDGE
+	}
+	return true
+}
+
+// sample returns the color of t at the texture coordinates (s, t), filtered
+// with the magnification filter.
+func (c *Context) sample(tex *softTexture, s, t float32) [4]float32 {
+	if !c.complete(tex) {
+		return [4]float32{0, 0, 0, 1}
+	}
+	u := float64(s)*float64(tex.width) - 0.5
+	v := float64(t)*float64(tex.height) - 0.5
+	if tex.magFilter == c.NEAREST {
+		x := c.wrap(tex.wrapS, int(math.Floor(u+0.5)), tex.width)
+		y := c.wrap(tex.wrapT, int(math.Floor(v+0.5)), tex.height)
+		return tex.texel(x, y)
+	}
+
+	x0, y0 := math.Floor(u), math.Floor(v)
+	fx, fy := float32(u-x0), float32(v-y0)
+	xa, xb := c.wrap(tex.wrapS, int(x0), tex.width), c.wrap(tex.wrapS, int(x0)+1, tex.width)
+	ya, yb := c.wrap(tex.wrapT, int(y0), tex.height), c.wrap(tex.wrapT, int(y0)+1, tex.height)
+	t00, t10 := tex.texel(xa, ya), tex.texel(xb, ya)
+	t01, t11 := tex.texel(xa, yb), tex.texel(xb, yb)
+	var out [4]float32
+	for i := range out {
+		top := t00[i] + (t10[i]-t00[i])*fx
+		bottom := t01[i] + (t11[i]-t01[i])*fx
+		out[i] = top + (bottom-top)*fy
+	}
+	return out
+}
+
+// wrap maps the texel coordinate i into [0, size) with the wrap mode.
+func (c *Context) wrap(mode Enum, i, size int) int {
+	switch mode {
+	case c.REPEAT:
+		i %= size
+		if i < 0 {
+			i += size
+		}
+		return i
+	case c.MIRRORED_REPEAT:
+		period := 2 * size
+		i %= period
+		if i < 0 {
+			i += period
+		}
+		if i >= size {
+			i = period - 1 - i
+		}
+		return i
+	}
+	return minInt(maxInt(i, 0), size-1)
+}
+
+func (t *softTexture) texel(x, y int) [4]float32 {
+	p := t.pix[4*(y*t.width+x):]
+	return [4]float32{float32(p[0]) / 255, float32(p[1]) / 255, float32(p[2]) / 255, float32(p[3]) / 255}
+}
+
+func textureID(texture *Texture) uint32 {
+	if texture == nil {
+		return 0
+	}
+	return texture.uint32
+}
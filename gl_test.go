@@ -0,0 +1,211 @@
+package gl
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+// newConstantsContext returns a Context with only its constants set, which is
+// enough to test the code the backends share without a driver.
+func newConstantsContext() *Context {
+	return &Context{Constants: newConstants()}
+}
+
+func TestVectorCount(t *testing.T) {
+	for _, test := range []struct {
+		n, length int
+		want      int
+		invalid   bool
+	}{
+		{n: 1, length: 0, want: 0},
+		{n: 1, length: 3, want: 3},
+		{n: 2, length: 4, want: 2},
+		{n: 3, length: 3, want: 1},
+		{n: 4, length: 8, want: 2},
+		{n: 2, length: 3, invalid: true},
+		{n: 4, length: 2, invalid: true},
+	} {
+		c := newConstantsContext()
+		if got := c.vectorCount(test.n, test.length); got != test.want {
+			t.Errorf("vectorCount(%d, %d) = %d, want %d", test.n, test.length, got, test.want)
+		}
+		// GetError is only called when an error is expected, as it would
+		// call the driver otherwise.
+		if test.invalid {
+			if err := c.GetError(); err != c.INVALID_VALUE {
+				t.Errorf("vectorCount(%d, %d) raised 0x%X, want INVALID_VALUE", test.n, test.length, int(err))
+			}
+		}
+	}
+}
+
+func TestMatrixCount(t *testing.T) {
+	for _, test := range []struct {
+		n, length int
+		want      int
+		invalid   bool
+	}{
+		{n: 2, length: 0, want: 0},
+		{n: 2, length: 4, want: 1},
+		{n: 3, length: 18, want: 2},
+		{n: 4, length: 16, want: 1},
+		{n: 4, length: 48, want: 3},
+		{n: 2, length: 3, invalid: true},
+		{n: 3, length: 16, invalid: true},
+		{n: 4, length: 20, invalid: true},
+	} {
+		c := newConstantsContext()
+		if got := c.matrixCount(test.n, make([]float32, test.length)); got != test.want {
+			t.Errorf("matrixCount(%d, %d values) = %d, want %d", test.n, test.length, got, test.want)
+		}
+		if test.invalid {
+			if err := c.GetError(); err != c.INVALID_VALUE {
+				t.Errorf("matrixCount(%d, %d values) raised 0x%X, want INVALID_VALUE", test.n, test.length, int(err))
+			}
+		}
+	}
+}
+
+func TestTransposeMatrices(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		n     int
+		value []float32
+		want  []float32
+	}{
+		{
+			name:  "2x2",
+			n:     2,
+			value: []float32{1, 2, 3, 4},
+			want:  []float32{1, 3, 2, 4},
+		},
+		{
+			name:  "3x3",
+			n:     3,
+			value: []float32{1, 2, 3, 4, 5, 6, 7, 8, 9},
+			want:  []float32{1, 4, 7, 2, 5, 8, 3, 6, 9},
+		},
+		{
+			name:  "two 2x2",
+			n:     2,
+			value: []float32{1, 2, 3, 4, 5, 6, 7, 8},
+			want:  []float32{1, 3, 2, 4, 5, 7, 6, 8},
+		},
+		{
+			name:  "4x4",
+			n:     4,
+			value: []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16},
+			want:  []float32{1, 5, 9, 13, 2, 6, 10, 14, 3, 7, 11, 15, 4, 8, 12, 16},
+		},
+		{
+			name:  "empty",
+			n:     4,
+			value: []float32{},
+			want:  []float32{},
+		},
+	} {
+		value := make([]float32, len(test.value))
+		copy(value, test.value)
+		if got := transposeMatrices(test.n, value); !reflect.DeepEqual(got, test.want) {
+			t.Errorf("%s: transposeMatrices returned %v, want %v", test.name, got, test.want)
+		}
+		if !reflect.DeepEqual(value, test.value) {
+			t.Errorf("%s: transposeMatrices modified its argument", test.name)
+		}
+	}
+}
+
+func TestFlipRows(t *testing.T) {
+	for _, test := range []struct {
+		name           string
+		pix            []byte
+		stride, height int
+		want           []byte
+	}{
+		{
+			name:   "empty",
+			pix:    []byte{},
+			stride: 4,
+			want:   []byte{},
+		},
+		{
+			name:   "one row",
+			pix:    []byte{1, 2, 3, 4},
+			stride: 4,
+			height: 1,
+			want:   []byte{1, 2, 3, 4},
+		},
+		{
+			name:   "two rows",
+			pix:    []byte{1, 2, 3, 4, 5, 6, 7, 8},
+			stride: 4,
+			height: 2,
+			want:   []byte{5, 6, 7, 8, 1, 2, 3, 4},
+		},
+		{
+			name:   "three rows",
+			pix:    []byte{1, 1, 2, 2, 3, 3},
+			stride: 2,
+			height: 3,
+			want:   []byte{3, 3, 2, 2, 1, 1},
+		},
+		{
+			name:   "rows past height",
+			pix:    []byte{1, 1, 2, 2, 9, 9},
+			stride: 2,
+			height: 2,
+			want:   []byte{2, 2, 1, 1, 9, 9},
+		},
+	} {
+		pix := append([]byte(nil), test.pix...)
+		flipRows(pix, test.stride, test.height)
+		if !bytes.Equal(pix, test.want) {
+			t.Errorf("%s: flipRows returned %v, want %v", test.name, pix, test.want)
+		}
+	}
+}
+
+func TestCheckReadPixels(t *testing.T) {
+	for _, test := range []struct {
+		width, height int
+		length        int
+		ok            bool
+	}{
+		{width: 2, height: 2, length: 16, ok: true},
+		{width: 2, height: 2, length: 20, ok: true},
+		{width: 2, height: 2, length: 15},
+		{width: 0, height: 2, length: 16},
+		{width: 2, height: -1, length: 16},
+	} {
+		err := checkReadPixels(test.width, test.height, make([]byte, test.length))
+		if (err == nil) != test.ok {
+			t.Errorf("checkReadPixels(%d, %d, %d bytes) returned %v", test.width, test.height, test.length, err)
+		}
+	}
+
+	c := newConstantsContext()
+	if _, err := c.ReadPixels(0, 0, 0, 1); err == nil {
+		t.Error("ReadPixels of an empty rectangle didn't fail")
+	}
+}
+
+func TestParameterSize(t *testing.T) {
+	c := newConstantsContext()
+	for _, test := range []struct {
+		pname Enum
+		want  int
+	}{
+		{c.VIEWPORT, 4},
+		{c.SCISSOR_BOX, 4},
+		{c.COLOR_WRITEMASK, 4},
+		{c.DEPTH_RANGE, 2},
+		{c.MAX_VIEWPORT_DIMS, 2},
+		{c.BLEND, 1},
+		{c.CURRENT_PROGRAM, 1},
+	} {
+		if got := c.parameterSize(test.pname); got != test.want {
+			t.Errorf("parameterSize(0x%X) = %d, want %d", int(test.pname), got, test.want)
+		}
+	}
+}
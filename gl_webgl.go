@@ -2,16 +2,17 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-//go:build js && !nogl
-// +build js,!nogl
+//go:build js && !nogl && !softgl
+// +build js,!nogl,!softgl
 
 package gl
 
 import (
 	"errors"
+	"fmt"
 	"image"
-	"log"
 	"reflect"
+	"strings"
 	"syscall/js"
 	"unsafe"
 )
@@ -24,6 +25,44 @@ type Program struct{ js.Value }
 type UniformLocation struct{ js.Value }
 type Shader struct{ js.Value }
 
+// objectKeys numbers the WebGL objects seen by objectKey.
+var objectKeys int
+
+// objectKey returns a number telling the WebGL object obj points to apart from
+// other objects in a map, as a js.Value can't be compared. The number is
+// stored in a property of the object the first time it is seen.
+func objectKey(obj interface{}) interface{} {
+	var v js.Value
+	switch o := obj.(type) {
+	case *Texture:
+		v = o.Value
+	case *Buffer:
+		v = o.Value
+	case *FrameBuffer:
+		v = o.Value
+	case *RenderBuffer:
+		v = o.Value
+	case *Shader:
+		v = o.Value
+	case *Program:
+		v = o.Value
+	case *UniformLocation:
+		v = o.Value
+	default:
+		return obj
+	}
+	if v.Type() != js.TypeObject {
+		return 0
+	}
+	key := v.Get("_glObjectKey")
+	if key.IsUndefined() {
+		objectKeys++
+		v.Set("_glObjectKey", objectKeys)
+		return objectKeys
+	}
+	return key.Int()
+}
+
 var jsBuf = js.Global().Get("ArrayBuffer").New(16)
 
 func temporaryUint8Array(byteLength int) js.Value {
@@ -36,6 +75,34 @@ func temporaryUint8Array(byteLength int) js.Value {
 	return js.Global().Get("Uint8Array").New(jsBuf, 0, byteLength)
 }
 
+// temporaryFloat32Array copies value into the shared staging buffer and
+// returns a Float32Array view of it. The view is only valid until the next
+// call that uses the staging buffer.
+func temporaryFloat32Array(value []float32) js.Value {
+	l := len(value)
+	h := (*reflect.SliceHeader)(unsafe.Pointer(&value))
+	h.Len *= 4
+	h.Cap *= 4
+	bs := *(*[]byte)(unsafe.Pointer(h))
+	uint8arr := temporaryUint8Array(l * 4)
+	js.CopyBytesToJS(uint8arr, bs)
+	return js.Global().Get("Float32Array").New(uint8arr.Get("buffer"), uint8arr.Get("byteOffset"), l)
+}
+
+// temporaryInt32Array copies value into the shared staging buffer and
+// returns an Int32Array view of it. The view is only valid until the next
+// call that uses the staging buffer.
+func temporaryInt32Array(value []int32) js.Value {
+	l := len(value)
+	h := (*reflect.SliceHeader)(unsafe.Pointer(&value))
+	h.Len *= 4
+	h.Cap *= 4
+	bs := *(*[]byte)(unsafe.Pointer(h))
+	uint8arr := temporaryUint8Array(l * 4)
+	js.CopyBytesToJS(uint8arr, bs)
+	return js.Global().Get("Int32Array").New(uint8arr.Get("buffer"), uint8arr.Get("byteOffset"), l)
+}
+
 type ContextAttributes struct {
 	// If Alpha is true, the drawing buffer has an alpha channel for
 	// the purposes of performing OpenGL destination alpha operations
@@ -68,303 +135,19 @@ func DefaultAttributes() *ContextAttributes {
 	return &ContextAttributes{true, true, false, true, true, false}
 }
 
+var _ Renderer = (*Context)(nil)
+
 type Context struct {
 	js.Value
-	ARRAY_BUFFER                                 int
-	ARRAY_BUFFER_BINDING                         int
-	ATTACHED_SHADERS                             int
-	BACK                                         int
-	BLEND                                        int
-	BLEND_COLOR                                  int
-	BLEND_DST_ALPHA                              int
-	BLEND_DST_RGB                                int
-	BLEND_EQUATION                               int
-	BLEND_EQUATION_ALPHA                         int
-	BLEND_EQUATION_RGB                           int
-	BLEND_SRC_ALPHA                              int
-	BLEND_SRC_RGB                                int
-	BLUE_BITS                                    int
-	BOOL                                         int
-	BOOL_VEC2                                    int
-	BOOL_VEC3                                    int
-	BOOL_VEC4                                    int
-	BROWSER_DEFAULT_WEBGL                        int
-	BUFFER_SIZE                                  int
-	BUFFER_USAGE                                 int
-	BYTE                                         int
-	CCW                                          int
-	CLAMP_TO_EDGE                                int
-	CLAMP_TO_BORDER                              int // not supported! Defaults to CLAMP_TO_EDGE!
-	COLOR_ATTACHMENT0                            int
-	COLOR_BUFFER_BIT                             int
-	COLOR_CLEAR_VALUE                            int
-	COLOR_WRITEMASK                              int
-	COMPILE_STATUS                               uint32
-	COMPRESSED_TEXTURE_FORMATS                   int
-	CONSTANT_ALPHA                               int
-	CONSTANT_COLOR                               int
-	CONTEXT_LOST_WEBGL                           int
-	CULL_FACE                                    int
-	CULL_FACE_MODE                               int
-	CURRENT_PROGRAM                              int
-	CURRENT_VERTEX_ATTRIB                        int
-	CW                                           int
-	DECR                                         int
-	DECR_WRAP                                    int
-	DELETE_STATUS                                int
-	DEPTH_ATTACHMENT                             int
-	DEPTH_BITS                                   int
-	DEPTH_BUFFER_BIT                             int
-	DEPTH_CLEAR_VALUE                            int
-	DEPTH_COMPONENT                              int
-	DEPTH_COMPONENT16                            int
-	DEPTH_FUNC                                   int
-	DEPTH_RANGE                                  int
-	DEPTH_STENCIL                                int
-	DEPTH_STENCIL_ATTACHMENT                     int
-	DEPTH_TEST                                   int
-	DEPTH_WRITEMASK                              int
-	DITHER                                       int
-	DONT_CARE                                    int
-	DST_ALPHA                                    int
-	DST_COLOR                                    int
-	DYNAMIC_DRAW                                 int
-	ELEMENT_ARRAY_BUFFER                         int
-	ELEMENT_ARRAY_BUFFER_BINDING                 int
-	EQUAL                                        int
-	FASTEST                                      int
-	FLOAT                                        int
-	FLOAT_MAT2                                   int
-	FLOAT_MAT3                                   int
-	FLOAT_MAT4                                   int
-	FLOAT_VEC2                                   int
-	FLOAT_VEC3                                   int
-	FLOAT_VEC4                                   int
-	FRAGMENT_SHADER                              int
-	FRAMEBUFFER                                  int
-	FRAMEBUFFER_ATTACHMENT_OBJECT_NAME           int
-	FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE           int
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE int
-	FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL         int
-	FRAMEBUFFER_BINDING                          int
-	FRAMEBUFFER_COMPLETE                         int
-	FRAMEBUFFER_INCOMPLETE_ATTACHMENT            int
-	FRAMEBUFFER_INCOMPLETE_DIMENSIONS            int
-	FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT    int
-	FRAMEBUFFER_UNSUPPORTED                      int
-	FRONT                                        int
-	FRONT_AND_BACK                               int
-	FRONT_FACE                                   int
-	FUNC_ADD                                     int
-	FUNC_REVERSE_SUBTRACT                        int
-	FUNC_SUBTRACT                                int
-	GENERATE_MIPMAP_HINT                         int
-	GEQUAL                                       int
-	GREATER                                      int
-	GREEN_BITS                                   int
-	HIGH_FLOAT                                   int
-	HIGH_INT                                     int
-	INCR                                         int
-	INCR_WRAP                                    int
-	INFO_LOG_LENGTH                              uint32 //not supported!
-	INT                                          int
-	INT_VEC2                                     int
-	INT_VEC3                                     int
-	INT_VEC4                                     int
-	INVALID_ENUM                                 int
-	INVALID_FRAMEBUFFER_OPERATION                int
-	INVALID_OPERATION                            int
-	INVALID_VALUE                                int
-	INVERT                                       int
-	KEEP                                         int
-	LEQUAL                                       int
-	LESS                                         int
-	LINEAR                                       int
-	LINEAR_MIPMAP_LINEAR                         int
-	LINEAR_MIPMAP_NEAREST                        int
-	LINES                                        int
-	LINE_LOOP                                    int
-	LINE_STRIP                                   int
-	LINE_STIPPLE                                 int
-	LINE_WIDTH                                   int
-	LINK_STATUS                                  int
-	LOW_FLOAT                                    int
-	LOW_INT                                      int
-	LUMINANCE                                    int
-	LUMINANCE_ALPHA                              int
-	MAX_COMBINED_TEXTURE_IMAGE_UNITS             int
-	MAX_CUBE_MAP_TEXTURE_SIZE                    int
-	MAX_FRAGMENT_UNIFORM_VECTORS                 int
-	MAX_RENDERBUFFER_SIZE                        int
-	MAX_TEXTURE_IMAGE_UNITS                      int
-	MAX_TEXTURE_SIZE                             int
-	MAX_VARYING_VECTORS                          int
-	MAX_VERTEX_ATTRIBS                           int
-	MAX_VERTEX_TEXTURE_IMAGE_UNITS               int
-	MAX_VERTEX_UNIFORM_VECTORS                   int
-	MAX_VIEWPORT_DIMS                            int
-	MEDIUM_FLOAT                                 int
-	MEDIUM_INT                                   int
-	MIRRORED_REPEAT                              int
-	MULTISAMPLE                                  int // not supported!
-	NEAREST                                      int
-	NEAREST_MIPMAP_LINEAR                        int
-	NEAREST_MIPMAP_NEAREST                       int
-	NEVER                                        int
-	NICEST                                       int
-	NONE                                         int
-	NOTEQUAL                                     int
-	NO_ERROR                                     int
-	NUM_COMPRESSED_TEXTURE_FORMATS               int // not supported!
-	ONE                                          int
-	ONE_MINUS_CONSTANT_ALPHA                     int
-	ONE_MINUS_CONSTANT_COLOR                     int
-	ONE_MINUS_DST_ALPHA                          int
-	ONE_MINUS_DST_COLOR                          int
-	ONE_MINUS_SRC_ALPHA                          int
-	ONE_MINUS_SRC_COLOR                          int
-	OUT_OF_MEMORY                                int
-	PACK_ALIGNMENT                               int
-	POINTS                                       int
-	POLYGON_OFFSET_FACTOR                        int
-	POLYGON_OFFSET_FILL                          int
-	POLYGON_OFFSET_UNITS                         int
-	RED_BITS                                     int
-	RENDERBUFFER                                 int
-	RENDERBUFFER_ALPHA_SIZE                      int
-	RENDERBUFFER_BINDING                         int
-	RENDERBUFFER_BLUE_SIZE                       int
-	RENDERBUFFER_DEPTH_SIZE                      int
-	RENDERBUFFER_GREEN_SIZE                      int
-	RENDERBUFFER_HEIGHT                          int
-	RENDERBUFFER_INTERNAL_FORMAT                 int
-	RENDERBUFFER_RED_SIZE                        int
-	RENDERBUFFER_STENCIL_SIZE                    int
-	RENDERBUFFER_WIDTH                           int
-	RENDERER                                     int
-	REPEAT                                       int
-	REPLACE                                      int
-	RGB                                          int
-	RGB5_A1                                      int
-	RGB565                                       int
-	RGBA                                         int
-	RGBA4                                        int
-	RGBA8                                        int
-	SAMPLER_2D                                   int
-	SAMPLER_CUBE                                 int
-	SAMPLES                                      int
-	SAMPLE_ALPHA_TO_COVERAGE                     int
-	SAMPLE_BUFFERS                               int
-	SAMPLE_COVERAGE                              int
-	SAMPLE_COVERAGE_INVERT                       int
-	SAMPLE_COVERAGE_VALUE                        int
-	SCISSOR_BOX                                  int
-	SCISSOR_TEST                                 int
-	SHADER_COMPILER                              int // not supported!
-	SHADER_SOURCE_LENGTH                         int // not supported!
-	SHADER_TYPE                                  int
-	SHADING_LANGUAGE_VERSION                     int
-	SHORT                                        int
-	SRC_ALPHA                                    int
-	SRC_ALPHA_SATURATE                           int
-	SRC_COLOR                                    int
-	STATIC_DRAW                                  int
-	STENCIL_ATTACHMENT                           int
-	STENCIL_BACK_FAIL                            int
-	STENCIL_BACK_FUNC                            int
-	STENCIL_BACK_PASS_DEPTH_FAIL                 int
-	STENCIL_BACK_PASS_DEPTH_PASS                 int
-	STENCIL_BACK_REF                             int
-	STENCIL_BACK_VALUE_MASK                      int
-	STENCIL_BACK_WRITEMASK                       int
-	STENCIL_BITS                                 int
-	STENCIL_BUFFER_BIT                           int
-	STENCIL_CLEAR_VALUE                          int
-	STENCIL_FAIL                                 int
-	STENCIL_FUNC                                 int
-	STENCIL_INDEX8                               int
-	STENCIL_PASS_DEPTH_FAIL                      int
-	STENCIL_PASS_DEPTH_PASS                      int
-	STENCIL_REF                                  int
-	STENCIL_TEST                                 int
-	STENCIL_VALUE_MASK                           int
-	STENCIL_WRITEMASK                            int
-	STREAM_DRAW                                  int
-	SUBPIXEL_BITS                                int
-	TEXTURE                                      int
-	TEXTURE0                                     int
-	TEXTURE1                                     int
-	TEXTURE2                                     int
-	TEXTURE3                                     int
-	TEXTURE4                                     int
-	TEXTURE5                                     int
-	TEXTURE6                                     int
-	TEXTURE7                                     int
-	TEXTURE8                                     int
-	TEXTURE9                                     int
-	TEXTURE10                                    int
-	TEXTURE11                                    int
-	TEXTURE12                                    int
-	TEXTURE13                                    int
-	TEXTURE14                                    int
-	TEXTURE15                                    int
-	TEXTURE16                                    int
-	TEXTURE17                                    int
-	TEXTURE18                                    int
-	TEXTURE19                                    int
-	TEXTURE20                                    int
-	TEXTURE21                                    int
-	TEXTURE22                                    int
-	TEXTURE23                                    int
-	TEXTURE24                                    int
-	TEXTURE25                                    int
-	TEXTURE26                                    int
-	TEXTURE27                                    int
-	TEXTURE28                                    int
-	TEXTURE29                                    int
-	TEXTURE30                                    int
-	TEXTURE31                                    int
-	TEXTURE_2D                                   int
-	TEXTURE_BINDING_2D                           int
-	TEXTURE_BINDING_CUBE_MAP                     int
-	TEXTURE_CUBE_MAP                             int
-	TEXTURE_CUBE_MAP_NEGATIVE_X                  int
-	TEXTURE_CUBE_MAP_NEGATIVE_Y                  int
-	TEXTURE_CUBE_MAP_NEGATIVE_Z                  int
-	TEXTURE_CUBE_MAP_POSITIVE_X                  int
-	TEXTURE_CUBE_MAP_POSITIVE_Y                  int
-	TEXTURE_CUBE_MAP_POSITIVE_Z                  int
-	TEXTURE_MAG_FILTER                           int
-	TEXTURE_MIN_FILTER                           int
-	TEXTURE_WRAP_S                               int
-	TEXTURE_WRAP_T                               int
-	TRIANGLES                                    int
-	TRIANGLE_FAN                                 int
-	TRIANGLE_STRIP                               int
-	UNPACK_ALIGNMENT                             int
-	UNPACK_COLORSPACE_CONVERSION_WEBGL           int
-	UNPACK_FLIP_Y_WEBGL                          int
-	UNPACK_PREMULTIPLY_ALPHA_WEBGL               int
-	UNSIGNED_BYTE                                int
-	UNSIGNED_INT                                 int
-	UNSIGNED_SHORT                               int
-	UNSIGNED_SHORT_4_4_4_4                       int
-	UNSIGNED_SHORT_5_5_5_1                       int
-	UNSIGNED_SHORT_5_6_5                         int
-	VALIDATE_STATUS                              int
-	VENDOR                                       int // not supported!
-	VERSION                                      int
-	VERTEX_ATTRIB_ARRAY_BUFFER_BINDING           int
-	VERTEX_ATTRIB_ARRAY_ENABLED                  int
-	VERTEX_ATTRIB_ARRAY_NORMALIZED               int
-	VERTEX_ATTRIB_ARRAY_POINTER                  int
-	VERTEX_ATTRIB_ARRAY_SIZE                     int
-	VERTEX_ATTRIB_ARRAY_STRIDE                   int
-	VERTEX_ATTRIB_ARRAY_TYPE                     int
-	VERTEX_SHADER                                int
-	VIEWPORT                                     int
-	ZERO                                         int
-	TRUE                                         int
+	Constants
+
+	// enumError is set when a method was passed an unsupported constant, and
+	// reported as INVALID_ENUM by the next GetError.
+	enumError bool
+
+	// valueError is set when a method was passed values it didn't pass on to
+	// OpenGL, and reported as INVALID_VALUE by the next GetError.
+	valueError bool
 }
 
 // NewContext takes an HTML5 canvas object and optional context attributes.
@@ -377,6 +160,7 @@ func NewContext(canvas js.Value, ca *ContextAttributes) (*Context, error) {
 
 	ctx := new(Context)
 	ctx.InitialContextValues()
+	ctx.loadEnumNames()
 
 	if ca == nil {
 		ca = DefaultAttributes()
@@ -403,298 +187,7 @@ func NewContext(canvas js.Value, ca *ContextAttributes) (*Context, error) {
 // InitialContextValues sets up the context by retrieving the values from the
 // webgl context
 func (c *Context) InitialContextValues() {
-	webCtx := js.Global().Get("WebGLRenderingContext").Get("prototype")
-	c.ARRAY_BUFFER = webCtx.Get("ARRAY_BUFFER").Int()
-	c.ARRAY_BUFFER_BINDING = webCtx.Get("ARRAY_BUFFER_BINDING").Int()
-	c.ATTACHED_SHADERS = webCtx.Get("ATTACHED_SHADERS").Int()
-	c.BACK = webCtx.Get("BACK").Int()
-	c.BLEND = webCtx.Get("BLEND").Int()
-	c.BLEND_COLOR = webCtx.Get("BLEND_COLOR").Int()
-	c.BLEND_DST_ALPHA = webCtx.Get("BLEND_DST_ALPHA").Int()
-	c.BLEND_DST_RGB = webCtx.Get("BLEND_DST_RGB").Int()
-	c.BLEND_EQUATION = webCtx.Get("BLEND_EQUATION").Int()
-	c.BLEND_EQUATION_ALPHA = webCtx.Get("BLEND_EQUATION_ALPHA").Int()
-	c.BLEND_EQUATION_RGB = webCtx.Get("BLEND_EQUATION_RGB").Int()
-	c.BLEND_SRC_ALPHA = webCtx.Get("BLEND_SRC_ALPHA").Int()
-	c.BLEND_SRC_RGB = webCtx.Get("BLEND_SRC_RGB").Int()
-	c.BLUE_BITS = webCtx.Get("BLUE_BITS").Int()
-	c.BOOL = webCtx.Get("BOOL").Int()
-	c.BOOL_VEC2 = webCtx.Get("BOOL_VEC2").Int()
-	c.BOOL_VEC3 = webCtx.Get("BOOL_VEC3").Int()
-	c.BOOL_VEC4 = webCtx.Get("BOOL_VEC4").Int()
-	c.BROWSER_DEFAULT_WEBGL = webCtx.Get("BROWSER_DEFAULT_WEBGL").Int()
-	c.BUFFER_SIZE = webCtx.Get("BUFFER_SIZE").Int()
-	c.BUFFER_USAGE = webCtx.Get("BUFFER_USAGE").Int()
-	c.BYTE = webCtx.Get("BYTE").Int()
-	c.CCW = webCtx.Get("CCW").Int()
-	c.CLAMP_TO_EDGE = webCtx.Get("CLAMP_TO_EDGE").Int()
-	c.CLAMP_TO_BORDER = webCtx.Get("CLAMP_TO_EDGE").Int()
-	c.COLOR_ATTACHMENT0 = webCtx.Get("COLOR_ATTACHMENT0").Int()
-	c.COLOR_BUFFER_BIT = webCtx.Get("COLOR_BUFFER_BIT").Int()
-	c.COLOR_CLEAR_VALUE = webCtx.Get("COLOR_CLEAR_VALUE").Int()
-	c.COLOR_WRITEMASK = webCtx.Get("COLOR_WRITEMASK").Int()
-	c.COMPILE_STATUS = uint32(webCtx.Get("COMPILE_STATUS").Int())
-	c.COMPRESSED_TEXTURE_FORMATS = webCtx.Get("COMPRESSED_TEXTURE_FORMATS").Int()
-	c.CONSTANT_ALPHA = webCtx.Get("CONSTANT_ALPHA").Int()
-	c.CONSTANT_COLOR = webCtx.Get("CONSTANT_COLOR").Int()
-	c.CONTEXT_LOST_WEBGL = webCtx.Get("CONTEXT_LOST_WEBGL").Int()
-	c.CULL_FACE = webCtx.Get("CULL_FACE").Int()
-	c.CULL_FACE_MODE = webCtx.Get("CULL_FACE_MODE").Int()
-	c.CURRENT_PROGRAM = webCtx.Get("CURRENT_PROGRAM").Int()
-	c.CURRENT_VERTEX_ATTRIB = webCtx.Get("CURRENT_VERTEX_ATTRIB").Int()
-	c.CW = webCtx.Get("CW").Int()
-	c.DECR = webCtx.Get("DECR").Int()
-	c.DECR_WRAP = webCtx.Get("DECR_WRAP").Int()
-	c.DELETE_STATUS = webCtx.Get("DELETE_STATUS").Int()
-	c.DEPTH_ATTACHMENT = webCtx.Get("DEPTH_ATTACHMENT").Int()
-	c.DEPTH_BITS = webCtx.Get("DEPTH_BITS").Int()
-	c.DEPTH_BUFFER_BIT = webCtx.Get("DEPTH_BUFFER_BIT").Int()
-	c.DEPTH_CLEAR_VALUE = webCtx.Get("DEPTH_CLEAR_VALUE").Int()
-	c.DEPTH_COMPONENT = webCtx.Get("DEPTH_COMPONENT").Int()
-	c.DEPTH_COMPONENT16 = webCtx.Get("DEPTH_COMPONENT16").Int()
-	c.DEPTH_FUNC = webCtx.Get("DEPTH_FUNC").Int()
-	c.DEPTH_RANGE = webCtx.Get("DEPTH_RANGE").Int()
-	c.DEPTH_STENCIL = webCtx.Get("DEPTH_STENCIL").Int()
-	c.DEPTH_STENCIL_ATTACHMENT = webCtx.Get("DEPTH_STENCIL_ATTACHMENT").Int()
-	c.DEPTH_TEST = webCtx.Get("DEPTH_TEST").Int()
-	c.DEPTH_WRITEMASK = webCtx.Get("DEPTH_WRITEMASK").Int()
-	c.DITHER = webCtx.Get("DITHER").Int()
-	c.DONT_CARE = webCtx.Get("DONT_CARE").Int()
-	c.DST_ALPHA = webCtx.Get("DST_ALPHA").Int()
-	c.DST_COLOR = webCtx.Get("DST_COLOR").Int()
-	c.DYNAMIC_DRAW = webCtx.Get("DYNAMIC_DRAW").Int()
-	c.ELEMENT_ARRAY_BUFFER = webCtx.Get("ELEMENT_ARRAY_BUFFER").Int()
-	c.ELEMENT_ARRAY_BUFFER_BINDING = webCtx.Get("ELEMENT_ARRAY_BUFFER_BINDING").Int()
-	c.EQUAL = webCtx.Get("EQUAL").Int()
-	c.FASTEST = webCtx.Get("FASTEST").Int()
-	c.FLOAT = webCtx.Get("FLOAT").Int()
-	c.FLOAT_MAT2 = webCtx.Get("FLOAT_MAT2").Int()
-	c.FLOAT_MAT3 = webCtx.Get("FLOAT_MAT3").Int()
-	c.FLOAT_MAT4 = webCtx.Get("FLOAT_MAT4").Int()
-	c.FLOAT_VEC2 = webCtx.Get("FLOAT_VEC2").Int()
-	c.FLOAT_VEC3 = webCtx.Get("FLOAT_VEC3").Int()
-	c.FLOAT_VEC4 = webCtx.Get("FLOAT_VEC4").Int()
-	c.FRAGMENT_SHADER = webCtx.Get("FRAGMENT_SHADER").Int()
-	c.FRAMEBUFFER = webCtx.Get("FRAMEBUFFER").Int()
-	c.FRAMEBUFFER_ATTACHMENT_OBJECT_NAME = webCtx.Get("FRAMEBUFFER_ATTACHMENT_OBJECT_NAME").Int()
-	c.FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE = webCtx.Get("FRAMEBUFFER_ATTACHMENT_OBJECT_TYPE").Int()
-	c.FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE = webCtx.Get("FRAMEBUFFER_ATTACHMENT_TEXTURE_CUBE_MAP_FACE").Int()
-	c.FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL = webCtx.Get("FRAMEBUFFER_ATTACHMENT_TEXTURE_LEVEL").Int()
-	c.FRAMEBUFFER_BINDING = webCtx.Get("FRAMEBUFFER_BINDING").Int()
-	c.FRAMEBUFFER_COMPLETE = webCtx.Get("FRAMEBUFFER_COMPLETE").Int()
-	c.FRAMEBUFFER_INCOMPLETE_ATTACHMENT = webCtx.Get("FRAMEBUFFER_INCOMPLETE_ATTACHMENT").Int()
-	c.FRAMEBUFFER_INCOMPLETE_DIMENSIONS = webCtx.Get("FRAMEBUFFER_INCOMPLETE_DIMENSIONS").Int()
-	c.FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT = webCtx.Get("FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT").Int()
-	c.FRAMEBUFFER_UNSUPPORTED = webCtx.Get("FRAMEBUFFER_UNSUPPORTED").Int()
-	c.FRONT = webCtx.Get("FRONT").Int()
-	c.FRONT_AND_BACK = webCtx.Get("FRONT_AND_BACK").Int()
-	c.FRONT_FACE = webCtx.Get("FRONT_FACE").Int()
-	c.FUNC_ADD = webCtx.Get("FUNC_ADD").Int()
-	c.FUNC_REVERSE_SUBTRACT = webCtx.Get("FUNC_REVERSE_SUBTRACT").Int()
-	c.FUNC_SUBTRACT = webCtx.Get("FUNC_SUBTRACT").Int()
-	c.GENERATE_MIPMAP_HINT = webCtx.Get("GENERATE_MIPMAP_HINT").Int()
-	c.GEQUAL = webCtx.Get("GEQUAL").Int()
-	c.GREATER = webCtx.Get("GREATER").Int()
-	c.GREEN_BITS = webCtx.Get("GREEN_BITS").Int()
-	c.HIGH_FLOAT = webCtx.Get("HIGH_FLOAT").Int()
-	c.HIGH_INT = webCtx.Get("HIGH_INT").Int()
-	c.INCR = webCtx.Get("INCR").Int()
-	c.INCR_WRAP = webCtx.Get("INCR_WRAP").Int()
-	c.INFO_LOG_LENGTH = uint32(0)
-	c.INT = webCtx.Get("INT").Int()
-	c.INT_VEC2 = webCtx.Get("INT_VEC2").Int()
-	c.INT_VEC3 = webCtx.Get("INT_VEC3").Int()
-	c.INT_VEC4 = webCtx.Get("INT_VEC4").Int()
-	c.INVALID_ENUM = webCtx.Get("INVALID_ENUM").Int()
-	c.INVALID_FRAMEBUFFER_OPERATION = webCtx.Get("INVALID_FRAMEBUFFER_OPERATION").Int()
-	c.INVALID_OPERATION = webCtx.Get("INVALID_OPERATION").Int()
-	c.INVALID_VALUE = webCtx.Get("INVALID_VALUE").Int()
-	c.INVERT = webCtx.Get("INVERT").Int()
-	c.KEEP = webCtx.Get("KEEP").Int()
-	c.LEQUAL = webCtx.Get("LEQUAL").Int()
-	c.LESS = webCtx.Get("LESS").Int()
-	c.LINEAR = webCtx.Get("LINEAR").Int()
-	c.LINEAR_MIPMAP_LINEAR = webCtx.Get("LINEAR_MIPMAP_LINEAR").Int()
-	c.LINEAR_MIPMAP_NEAREST = webCtx.Get("LINEAR_MIPMAP_NEAREST").Int()
-	c.LINES = webCtx.Get("LINES").Int()
-	c.LINE_LOOP = webCtx.Get("LINE_LOOP").Int()
-	c.LINE_STRIP = webCtx.Get("LINE_STRIP").Int()
-	c.LINE_WIDTH = webCtx.Get("LINE_WIDTH").Int()
-	c.LINK_STATUS = webCtx.Get("LINK_STATUS").Int()
-	c.LOW_FLOAT = webCtx.Get("LOW_FLOAT").Int()
-	c.LOW_INT = webCtx.Get("LOW_INT").Int()
-	c.LUMINANCE = webCtx.Get("LUMINANCE").Int()
-	c.LUMINANCE_ALPHA = webCtx.Get("LUMINANCE_ALPHA").Int()
-	c.MAX_COMBINED_TEXTURE_IMAGE_UNITS = webCtx.Get("MAX_COMBINED_TEXTURE_IMAGE_UNITS").Int()
-	c.MAX_CUBE_MAP_TEXTURE_SIZE = webCtx.Get("MAX_CUBE_MAP_TEXTURE_SIZE").Int()
-	c.MAX_FRAGMENT_UNIFORM_VECTORS = webCtx.Get("MAX_FRAGMENT_UNIFORM_VECTORS").Int()
-	c.MAX_RENDERBUFFER_SIZE = webCtx.Get("MAX_RENDERBUFFER_SIZE").Int()
-	c.MAX_TEXTURE_IMAGE_UNITS = webCtx.Get("MAX_TEXTURE_IMAGE_UNITS").Int()
-	c.MAX_TEXTURE_SIZE = webCtx.Get("MAX_TEXTURE_SIZE").Int()
-	c.MAX_VARYING_VECTORS = webCtx.Get("MAX_VARYING_VECTORS").Int()
-	c.MAX_VERTEX_ATTRIBS = webCtx.Get("MAX_VERTEX_ATTRIBS").Int()
-	c.MAX_VERTEX_TEXTURE_IMAGE_UNITS = webCtx.Get("MAX_VERTEX_TEXTURE_IMAGE_UNITS").Int()
-	c.MAX_VERTEX_UNIFORM_VECTORS = webCtx.Get("MAX_VERTEX_UNIFORM_VECTORS").Int()
-	c.MAX_VIEWPORT_DIMS = webCtx.Get("MAX_VIEWPORT_DIMS").Int()
-	c.MEDIUM_FLOAT = webCtx.Get("MEDIUM_FLOAT").Int()
-	c.MEDIUM_INT = webCtx.Get("MEDIUM_INT").Int()
-	c.MIRRORED_REPEAT = webCtx.Get("MIRRORED_REPEAT").Int()
-	c.MULTISAMPLE = 0
-	c.NEAREST = webCtx.Get("NEAREST").Int()
-	c.NEAREST_MIPMAP_LINEAR = webCtx.Get("NEAREST_MIPMAP_LINEAR").Int()
-	c.NEAREST_MIPMAP_NEAREST = webCtx.Get("NEAREST_MIPMAP_NEAREST").Int()
-	c.NEVER = webCtx.Get("NEVER").Int()
-	c.NICEST = webCtx.Get("NICEST").Int()
-	c.NONE = webCtx.Get("NONE").Int()
-	c.NOTEQUAL = webCtx.Get("NOTEQUAL").Int()
-	c.NO_ERROR = webCtx.Get("NO_ERROR").Int()
-	c.NUM_COMPRESSED_TEXTURE_FORMATS = 0
-	c.ONE = webCtx.Get("ONE").Int()
-	c.ONE_MINUS_CONSTANT_ALPHA = webCtx.Get("ONE_MINUS_CONSTANT_ALPHA").Int()
-	c.ONE_MINUS_CONSTANT_COLOR = webCtx.Get("ONE_MINUS_CONSTANT_COLOR").Int()
-	c.ONE_MINUS_DST_ALPHA = webCtx.Get("ONE_MINUS_DST_ALPHA").Int()
-	c.ONE_MINUS_DST_COLOR = webCtx.Get("ONE_MINUS_DST_COLOR").Int()
-	c.ONE_MINUS_SRC_ALPHA = webCtx.Get("ONE_MINUS_SRC_ALPHA").Int()
-	c.ONE_MINUS_SRC_COLOR = webCtx.Get("ONE_MINUS_SRC_COLOR").Int()
-	c.OUT_OF_MEMORY = webCtx.Get("OUT_OF_MEMORY").Int()
-	c.PACK_ALIGNMENT = webCtx.Get("PACK_ALIGNMENT").Int()
-	c.POINTS = webCtx.Get("POINTS").Int()
-	c.POLYGON_OFFSET_FACTOR = webCtx.Get("POLYGON_OFFSET_FACTOR").Int()
-	c.POLYGON_OFFSET_FILL = webCtx.Get("POLYGON_OFFSET_FILL").Int()
-	c.POLYGON_OFFSET_UNITS = webCtx.Get("POLYGON_OFFSET_UNITS").Int()
-	c.RED_BITS = webCtx.Get("RED_BITS").Int()
-	c.RENDERBUFFER = webCtx.Get("RENDERBUFFER").Int()
-	c.RENDERBUFFER_ALPHA_SIZE = webCtx.Get("RENDERBUFFER_ALPHA_SIZE").Int()
-	c.RENDERBUFFER_BINDING = webCtx.Get("RENDERBUFFER_BINDING").Int()
-	c.RENDERBUFFER_BLUE_SIZE = webCtx.Get("RENDERBUFFER_BLUE_SIZE").Int()
-	c.RENDERBUFFER_DEPTH_SIZE = webCtx.Get("RENDERBUFFER_DEPTH_SIZE").Int()
-	c.RENDERBUFFER_GREEN_SIZE = webCtx.Get("RENDERBUFFER_GREEN_SIZE").Int()
-	c.RENDERBUFFER_HEIGHT = webCtx.Get("RENDERBUFFER_HEIGHT").Int()
-	c.RENDERBUFFER_INTERNAL_FORMAT = webCtx.Get("RENDERBUFFER_INTERNAL_FORMAT").Int()
-	c.RENDERBUFFER_RED_SIZE = webCtx.Get("RENDERBUFFER_RED_SIZE").Int()
-	c.RENDERBUFFER_STENCIL_SIZE = webCtx.Get("RENDERBUFFER_STENCIL_SIZE").Int()
-	c.RENDERBUFFER_WIDTH = webCtx.Get("RENDERBUFFER_WIDTH").Int()
-	c.RENDERER = webCtx.Get("RENDERER").Int()
-	c.REPEAT = webCtx.Get("REPEAT").Int()
-	c.REPLACE = webCtx.Get("REPLACE").Int()
-	c.RGB = webCtx.Get("RGB").Int()
-	c.RGB5_A1 = webCtx.Get("RGB5_A1").Int()
-	c.RGB565 = webCtx.Get("RGB565").Int()
-	c.RGBA = webCtx.Get("RGBA").Int()
-	c.RGBA4 = webCtx.Get("RGBA4").Int()
-	c.RGBA8 = webCtx.Get("RGBA4").Int() // use RGBA8 when available.
-	c.SAMPLER_2D = webCtx.Get("SAMPLER_2D").Int()
-	c.SAMPLER_CUBE = webCtx.Get("SAMPLER_CUBE").Int()
-	c.SAMPLES = webCtx.Get("SAMPLES").Int()
-	c.SAMPLE_ALPHA_TO_COVERAGE = webCtx.Get("SAMPLE_ALPHA_TO_COVERAGE").Int()
-	c.SAMPLE_BUFFERS = webCtx.Get("SAMPLE_BUFFERS").Int()
-	c.SAMPLE_COVERAGE = webCtx.Get("SAMPLE_COVERAGE").Int()
-	c.SAMPLE_COVERAGE_INVERT = webCtx.Get("SAMPLE_COVERAGE_INVERT").Int()
-	c.SAMPLE_COVERAGE_VALUE = webCtx.Get("SAMPLE_COVERAGE_VALUE").Int()
-	c.SCISSOR_BOX = webCtx.Get("SCISSOR_BOX").Int()
-	c.SCISSOR_TEST = webCtx.Get("SCISSOR_TEST").Int()
-	c.SHADER_TYPE = webCtx.Get("SHADER_TYPE").Int()
-	c.SHADING_LANGUAGE_VERSION = webCtx.Get("SHADING_LANGUAGE_VERSION").Int()
-	c.SHORT = webCtx.Get("SHORT").Int()
-	c.SRC_ALPHA = webCtx.Get("SRC_ALPHA").Int()
-	c.SRC_ALPHA_SATURATE = webCtx.Get("SRC_ALPHA_SATURATE").Int()
-	c.SRC_COLOR = webCtx.Get("SRC_COLOR").Int()
-	c.STATIC_DRAW = webCtx.Get("STATIC_DRAW").Int()
-	c.STENCIL_ATTACHMENT = webCtx.Get("STENCIL_ATTACHMENT").Int()
-	c.STENCIL_BACK_FAIL = webCtx.Get("STENCIL_BACK_FAIL").Int()
-	c.STENCIL_BACK_FUNC = webCtx.Get("STENCIL_BACK_FUNC").Int()
-	c.STENCIL_BACK_PASS_DEPTH_FAIL = webCtx.Get("STENCIL_BACK_PASS_DEPTH_FAIL").Int()
-	c.STENCIL_BACK_PASS_DEPTH_PASS = webCtx.Get("STENCIL_BACK_PASS_DEPTH_PASS").Int()
-	c.STENCIL_BACK_REF = webCtx.Get("STENCIL_BACK_REF").Int()
-	c.STENCIL_BACK_VALUE_MASK = webCtx.Get("STENCIL_BACK_VALUE_MASK").Int()
-	c.STENCIL_BACK_WRITEMASK = webCtx.Get("STENCIL_BACK_WRITEMASK").Int()
-	c.STENCIL_BITS = webCtx.Get("STENCIL_BITS").Int()
-	c.STENCIL_BUFFER_BIT = webCtx.Get("STENCIL_BUFFER_BIT").Int()
-	c.STENCIL_CLEAR_VALUE = webCtx.Get("STENCIL_CLEAR_VALUE").Int()
-	c.STENCIL_FAIL = webCtx.Get("STENCIL_FAIL").Int()
-	c.STENCIL_FUNC = webCtx.Get("STENCIL_FUNC").Int()
-	c.STENCIL_INDEX8 = webCtx.Get("STENCIL_INDEX8").Int()
-	c.STENCIL_PASS_DEPTH_FAIL = webCtx.Get("STENCIL_PASS_DEPTH_FAIL").Int()
-	c.STENCIL_PASS_DEPTH_PASS = webCtx.Get("STENCIL_PASS_DEPTH_PASS").Int()
-	c.STENCIL_REF = webCtx.Get("STENCIL_REF").Int()
-	c.STENCIL_TEST = webCtx.Get("STENCIL_TEST").Int()
-	c.STENCIL_VALUE_MASK = webCtx.Get("STENCIL_VALUE_MASK").Int()
-	c.STENCIL_WRITEMASK = webCtx.Get("STENCIL_WRITEMASK").Int()
-	c.STREAM_DRAW = webCtx.Get("STREAM_DRAW").Int()
-	c.SUBPIXEL_BITS = webCtx.Get("SUBPIXEL_BITS").Int()
-	c.TEXTURE = webCtx.Get("TEXTURE").Int()
-	c.TEXTURE0 = webCtx.Get("TEXTURE0").Int()
-	c.TEXTURE1 = webCtx.Get("TEXTURE1").Int()
-	c.TEXTURE2 = webCtx.Get("TEXTURE2").Int()
-	c.TEXTURE3 = webCtx.Get("TEXTURE3").Int()
-	c.TEXTURE4 = webCtx.Get("TEXTURE4").Int()
-	c.TEXTURE5 = webCtx.Get("TEXTURE5").Int()
-	c.TEXTURE6 = webCtx.Get("TEXTURE6").Int()
-	c.TEXTURE7 = webCtx.Get("TEXTURE7").Int()
-	c.TEXTURE8 = webCtx.Get("TEXTURE8").Int()
-	c.TEXTURE9 = webCtx.Get("TEXTURE9").Int()
-	c.TEXTURE10 = webCtx.Get("TEXTURE10").Int()
-	c.TEXTURE11 = webCtx.Get("TEXTURE11").Int()
-	c.TEXTURE12 = webCtx.Get("TEXTURE12").Int()
-	c.TEXTURE13 = webCtx.Get("TEXTURE13").Int()
-	c.TEXTURE14 = webCtx.Get("TEXTURE14").Int()
-	c.TEXTURE15 = webCtx.Get("TEXTURE15").Int()
-	c.TEXTURE16 = webCtx.Get("TEXTURE16").Int()
-	c.TEXTURE17 = webCtx.Get("TEXTURE17").Int()
-	c.TEXTURE18 = webCtx.Get("TEXTURE18").Int()
-	c.TEXTURE19 = webCtx.Get("TEXTURE19").Int()
-	c.TEXTURE20 = webCtx.Get("TEXTURE20").Int()
-	c.TEXTURE21 = webCtx.Get("TEXTURE21").Int()
-	c.TEXTURE22 = webCtx.Get("TEXTURE22").Int()
-	c.TEXTURE23 = webCtx.Get("TEXTURE23").Int()
-	c.TEXTURE24 = webCtx.Get("TEXTURE24").Int()
-	c.TEXTURE25 = webCtx.Get("TEXTURE25").Int()
-	c.TEXTURE26 = webCtx.Get("TEXTURE26").Int()
-	c.TEXTURE27 = webCtx.Get("TEXTURE27").Int()
-	c.TEXTURE28 = webCtx.Get("TEXTURE28").Int()
-	c.TEXTURE29 = webCtx.Get("TEXTURE29").Int()
-	c.TEXTURE30 = webCtx.Get("TEXTURE30").Int()
-	c.TEXTURE31 = webCtx.Get("TEXTURE31").Int()
-	c.TEXTURE_2D = webCtx.Get("TEXTURE_2D").Int()
-	c.TEXTURE_BINDING_2D = webCtx.Get("TEXTURE_BINDING_2D").Int()
-	c.TEXTURE_BINDING_CUBE_MAP = webCtx.Get("TEXTURE_BINDING_CUBE_MAP").Int()
-	c.TEXTURE_CUBE_MAP = webCtx.Get("TEXTURE_CUBE_MAP").Int()
-	c.TEXTURE_CUBE_MAP_NEGATIVE_X = webCtx.Get("TEXTURE_CUBE_MAP_NEGATIVE_X").Int()
-	c.TEXTURE_CUBE_MAP_NEGATIVE_Y = webCtx.Get("TEXTURE_CUBE_MAP_NEGATIVE_Y").Int()
-	c.TEXTURE_CUBE_MAP_NEGATIVE_Z = webCtx.Get("TEXTURE_CUBE_MAP_NEGATIVE_Z").Int()
-	c.TEXTURE_CUBE_MAP_POSITIVE_X = webCtx.Get("TEXTURE_CUBE_MAP_POSITIVE_X").Int()
-	c.TEXTURE_CUBE_MAP_POSITIVE_Y = webCtx.Get("TEXTURE_CUBE_MAP_POSITIVE_Y").Int()
-	c.TEXTURE_CUBE_MAP_POSITIVE_Z = webCtx.Get("TEXTURE_CUBE_MAP_POSITIVE_Z").Int()
-	c.TEXTURE_MAG_FILTER = webCtx.Get("TEXTURE_MAG_FILTER").Int()
-	c.TEXTURE_MIN_FILTER = webCtx.Get("TEXTURE_MIN_FILTER").Int()
-	c.TEXTURE_WRAP_S = webCtx.Get("TEXTURE_WRAP_S").Int()
-	c.TEXTURE_WRAP_T = webCtx.Get("TEXTURE_WRAP_T").Int()
-	c.TRIANGLES = webCtx.Get("TRIANGLES").Int()
-	c.TRIANGLE_FAN = webCtx.Get("TRIANGLE_FAN").Int()
-	c.TRIANGLE_STRIP = webCtx.Get("TRIANGLE_STRIP").Int()
-	c.UNPACK_ALIGNMENT = webCtx.Get("UNPACK_ALIGNMENT").Int()
-	c.UNPACK_COLORSPACE_CONVERSION_WEBGL = webCtx.Get("UNPACK_COLORSPACE_CONVERSION_WEBGL").Int()
-	c.UNPACK_FLIP_Y_WEBGL = webCtx.Get("UNPACK_FLIP_Y_WEBGL").Int()
-	c.UNPACK_PREMULTIPLY_ALPHA_WEBGL = webCtx.Get("UNPACK_PREMULTIPLY_ALPHA_WEBGL").Int()
-	c.UNSIGNED_BYTE = webCtx.Get("UNSIGNED_BYTE").Int()
-	c.UNSIGNED_INT = webCtx.Get("UNSIGNED_INT").Int()
-	c.UNSIGNED_SHORT = webCtx.Get("UNSIGNED_SHORT").Int()
-	c.UNSIGNED_SHORT_4_4_4_4 = webCtx.Get("UNSIGNED_SHORT_4_4_4_4").Int()
-	c.UNSIGNED_SHORT_5_5_5_1 = webCtx.Get("UNSIGNED_SHORT_5_5_5_1").Int()
-	c.UNSIGNED_SHORT_5_6_5 = webCtx.Get("UNSIGNED_SHORT_5_6_5").Int()
-	c.VALIDATE_STATUS = webCtx.Get("VALIDATE_STATUS").Int()
-	c.VERSION = webCtx.Get("VERSION").Int()
-	c.VERTEX_ATTRIB_ARRAY_BUFFER_BINDING = webCtx.Get("VERTEX_ATTRIB_ARRAY_BUFFER_BINDING").Int()
-	c.VERTEX_ATTRIB_ARRAY_ENABLED = webCtx.Get("VERTEX_ATTRIB_ARRAY_ENABLED").Int()
-	c.VERTEX_ATTRIB_ARRAY_NORMALIZED = webCtx.Get("VERTEX_ATTRIB_ARRAY_NORMALIZED").Int()
-	c.VERTEX_ATTRIB_ARRAY_POINTER = webCtx.Get("VERTEX_ATTRIB_ARRAY_POINTER").Int()
-	c.VERTEX_ATTRIB_ARRAY_SIZE = webCtx.Get("VERTEX_ATTRIB_ARRAY_SIZE").Int()
-	c.VERTEX_ATTRIB_ARRAY_STRIDE = webCtx.Get("VERTEX_ATTRIB_ARRAY_STRIDE").Int()
-	c.VERTEX_ATTRIB_ARRAY_TYPE = webCtx.Get("VERTEX_ATTRIB_ARRAY_TYPE").Int()
-	c.VERTEX_SHADER = webCtx.Get("VERTEX_SHADER").Int()
-	c.VIEWPORT = webCtx.Get("VIEWPORT").Int()
-	c.ZERO = webCtx.Get("ZERO").Int()
-	c.TRUE = 1
+	c.Constants = newConstants()
 }
 
 // Returns the context attributes active on the context. These values might
@@ -715,52 +208,61 @@ func (c *Context) GetContextAttributes() ContextAttributes {
 // PerFragment ---------------------------------------------------------------
 
 // The GL_BLEND_COLOR may be used to calculate the source and destination blending factors.
-func (c *Context) BlendColor(r, g, b, a float64) {
+func (c *Context) BlendColor(r, g, b, a float32) {
 	c.Call("blendColor", r, g, b, a)
 }
 
 // Sets the equation used to blend RGB and Alpha values of an incoming source
 // fragment with a destination values as stored in the fragment's frame buffer.
-func (c *Context) BlendEquation(mode int) {
-	c.Call("blendEquation", mode)
+func (c *Context) BlendEquation(mode Enum) {
+	c.Call("blendEquation", int(mode))
 }
 
 // Controls the blending of an incoming source fragment's R, G, B, and A values
 // with a destination R, G, B, and A values as stored in the fragment's WebGLFramebuffer.
-func (c *Context) BlendEquationSeparate(modeRGB, modeAlpha int) {
-	c.Call("blendEquationSeparate", modeRGB, modeAlpha)
+func (c *Context) BlendEquationSeparate(modeRGB, modeAlpha Enum) {
+	c.Call("blendEquationSeparate", int(modeRGB), int(modeAlpha))
 }
 
 // Sets the blending factors used to combine source and destination pixels.
-func (c *Context) BlendFunc(sfactor, dfactor int) {
-	c.Call("blendFunc", sfactor, dfactor)
+func (c *Context) BlendFunc(sfactor, dfactor Enum) {
+	c.Call("blendFunc", int(sfactor), int(dfactor))
 }
 
 // Sets the weighting factors that are used by blendEquationSeparate.
-func (c *Context) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha int) {
-	c.Call("blendFuncSeparate", srcRGB, dstRGB, srcAlpha, dstAlpha)
+func (c *Context) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha Enum) {
+	c.Call("blendFuncSeparate", int(srcRGB), int(dstRGB), int(srcAlpha), int(dstAlpha))
 }
 
 // Sets a function to use to compare incoming pixel depth to the
 // current depth buffer value.
-func (c *Context) DepthFunc(fun int) {
-	c.Call("depthFunc", fun)
+func (c *Context) DepthFunc(fun Enum) {
+	c.Call("depthFunc", int(fun))
 }
 
-func (c *Context) SampleCoverage(value float64, invert bool) {
+func (c *Context) SampleCoverage(value float32, invert bool) {
 	c.Call("sampleCoverage", value, invert)
 }
 
-func (c *Context) StencilFunc(function, ref, mask int) {
-	c.Call("stencilFunc", function, ref, mask)
+// Sets the front and back function and reference value for stencil testing.
+func (c *Context) StencilFunc(function Enum, ref, mask int) {
+	c.Call("stencilFunc", int(function), ref, mask)
+}
+
+// Sets the front and/or back function and reference value for stencil testing.
+func (c *Context) StencilFuncSeparate(face, function Enum, ref, mask int) {
+	c.Call("stencilFuncSeparate", int(face), int(function), ref, mask)
 }
 
-func (c *Context) StencilFuncSeparate(face, function, ref, mask int) {
-	c.Call("stencilFuncSeparate", face, function, ref, mask)
+// Sets both the front and back-facing stencil test actions.
+func (c *Context) StencilOp(fail, zfail, zpass Enum) {
+	c.Call("stencilOp", int(fail), int(zfail), int(zpass))
 }
 
-// public function stencilOp(fail:GLenum, zfail:GLenum, zpass:GLenum) : Void;
-// public function stencilOpSeparate(face:GLenum, fail:GLenum, zfail:GLenum, zpass:GLenum) : Void;
+// Sets the front and/or back-facing stencil test actions.
+func (c *Context) StencilOpSeparate(face, fail, zfail, zpass Enum) {
+	c.Call("stencilOpSeparate", int(face), int(fail), int(zfail), int(zpass))
+}
 
 // FrameBuffer
 
@@ -777,31 +279,31 @@ func (c *Context) BindAttribLocation(program *Program, index int, name string) {
 }
 
 // Associates a buffer with a buffer target.
-func (c *Context) BindBuffer(target int, buffer *Buffer) {
+func (c *Context) BindBuffer(target Enum, buffer *Buffer) {
 	if buffer == nil {
-		c.Call("bindBuffer", target, nil)
+		c.Call("bindBuffer", int(target), nil)
 		return
 	}
-	c.Call("bindBuffer", target, buffer.Value)
+	c.Call("bindBuffer", int(target), buffer.Value)
 }
 
 // Binds a named texture object to a target.
-func (c *Context) BindTexture(target int, texture *Texture) {
+func (c *Context) BindTexture(target Enum, texture *Texture) {
 	if texture == nil {
-		c.Call("bindTexture", target, nil)
+		c.Call("bindTexture", int(target), nil)
 		return
 	}
-	c.Call("bindTexture", target, texture.Value)
+	c.Call("bindTexture", int(target), texture.Value)
 }
 
 // Select active texture unit
-func (c *Context) ActiveTexture(target int) {
-	c.Call("activeTexture", target)
+func (c *Context) ActiveTexture(target Enum) {
+	c.Call("activeTexture", int(target))
 }
 
 // Creates a buffer in memory and initializes it with array data.
 // If no array is provided, the contents of the buffer is initialized to 0.
-func (c *Context) BufferData(target int, data interface{}, usage int) {
+func (c *Context) BufferData(target Enum, data interface{}, usage Enum) {
 	var val js.Value
 	switch d := data.(type) {
 	case []uint8:
@@ -835,11 +337,11 @@ func (c *Context) BufferData(target int, data interface{}, usage int) {
 		js.CopyBytesToJS(uint8arr, bs)
 		val = js.Global().Get("Float32Array").New(uint8arr.Get("buffer"), uint8arr.Get("byteoffset"), l)
 	}
-	c.Call("bufferData", target, val, usage)
+	c.Call("bufferData", int(target), val, int(usage))
 }
 
 // Used to modify or update some or all of a data store for a bound buffer object.
-func (c *Context) BufferSubData(target int, offset int, data interface{}) {
+func (c *Context) BufferSubData(target Enum, offset int, data interface{}) {
 	var val js.Value
 	switch d := data.(type) {
 	case []uint8:
@@ -873,18 +375,18 @@ func (c *Context) BufferSubData(target int, offset int, data interface{}) {
 		js.CopyBytesToJS(uint8arr, bs)
 		val = js.Global().Get("Float32Array").New(uint8arr.Get("buffer"), uint8arr.Get("byteoffset"), l)
 	}
-	c.Call("bufferSubData", target, offset, val)
+	c.Call("bufferSubData", int(target), offset, val)
 }
 
 // Returns whether the currently bound WebGLFramebuffer is complete.
 // If not complete, returns the reason why.
-func (c *Context) CheckFramebufferStatus(target int) int {
-	return c.Call("checkFramebufferStatus", target).Int()
+func (c *Context) CheckFramebufferStatus(target Enum) Enum {
+	return Enum(c.Call("checkFramebufferStatus", int(target)).Int())
 }
 
 // Sets all pixels in a specific buffer to the same value.
-func (c *Context) Clear(flags int) {
-	c.Call("clear", flags)
+func (c *Context) Clear(flags Enum) {
+	c.Call("clear", int(flags))
 }
 
 // Specifies color values to use by the clear method to clear the color buffer.
@@ -893,10 +395,11 @@ func (c *Context) ClearColor(r, g, b, a float32) {
 }
 
 // Clears the depth buffer to a specific value.
-func (c *Context) ClearDepth(depth float64) {
+func (c *Context) ClearDepth(depth float32) {
 	c.Call("clearDepth", depth)
 }
 
+// Specifies the stencil index used by the clear method to clear the stencil buffer.
 func (c *Context) ClearStencil(s int) {
 	c.Call("clearStencil", s)
 }
@@ -908,24 +411,19 @@ func (c *Context) ColorMask(r, g, b, a bool) {
 }
 
 // Compiles the GLSL shader source into binary data used by the WebGLProgram object.
+// Use CompileShaderErr to find out whether compilation failed.
 func (c *Context) CompileShader(shader *Shader) {
 	c.Call("compileShader", shader.Value)
-
-	status := c.Call("getShaderParameter", shader.Value, c.COMPILE_STATUS)
-	if !status.Bool() {
-		info := c.Call("getShaderInfoLog", shader.Value)
-		log.Print("Error: could not compile shader:", info.String())
-	}
 }
 
 // Copies a rectangle of pixels from the current WebGLFramebuffer into a texture image.
-func (c *Context) CopyTexImage2D(target, level, internal, x, y, w, h, border int) {
-	c.Call("copyTexImage2D", target, level, internal, x, y, w, h, border)
+func (c *Context) CopyTexImage2D(target Enum, level int, internal Enum, x, y, w, h, border int) {
+	c.Call("copyTexImage2D", int(target), level, int(internal), x, y, w, h, border)
 }
 
 // Replaces a portion of an existing 2D texture image with data from the current framebuffer.
-func (c *Context) CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, w, h int) {
-	c.Call("copyTexSubImage2D", target, level, xoffset, yoffset, x, y, w, h)
+func (c *Context) CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, w, h int) {
+	c.Call("copyTexSubImage2D", int(target), level, xoffset, yoffset, x, y, w, h)
 }
 
 // Creates and initializes a WebGLBuffer.
@@ -951,8 +449,8 @@ func (c *Context) CreateRenderbuffer() *RenderBuffer {
 }
 
 // Returns an empty vertex or fragment shader object based on the type specified.
-func (c *Context) CreateShader(typ int) *Shader {
-	return &Shader{c.Call("createShader", typ)}
+func (c *Context) CreateShader(typ Enum) *Shader {
+	return &Shader{c.Call("createShader", int(typ))}
 }
 
 // Used to generate a WebGLTexture object to which images can be bound.
@@ -961,20 +459,20 @@ func (c *Context) CreateTexture() *Texture {
 }
 
 // Sets whether or not front, back, or both facing facets are able to be culled.
-func (c *Context) CullFace(mode int) {
-	c.Call("cullFace", mode)
+func (c *Context) CullFace(mode Enum) {
+	c.Call("cullFace", int(mode))
 }
 
 // Delete a specific buffer.
 func (c *Context) DeleteBuffer(buffer *Buffer) {
-	c.Call("deleteBuffer", buffer)
+	c.Call("deleteBuffer", buffer.Value)
 }
 
 // Deletes a specific WebGLFramebuffer object. If you delete the
 // currently bound framebuffer, the default framebuffer will be bound.
 // Deleting a framebuffer detaches all of its attachments.
 func (c *Context) DeleteFramebuffer(framebuffer *FrameBuffer) {
-	c.Call("deleteFramebuffer", framebuffer)
+	c.Call("deleteFramebuffer", framebuffer.Value)
 }
 
 // Flags a specific WebGLProgram object for deletion if currently active.
@@ -1008,7 +506,7 @@ func (c *Context) DepthMask(flag bool) {
 }
 
 // Sets the depth range for normalized coordinates to canvas or viewport depth coordinates.
-func (c *Context) DepthRange(zNear, zFar float64) {
+func (c *Context) DepthRange(zNear, zFar float32) {
 	c.Call("depthRange", zNear, zFar)
 }
 
@@ -1018,8 +516,11 @@ func (c *Context) DetachShader(program *Program, shader *Shader) {
 }
 
 // Turns off specific WebGL capabilities for this context.
-func (c *Context) Disable(cap int) {
-	c.Call("disable", cap)
+func (c *Context) Disable(cap Enum) {
+	if c.unsupportedEnum(cap) {
+		return
+	}
+	c.Call("disable", int(cap))
 }
 
 // Turns off a vertex attribute array at a specific index position.
@@ -1028,21 +529,21 @@ func (c *Context) DisableVertexAttribArray(index int) {
 }
 
 // Render geometric primitives from bound and enabled vertex data.
-func (c *Context) DrawArrays(mode, first, count int) {
-	c.Call("drawArrays", mode, first, count)
+func (c *Context) DrawArrays(mode Enum, first, count int) {
+	c.Call("drawArrays", int(mode), first, count)
 }
 
 // Renders geometric primitives indexed by element array data.
-func (c *Context) DrawElements(mode, count, typ, offset int) {
-	c.Call("drawElements", mode, count, typ, offset)
+func (c *Context) DrawElements(mode Enum, count int, typ Enum, offset int) {
+	c.Call("drawElements", int(mode), count, int(typ), offset)
 }
 
 // Turns on specific WebGL capabilities for this context.
-func (c *Context) Enable(cap int) {
-	if cap == 0 {
+func (c *Context) Enable(cap Enum) {
+	if c.unsupportedEnum(cap) {
 		return
 	}
-	c.Call("enable", cap)
+	c.Call("enable", int(cap))
 }
 
 // Turns on a vertex attribute at a specific index position in
@@ -1060,32 +561,40 @@ func (c *Context) Flush() {
 }
 
 // Attaches a texture to a WebGLFramebuffer object.
-func (c *Context) FramebufferTexture2D(target, attachment, textarget int, texture *Texture, level int) {
-	c.Call("framebufferTexture2D", target, attachment, textarget, texture, level)
+func (c *Context) FramebufferTexture2D(target, attachment, textarget Enum, texture *Texture, level int) {
+	c.Call("framebufferTexture2D", int(target), int(attachment), int(textarget), texture.Value, level)
 }
 
 // Sets whether or not polygons are considered front-facing based
 // on their winding direction.
-func (c *Context) FrontFace(mode int) {
-	c.Call("frontFace", mode)
+func (c *Context) FrontFace(mode Enum) {
+	c.Call("frontFace", int(mode))
 }
 
 // Creates a set of textures for a WebGLTexture object with image
 // dimensions from the original size of the image down to a 1x1 image.
-func (c *Context) GenerateMipmap(target int) {
-	c.Call("generateMipmap", target)
+func (c *Context) GenerateMipmap(target Enum) {
+	c.Call("generateMipmap", int(target))
 }
 
 // Returns an WebGLActiveInfo object containing the size, type, and name
 // of a vertex attribute at a specific index position in a program object.
-func (c *Context) GetActiveAttrib(program *Program, index int) string {
-	return c.Call("getActiveAttrib", program.Value, index).String()
+func (c *Context) GetActiveAttrib(program *Program, index int) (name string, size int, typ Enum) {
+	info := c.Call("getActiveAttrib", program.Value, index)
+	if info.IsNull() {
+		return "", 0, 0
+	}
+	return info.Get("name").String(), info.Get("size").Int(), Enum(info.Get("type").Int())
 }
 
 // Returns an WebGLActiveInfo object containing the size, type, and name
 // of a uniform attribute at a specific index position in a program object.
-func (c *Context) GetActiveUniform(program *Program, index int) string {
-	return c.Call("getActiveUniform", program.Value, index).String()
+func (c *Context) GetActiveUniform(program *Program, index int) (name string, size int, typ Enum) {
+	info := c.Call("getActiveUniform", program.Value, index)
+	if info.IsNull() {
+		return "", 0, 0
+	}
+	return info.Get("name").String(), info.Get("size").Int(), Enum(info.Get("type").Int())
 }
 
 // Returns a slice of WebGLShaders bound to a WebGLProgram.
@@ -1104,19 +613,147 @@ func (c *Context) GetAttribLocation(program *Program, name string) int {
 }
 
 // Returns the type of a parameter for a given buffer.
-func (c *Context) GetBufferParameter(target, pname int) int {
-	return c.Call("getBufferParameter", target, pname).Int()
+func (c *Context) GetBufferParameter(target, pname Enum) int {
+	return c.Call("getBufferParameter", int(target), int(pname)).Int()
 }
 
-// TODO: Create type specific variations.
 // Returns the natural type value for a constant parameter.
-func (c *Context) GetParameter(pname int) js.Value {
-	return c.Call("getParameter", pname)
+func (c *Context) GetParameter(pname Enum) js.Value {
+	if c.unsupportedEnum(pname) {
+		return js.Null()
+	}
+	return c.Call("getParameter", int(pname))
+}
+
+// Returns the first value of the state variable pname.
+func (c *Context) GetParameterBool(pname Enum) bool {
+	v := parameterValues(c.GetParameter(pname))
+	return len(v) > 0 && parameterNumber(v[0]) != 0
+}
+
+// Returns the values of the state variable pname.
+func (c *Context) GetParameterBools(pname Enum) []bool {
+	v := parameterValues(c.GetParameter(pname))
+	params := make([]bool, len(v))
+	for i := range v {
+		params[i] = parameterNumber(v[i]) != 0
+	}
+	return params
+}
+
+// Returns the first value of the state variable pname.
+func (c *Context) GetParameterFloat(pname Enum) float32 {
+	v := parameterValues(c.GetParameter(pname))
+	if len(v) == 0 {
+		return 0
+	}
+	return float32(parameterNumber(v[0]))
+}
+
+// Returns the values of the state variable pname.
+func (c *Context) GetParameterFloats(pname Enum) []float32 {
+	v := parameterValues(c.GetParameter(pname))
+	params := make([]float32, len(v))
+	for i := range v {
+		params[i] = float32(parameterNumber(v[i]))
+	}
+	return params
+}
+
+// Returns the first value of the state variable pname. WebGL objects have
+// no integer names, so object bindings such as CURRENT_PROGRAM return 1 when
+// an object is bound and 0 otherwise, unlike on other backends, which return
+// the object's name. Use GetParameter to get the bound object itself.
+func (c *Context) GetParameterInt(pname Enum) int {
+	v := parameterValues(c.GetParameter(pname))
+	if len(v) == 0 {
+		return 0
+	}
+	return int(parameterNumber(v[0]))
+}
+
+// Returns the values of the state variable pname.
+func (c *Context) GetParameterInts(pname Enum) []int32 {
+	v := parameterValues(c.GetParameter(pname))
+	params := make([]int32, len(v))
+	for i := range v {
+		params[i] = int32(parameterNumber(v[i]))
+	}
+	return params
+}
+
+// Returns the string state variable pname, such as VENDOR or
+// SHADING_LANGUAGE_VERSION.
+func (c *Context) GetParameterString(pname Enum) string {
+	v := c.GetParameter(pname)
+	if v.Type() != js.TypeString {
+		return ""
+	}
+	return v.String()
+}
+
+// Returns the limits and features of the current context.
+func (c *Context) Capabilities() Capabilities {
+	return Capabilities{
+		MaxTextureSize:         c.GetParameterInt(c.MAX_TEXTURE_SIZE),
+		MaxVertexAttribs:       c.GetParameterInt(c.MAX_VERTEX_ATTRIBS),
+		MaxTextureImageUnits:   c.GetParameterInt(c.MAX_TEXTURE_IMAGE_UNITS),
+		MaxVaryingVectors:      c.GetParameterInt(c.MAX_VARYING_VECTORS),
+		MaxRenderbufferSize:    c.GetParameterInt(c.MAX_RENDERBUFFER_SIZE),
+		ShadingLanguageVersion: c.GetParameterString(c.SHADING_LANGUAGE_VERSION),
+		Vendor:                 c.GetParameterString(c.VENDOR),
+		Renderer:               c.GetParameterString(c.RENDERER),
+		Version:                c.GetParameterString(c.VERSION),
+		Extensions:             c.Extensions(),
+	}
+}
+
+// parameterValues splits a getParameter result into its elements. Array
+// results such as VIEWPORT are returned element by element, anything else as
+// a single value.
+func parameterValues(v js.Value) []js.Value {
+	if v.Type() != js.TypeObject || v.Get("length").Type() != js.TypeNumber {
+		return []js.Value{v}
+	}
+	values := make([]js.Value, v.Length())
+	for i := range values {
+		values[i] = v.Index(i)
+	}
+	return values
+}
+
+// parameterNumber converts a single getParameter value to a number. Booleans
+// become 0 or 1, and non-null objects 1.
+func parameterNumber(v js.Value) float64 {
+	switch v.Type() {
+	case js.TypeNumber:
+		return v.Float()
+	case js.TypeBoolean:
+		if v.Bool() {
+			return 1
+		}
+	case js.TypeObject:
+		return 1
+	}
+	return 0
 }
 
 // Returns a value for the WebGL error flag and clears the flag.
-func (c *Context) GetError() int {
-	return c.Call("getError").Int()
+func (c *Context) GetError() Enum {
+	if c.enumError {
+		c.enumError = false
+		return c.INVALID_ENUM
+	}
+	if c.valueError {
+		c.valueError = false
+		return c.INVALID_VALUE
+	}
+	return Enum(c.Call("getError").Int())
+}
+
+// invalidValue makes the next GetError return INVALID_VALUE.
+func (c *Context) invalidValue() {
+	c.valueError = true
 }
 
 // TODO: Create type specific variations.
@@ -1127,20 +764,20 @@ func (c *Context) GetExtension(name string) js.Value {
 
 // TODO: Create type specific variations.
 // Gets a parameter value for a given target and attachment.
-func (c *Context) GetFramebufferAttachmentParameter(target, attachment, pname int) js.Value {
-	return c.Call("getFramebufferAttachmentParameter", target, attachment, pname)
+func (c *Context) GetFramebufferAttachmentParameter(target, attachment, pname Enum) js.Value {
+	return c.Call("getFramebufferAttachmentParameter", int(target), int(attachment), int(pname))
 }
 
 // Returns the value of the program parameter that corresponds to a supplied pname
 // which is interpreted as an int.
-func (c *Context) GetProgramParameteri(program *Program, pname int) int {
-	return c.Call("getProgramParameter", program.Value, pname).Int()
+func (c *Context) GetProgramParameteri(program *Program, pname Enum) int {
+	return c.Call("getProgramParameter", program.Value, int(pname)).Int()
 }
 
 // Returns the value of the program parameter that corresponds to a supplied pname
 // which is interpreted as a bool.
-func (c *Context) GetProgramParameterb(program *Program, pname int) bool {
-	return c.Call("getProgramParameter", program.Value, pname).Bool()
+func (c *Context) GetProgramParameterb(program *Program, pname Enum) bool {
+	return c.Call("getProgramParameter", program.Value, int(pname)).Bool()
 }
 
 // Returns information about the last error that occurred during
@@ -1151,19 +788,24 @@ func (c *Context) GetProgramInfoLog(program *Program) string {
 
 // TODO: Create type specific variations.
 // Returns a renderbuffer parameter from the currently bound WebGLRenderbuffer object.
-func (c *Context) GetRenderbufferParameter(target, pname int) int {
-	return c.Call("getRenderbufferParameter", target, pname).Int()
+func (c *Context) GetRenderbufferParameter(target, pname Enum) int {
+	return c.Call("getRenderbufferParameter", int(target), int(pname)).Int()
 }
 
 // TODO: Create type specific variations.
 // Returns the value of the parameter associated with pname for a shader object.
-func (c *Context) GetShaderParameter(shader *Shader, pname int) js.Value {
-	return c.Call("getShaderParameter", shader.Value, pname)
+func (c *Context) GetShaderParameter(shader *Shader, pname Enum) js.Value {
+	return c.Call("getShaderParameter", shader.Value, int(pname))
+}
+
+// Returns the value of the parameter associated with pname for a shader object.
+func (c *Context) GetShaderParameterb(shader *Shader, pname Enum) bool {
+	return c.Call("getShaderParameter", shader.Value, int(pname)).Bool()
 }
 
 // Returns the value of the parameter associated with pname for a shader object.
-func (c *Context) GetShaderParameterb(shader *Shader, pname int) bool {
-	return c.Call("getShaderParameter", shader.Value, pname).Bool()
+func (c *Context) GetShaderParameteri(shader *Shader, pname Enum) int {
+	return c.Call("getShaderParameter", shader.Value, int(pname)).Int()
 }
 
 // Returns errors which occur when compiling a shader.
@@ -1177,13 +819,16 @@ func (c *Context) GetShaderSource(shader *Shader) string {
 }
 
 // Returns a parameter from a shader object
-func (c *Context) GetShaderiv(shader *Shader, pname uint32) bool {
-	return c.Call("getShaderParameter", shader.Value, pname).Bool()
+func (c *Context) GetShaderiv(shader *Shader, pname Enum) bool {
+	return c.Call("getShaderParameter", shader.Value, int(pname)).Bool()
 }
 
 // Returns a slice of supported extension strings.
 func (c *Context) GetSupportedExtensions() []string {
 	ext := c.Call("getSupportedExtensions")
+	if ext.IsNull() {
+		return nil
+	}
 	extensions := make([]string, ext.Length())
 	for i := 0; i < ext.Length(); i++ {
 		extensions[i] = ext.Index(i).String()
@@ -1191,10 +836,60 @@ func (c *Context) GetSupportedExtensions() []string {
 	return extensions
 }
 
+// Returns the names of the supported extensions, translated to the OpenGL ES
+// extension they expose where the names differ, e.g.
+// "WEBGL_compressed_texture_s3tc" is returned as "EXT_texture_compression_s3tc".
+func (c *Context) Extensions() []string {
+	var names []string
+	for _, ext := range c.GetSupportedExtensions() {
+		name := webglExtensionName(ext)
+		if !containsExtension(names, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Reports whether the extension name is supported, and enables it as WebGL
+// requires before its constants and functions can be used. The name may be
+// given in the form returned by Extensions, with or without its "GL_" prefix,
+// or as the WebGL name.
+func (c *Context) HasExtension(name string) bool {
+	name = webglExtensionName(extensionName(name))
+	for _, ext := range c.GetSupportedExtensions() {
+		if webglExtensionName(ext) == name {
+			return !c.GetExtension(ext).IsNull()
+		}
+	}
+	return false
+}
+
+// webglExtensions maps WebGL extension names to the OpenGL ES extension they
+// expose, for the extensions whose names differ.
+var webglExtensions = map[string]string{
+	"WEBGL_compressed_texture_astc":  "KHR_texture_compression_astc_ldr",
+	"WEBGL_compressed_texture_etc1":  "OES_compressed_ETC1_RGB8_texture",
+	"WEBGL_compressed_texture_pvrtc": "IMG_texture_compression_pvrtc",
+	"WEBGL_compressed_texture_s3tc":  "EXT_texture_compression_s3tc",
+	"WEBGL_depth_texture":            "OES_depth_texture",
+	"WEBGL_draw_buffers":             "EXT_draw_buffers",
+}
+
+// webglExtensionName returns the normalized name of the WebGL extension name,
+// dropping the vendor prefixes used by older browsers.
+func webglExtensionName(name string) string {
+	name = strings.TrimPrefix(name, "MOZ_")
+	name = strings.TrimPrefix(name, "WEBKIT_")
+	if gl, ok := webglExtensions[name]; ok {
+		return gl
+	}
+	return name
+}
+
 // TODO: Create type specific variations.
 // Returns the value for a parameter on an active texture unit.
-func (c *Context) GetTexParameter(target, pname int) js.Value {
-	return c.Call("getTexParameter", target, pname)
+func (c *Context) GetTexParameter(target, pname Enum) js.Value {
+	return c.Call("getTexParameter", int(target), int(pname))
 }
 
 // TODO: Create type specific variations.
@@ -1212,20 +907,62 @@ func (c *Context) GetUniformLocation(program *Program, name string) *UniformLoca
 // TODO: Create type specific variations.
 // Returns data for a particular characteristic of a vertex
 // attribute at an index in a vertex attribute array.
-func (c *Context) GetVertexAttrib(index, pname int) js.Value {
-	return c.Call("getVertexAttrib", index, pname)
+func (c *Context) GetVertexAttrib(index int, pname Enum) js.Value {
+	return c.Call("getVertexAttrib", index, int(pname))
+}
+
+// Returns an integer parameter of the vertex attribute at index. Boolean
+// parameters are reported as 0 or 1. WebGL does not expose object names, so
+// VERTEX_ATTRIB_ARRAY_BUFFER_BINDING reports 1 if a buffer is bound.
+func (c *Context) GetVertexAttribi(index int, pname Enum) int {
+	v := c.Call("getVertexAttrib", index, int(pname))
+	switch v.Type() {
+	case js.TypeNumber:
+		return v.Int()
+	case js.TypeBoolean:
+		if v.Bool() {
+			return 1
+		}
+		return 0
+	case js.TypeObject:
+		return 1
+	}
+	return 0
+}
+
+// Returns the four floating point values of a vertex attribute parameter
+// such as CURRENT_VERTEX_ATTRIB. Scalar parameters are returned in the
+// first element.
+func (c *Context) GetVertexAttribfv(index int, pname Enum) []float32 {
+	params := make([]float32, 4)
+	v := c.Call("getVertexAttrib", index, int(pname))
+	switch v.Type() {
+	case js.TypeNumber:
+		params[0] = float32(v.Float())
+	case js.TypeBoolean:
+		if v.Bool() {
+			params[0] = 1
+		}
+	case js.TypeObject:
+		if v.Get("length").Type() == js.TypeNumber {
+			for i := 0; i < len(params) && i < v.Length(); i++ {
+				params[i] = float32(v.Index(i).Float())
+			}
+		}
+	}
+	return params
 }
 
 // Returns the address of a specified vertex attribute.
-func (c *Context) GetVertexAttribOffset(index, pname int) int {
-	return c.Call("getVertexAttribOffset", index, pname).Int()
+func (c *Context) GetVertexAttribOffset(index int, pname Enum) int {
+	return c.Call("getVertexAttribOffset", index, int(pname)).Int()
 }
 
 // public function hint(target:GLenum, mode:GLenum) : Void;
 
 // Returns true if buffer is valid, false otherwise.
-func (c *Context) IsBuffer(buffer js.Value) bool {
-	return c.Call("isBuffer", buffer).Bool()
+func (c *Context) IsBuffer(buffer *Buffer) bool {
+	return c.Call("isBuffer", buffer.Value).Bool()
 }
 
 // Returns whether the WebGL context has been lost.
@@ -1259,8 +996,11 @@ func (c *Context) IsTexture(texture *Texture) bool {
 }
 
 // Returns whether or not a WebGL capability is enabled for this context.
-func (c *Context) IsEnabled(capability int) bool {
-	return c.Call("isEnabled", capability).Bool()
+func (c *Context) IsEnabled(capability Enum) bool {
+	if c.unsupportedEnum(capability) {
+		return false
+	}
+	return c.Call("isEnabled", int(capability)).Bool()
 }
 
 // Sets the width of lines in WebGL.
@@ -1270,38 +1010,44 @@ func (c *Context) LineWidth(width float32) {
 
 // Links an attached vertex shader and an attached fragment shader
 // to a program so it can be used by the graphics processing unit (GPU).
+// Use LinkProgramErr to find out whether linking failed.
 func (c *Context) LinkProgram(program *Program) {
 	c.Call("linkProgram", program.Value)
-
-	param := c.Call("getProgramParameter", program.Value, c.LINK_STATUS)
-	if !param.Bool() {
-		info := c.Call("getProgramInfoLog", program.Value)
-		log.Print("Error: could not link program: ", info.String())
-	}
 }
 
 // Sets pixel storage modes for readPixels and unpacking of textures
 // with texImage2D and texSubImage2D.
-func (c *Context) PixelStorei(pname, param int) {
-	c.Call("pixelStorei", pname, param)
+func (c *Context) PixelStorei(pname Enum, param int) {
+	if c.unsupportedEnum(pname) {
+		return
+	}
+	c.Call("pixelStorei", int(pname), param)
 }
 
 // Sets the implementation-specific units and scale factor
 // used to calculate fragment depth values.
-func (c *Context) PolygonOffset(factor, units float64) {
+func (c *Context) PolygonOffset(factor, units float32) {
 	c.Call("polygonOffset", factor, units)
 }
 
-// TODO: Figure out if pixels should be a slice.
-// Reads pixel data into an ArrayBufferView object from a
-// rectangular area in the color buffer of the active frame buffer.
-func (c *Context) ReadPixels(x, y, width, height, format, typ int, pixels js.Value) {
-	c.Call("readPixels", x, y, width, height, format, typ, pixels)
+// Reads a rectangle of the color buffer of the active frame buffer as
+// RGBA into pixels, top row first. pixels must hold at least
+// 4*width*height bytes.
+func (c *Context) ReadPixelsInto(x, y, width, height int, pixels []byte) error {
+	if err := checkReadPixels(width, height, pixels); err != nil {
+		return err
+	}
+	n := 4 * width * height
+	d := temporaryUint8Array(n)
+	c.Call("readPixels", x, y, width, height, int(c.RGBA), int(c.UNSIGNED_BYTE), d)
+	js.CopyBytesToGo(pixels[:n], d)
+	flipRows(pixels, 4*width, height)
+	return nil
 }
 
 // Creates or replaces the data store for the currently bound WebGLRenderbuffer object.
-func (c *Context) RenderbufferStorage(target, internalFormat, width, height int) {
-	c.Call("renderbufferStorage", target, internalFormat, width, height)
+func (c *Context) RenderbufferStorage(target, internalFormat Enum, width, height int) {
+	c.Call("renderbufferStorage", int(target), int(internalFormat), width, height)
 }
 
 // Sets the dimensions of the scissor box.
@@ -1314,37 +1060,94 @@ func (c *Context) ShaderSource(shader *Shader, source string) {
 	c.Call("shaderSource", shader.Value, source)
 }
 
-// public function stencilMask(mask:GLuint) : Void;
-// public function stencilMaskSeparate(face:GLenum, mask:GLuint) : Void;
+// Controls enabling and disabling of both the front and back writing
+// of individual bits in the stencil planes.
+func (c *Context) StencilMask(mask int) {
+	c.Call("stencilMask", mask)
+}
+
+// Controls enabling and disabling of front and/or back writing
+// of individual bits in the stencil planes.
+func (c *Context) StencilMaskSeparate(face Enum, mask int) {
+	c.Call("stencilMaskSeparate", int(face), mask)
+}
 
-// Loads the supplied pixel data into a texture.
-func (c *Context) TexImage2D(target, level, internalFormat, format, kind int, data interface{}) {
+// Loads the supplied image into a texture. data may be nil, any image.Image,
+// whose pixels are converted to format if needed, or a js.Value holding an
+// image source such as an HTMLImageElement. WebGL 1 has no
+// UNPACK_ROW_LENGTH, so sub-images are repacked before uploading.
+// UNPACK_ALIGNMENT is restored after the upload.
+func (c *Context) TexImage2D(target Enum, level int, internalFormat, format, kind Enum, data interface{}) error {
 	switch img := data.(type) {
-	case *image.NRGBA:
-		d := temporaryUint8Array(len(img.Pix))
-		js.CopyBytesToJS(d, img.Pix)
-		c.Call("texImage2D", target, level, internalFormat, img.Bounds().Dx(), img.Bounds().Dy(), 0, format, kind, d)
-	case *image.RGBA:
-		d := temporaryUint8Array(len(img.Pix))
-		js.CopyBytesToJS(d, img.Pix)
-		c.Call("texImage2D", target, level, internalFormat, img.Bounds().Dx(), img.Bounds().Dy(), 0, format, kind, d)
+	case nil:
+		c.Call("texImage2D", int(target), level, int(internalFormat), 0, 0, 0, int(format), int(kind), nil)
+	case js.Value:
+		c.Call("texImage2D", int(target), level, int(internalFormat), int(format), int(kind), img)
+	case image.Image:
+		width, height := img.Bounds().Dx(), img.Bounds().Dy()
+		pix, err := c.texturePixels(img, width, height, format, kind)
+		if err != nil {
+			return err
+		}
+		d := temporaryUint8Array(len(pix))
+		js.CopyBytesToJS(d, pix)
+		defer c.pixelStore(c.UNPACK_ALIGNMENT, 1)()
+		c.Call("texImage2D", int(target), level, int(internalFormat), width, height, 0, int(format), int(kind), d)
 	default:
-		c.Call("texImage2D", target, level, internalFormat, format, kind, data)
+		return fmt.Errorf("gl: image type unsupported: %T", data)
 	}
+	return nil
 }
 
-func (c *Context) TexImage2DEmpty(target, level, internalFormat, format, kind, width, height int) {
-	c.Call("texImage2D", target, level, internalFormat, width, height, 0, format, kind, nil)
+func (c *Context) TexImage2DEmpty(target Enum, level int, internalFormat, format, kind Enum, width, height int) {
+	c.Call("texImage2D", int(target), level, int(internalFormat), width, height, 0, int(format), int(kind), nil)
 }
 
 // Sets texture parameters for the current texture unit.
-func (c *Context) TexParameteri(target int, pname int, param int) {
-	c.Call("texParameteri", target, pname, param)
-}
-
-// Replaces a portion of an existing 2D texture image with all of another image.
-func (c *Context) TexSubImage2D(target, level, xoffset, yoffset, format, typ int, image js.Value) {
-	c.Call("texSubImage2D", target, level, xoffset, yoffset, format, typ, image)
+func (c *Context) TexParameteri(target, pname, param Enum) {
+	if c.unsupportedEnum(target) || c.unsupportedEnum(pname) || c.unsupportedTexParameter(pname, param) {
+		return
+	}
+	c.Call("texParameteri", int(target), int(pname), int(param))
+}
+
+// Replaces a width×height rectangle of an existing 2D texture image at
+// (xoffset, yoffset). data is a []byte of tightly packed rows, or an
+// image.Image whose top-left rectangle is converted to format and used.
+// A js.Value such as an HTMLImageElement is uploaded whole, ignoring width
+// and height. UNPACK_ALIGNMENT is restored after the upload.
+func (c *Context) TexSubImage2D(target Enum, level, xoffset, yoffset, width, height int, format, kind Enum, data interface{}) error {
+	if v, ok := data.(js.Value); ok {
+		c.Call("texSubImage2D", int(target), level, xoffset, yoffset, int(format), int(kind), v)
+		return nil
+	}
+	pix, err := c.texturePixels(data, width, height, format, kind)
+	if err != nil {
+		return err
+	}
+	if len(pix) == 0 {
+		return nil
+	}
+	d := temporaryUint8Array(len(pix))
+	js.CopyBytesToJS(d, pix)
+	if kind != c.UNSIGNED_BYTE {
+		d = js.Global().Get("Uint16Array").New(d.Get("buffer"), d.Get("byteOffset"), len(pix)/2)
+	}
+	defer c.pixelStore(c.UNPACK_ALIGNMENT, 1)()
+	c.Call("texSubImage2D", int(target), level, xoffset, yoffset, width, height, int(format), int(kind), d)
+	return nil
+}
+
+// pixelStore sets the pixel storage parameter pname to param and returns a
+// func restoring its previous value, so that uploads leave the parameters set
+// with PixelStorei as they were.
+func (c *Context) pixelStore(pname Enum, param int) (restore func()) {
+	previous := c.Call("getParameter", int(pname)).Int()
+	if previous == param {
+		return func() {}
+	}
+	c.Call("pixelStorei", int(pname), param)
+	return func() { c.Call("pixelStorei", int(pname), previous) }
 }
 
 // Assigns a floating point value to a uniform variable for the current program object.
@@ -1392,55 +1195,107 @@ func (c *Context) Uniform4i(location *UniformLocation, x, y, z, w int) {
 	c.Call("uniform4i", location.Value, x, y, z, w)
 }
 
-// public function uniform1fv(location:WebGLUniformLocation, v:ArrayAccess<Float>) : Void;
-// public function uniform1iv(location:WebGLUniformLocation, v:ArrayAccess<Long>) : Void;
-// public function uniform2fv(location:WebGLUniformLocation, v:ArrayAccess<Float>) : Void;
-// public function uniform2iv(location:WebGLUniformLocation, v:ArrayAccess<Long>) : Void;
-// public function uniform3fv(location:WebGLUniformLocation, v:ArrayAccess<Float>) : Void;
-// public function uniform3iv(location:WebGLUniformLocation, v:ArrayAccess<Long>) : Void;
-// public function uniform4fv(location:WebGLUniformLocation, v:ArrayAccess<Float>) : Void;
-// public function uniform4iv(location:WebGLUniformLocation, v:ArrayAccess<Long>) : Void;
+// Assigns an array of float values to a uniform variable for the current program object.
+func (c *Context) Uniform1fv(location *UniformLocation, value []float32) {
+	if c.vectorCount(1, len(value)) == 0 {
+		return
+	}
+	c.Call("uniform1fv", location.Value, temporaryFloat32Array(value))
+}
+
+// Assigns an array of int values to a uniform variable for the current program object.
+func (c *Context) Uniform1iv(location *UniformLocation, value []int32) {
+	if c.vectorCount(1, len(value)) == 0 {
+		return
+	}
+	c.Call("uniform1iv", location.Value, temporaryInt32Array(value))
+}
+
+// Assigns an array of vec2 values to a uniform variable for the current program object.
+func (c *Context) Uniform2fv(location *UniformLocation, value []float32) {
+	if c.vectorCount(2, len(value)) == 0 {
+		return
+	}
+	c.Call("uniform2fv", location.Value, temporaryFloat32Array(value))
+}
+
+// Assigns an array of ivec2 values to a uniform variable for the current program object.
+func (c *Context) Uniform2iv(location *UniformLocation, value []int32) {
+	if c.vectorCount(2, len(value)) == 0 {
+		return
+	}
+	c.Call("uniform2iv", location.Value, temporaryInt32Array(value))
+}
+
+// Assigns an array of vec3 values to a uniform variable for the current program object.
+func (c *Context) Uniform3fv(location *UniformLocation, value []float32) {
+	if c.vectorCount(3, len(value)) == 0 {
+		return
+	}
+	c.Call("uniform3fv", location.Value, temporaryFloat32Array(value))
+}
+
+// Assigns an array of ivec3 values to a uniform variable for the current program object.
+func (c *Context) Uniform3iv(location *UniformLocation, value []int32) {
+	if c.vectorCount(3, len(value)) == 0 {
+		return
+	}
+	c.Call("uniform3iv", location.Value, temporaryInt32Array(value))
+}
+
+// Assigns an array of vec4 values to a uniform variable for the current program object.
+func (c *Context) Uniform4fv(location *UniformLocation, value []float32) {
+	if c.vectorCount(4, len(value)) == 0 {
+		return
+	}
+	c.Call("uniform4fv", location.Value, temporaryFloat32Array(value))
+}
+
+// Assigns an array of ivec4 values to a uniform variable for the current program object.
+func (c *Context) Uniform4iv(location *UniformLocation, value []int32) {
+	if c.vectorCount(4, len(value)) == 0 {
+		return
+	}
+	c.Call("uniform4iv", location.Value, temporaryInt32Array(value))
+}
 
 // Sets values for a 2x2 floating point vector matrix into a
-// uniform location as a matrix or a matrix array.
+// uniform location as a matrix or a matrix array. WebGL 1 rejects
+// transpose, so the matrices are transposed before uploading.
 func (c *Context) UniformMatrix2fv(location *UniformLocation, transpose bool, value []float32) {
-	l := len(value)
-	h := (*reflect.SliceHeader)(unsafe.Pointer(&value))
-	h.Len *= 4
-	h.Cap *= 4
-	bs := *(*[]byte)(unsafe.Pointer(h))
-	uint8arr := temporaryUint8Array(l * 4)
-	js.CopyBytesToJS(uint8arr, bs)
-	d := js.Global().Get("Float32Array").New(uint8arr.Get("buffer"), uint8arr.Get("byteoffset"), l)
-	c.Call("uniformMatrix2fv", location.Value, transpose, d)
+	if c.matrixCount(2, value) == 0 {
+		return
+	}
+	if transpose {
+		value = transposeMatrices(2, value)
+	}
+	c.Call("uniformMatrix2fv", location.Value, false, temporaryFloat32Array(value))
 }
 
 // Sets values for a 3x3 floating point vector matrix into a
-// uniform location as a matrix or a matrix array.
+// uniform location as a matrix or a matrix array. WebGL 1 rejects
+// transpose, so the matrices are transposed before uploading.
 func (c *Context) UniformMatrix3fv(location *UniformLocation, transpose bool, value []float32) {
-	l := len(value)
-	h := (*reflect.SliceHeader)(unsafe.Pointer(&value))
-	h.Len *= 4
-	h.Cap *= 4
-	bs := *(*[]byte)(unsafe.Pointer(h))
-	uint8arr := temporaryUint8Array(l * 4)
-	js.CopyBytesToJS(uint8arr, bs)
-	d := js.Global().Get("Float32Array").New(uint8arr.Get("buffer"), uint8arr.Get("byteoffset"), l)
-	c.Call("uniformMatrix3fv", location.Value, transpose, d)
+	if c.matrixCount(3, value) == 0 {
+		return
+	}
+	if transpose {
+		value = transposeMatrices(3, value)
+	}
+	c.Call("uniformMatrix3fv", location.Value, false, temporaryFloat32Array(value))
 }
 
 // Sets values for a 4x4 floating point vector matrix into a
-// uniform location as a matrix or a matrix array.
+// uniform location as a matrix or a matrix array. WebGL 1 rejects
+// transpose, so the matrices are transposed before uploading.
 func (c *Context) UniformMatrix4fv(location *UniformLocation, transpose bool, value []float32) {
-	l := len(value)
-	h := (*reflect.SliceHeader)(unsafe.Pointer(&value))
-	h.Len *= 4
-	h.Cap *= 4
-	bs := *(*[]byte)(unsafe.Pointer(h))
-	uint8arr := temporaryUint8Array(l * 4)
-	js.CopyBytesToJS(uint8arr, bs)
-	d := js.Global().Get("Float32Array").New(uint8arr.Get("buffer"), uint8arr.Get("byteoffset"), l)
-	c.Call("uniformMatrix4fv", location.Value, transpose, d)
+	if c.matrixCount(4, value) == 0 {
+		return
+	}
+	if transpose {
+		value = transposeMatrices(4, value)
+	}
+	c.Call("uniformMatrix4fv", location.Value, false, temporaryFloat32Array(value))
 }
 
 // Set the program object to use for rendering.
@@ -1457,18 +1312,69 @@ func (c *Context) ValidateProgram(program *Program) {
 	c.Call("validateProgram", program.Value)
 }
 
-func (c *Context) VertexAttribPointer(index, size, typ int, normal bool, stride int, offset int) {
-	c.Call("vertexAttribPointer", index, size, typ, normal, stride, offset)
+func (c *Context) VertexAttribPointer(index, size int, typ Enum, normal bool, stride, offset int) {
+	c.Call("vertexAttribPointer", index, size, int(typ), normal, stride, offset)
+}
+
+// Sets the constant value of a generic vertex attribute, used when
+// its attribute array is disabled.
+func (c *Context) VertexAttrib1f(index int, x float32) {
+	c.Call("vertexAttrib1f", index, x)
+}
+
+// Sets the constant value of a generic vertex attribute, used when
+// its attribute array is disabled.
+func (c *Context) VertexAttrib2f(index int, x, y float32) {
+	c.Call("vertexAttrib2f", index, x, y)
+}
+
+// Sets the constant value of a generic vertex attribute, used when
+// its attribute array is disabled.
+func (c *Context) VertexAttrib3f(index int, x, y, z float32) {
+	c.Call("vertexAttrib3f", index, x, y, z)
+}
+
+// Sets the constant value of a generic vertex attribute, used when
+// its attribute array is disabled.
+func (c *Context) VertexAttrib4f(index int, x, y, z, w float32) {
+	c.Call("vertexAttrib4f", index, x, y, z, w)
+}
+
+// Sets the constant value of a generic vertex attribute from a slice
+// of one value, used when its attribute array is disabled.
+func (c *Context) VertexAttrib1fv(index int, value []float32) {
+	if !c.attribValues(1, value) {
+		return
+	}
+	c.Call("vertexAttrib1fv", index, temporaryFloat32Array(value[:1]))
+}
+
+// Sets the constant value of a generic vertex attribute from a slice
+// of 2 values, used when its attribute array is disabled.
+func (c *Context) VertexAttrib2fv(index int, value []float32) {
+	if !c.attribValues(2, value) {
+		return
+	}
+	c.Call("vertexAttrib2fv", index, temporaryFloat32Array(value[:2]))
+}
+
+// Sets the constant value of a generic vertex attribute from a slice
+// of 3 values, used when its attribute array is disabled.
+func (c *Context) VertexAttrib3fv(index int, value []float32) {
+	if !c.attribValues(3, value) {
+		return
+	}
+	c.Call("vertexAttrib3fv", index, temporaryFloat32Array(value[:3]))
 }
 
-// public function vertexAttrib1f(indx:GLuint, x:GLfloat) : Void;
-// public function vertexAttrib2f(indx:GLuint, x:GLfloat, y:GLfloat) : Void;
-// public function vertexAttrib3f(indx:GLuint, x:GLfloat, y:GLfloat, z:GLfloat) : Void;
-// public function vertexAttrib4f(indx:GLuint, x:GLfloat, y:GLfloat, z:GLfloat, w:GLfloat) : Void;
-// public function vertexAttrib1fv(indx:GLuint, values:ArrayAccess<Float>) : Void;
-// public function vertexAttrib2fv(indx:GLuint, values:ArrayAccess<Float>) : Void;
-// public function vertexAttrib3fv(indx:GLuint, values:ArrayAccess<Float>) : Void;
-// public function vertexAttrib4fv(indx:GLuint, values:ArrayAccess<Float>) : Void;
+// Sets the constant value of a generic vertex attribute from a slice
+// of 4 values, used when its attribute array is disabled.
+func (c *Context) VertexAttrib4fv(index int, value []float32) {
+	if !c.attribValues(4, value) {
+		return
+	}
+	c.Call("vertexAttrib4fv", index, temporaryFloat32Array(value[:4]))
+}
 
 // Represents a rectangular viewable area that contains
 // the rendering results of the drawing buffer.
@@ -1478,7 +1384,7 @@ func (c *Context) Viewport(x, y, width, height int) {
 
 func (c *Context) GetViewport() [4]int32 {
 	var params [4]int32
-	vp := c.Call("getParameter", c.VIEWPORT)
+	vp := c.Call("getParameter", int(c.VIEWPORT))
 	for i := 0; i < 4; i++ {
 		params[i] = int32(vp.Index(i).Int())
 	}
@@ -1498,15 +1404,15 @@ func (c *Context) DeleteRenderBuffer(rb *RenderBuffer) {
 // BindRenderBuffer binds a named renderbuffer object.
 func (c *Context) BindRenderBuffer(rb *RenderBuffer) {
 	if rb != nil {
-		c.Call("bindRenderbuffer", c.RENDERBUFFER, rb.Value)
+		c.Call("bindRenderbuffer", int(c.RENDERBUFFER), rb.Value)
 	} else {
-		c.Call("bindRenderbuffer", c.RENDERBUFFER, nil)
+		c.Call("bindRenderbuffer", int(c.RENDERBUFFER), nil)
 	}
 }
 
 // RenderBufferStorage establishes the data storage, format, and dimensions of a renderbuffer object's image.
-func (c *Context) RenderBufferStorage(internalFormat int, width, height int) {
-	c.Call("renderbufferStorage", c.RENDERBUFFER, internalFormat, width, height)
+func (c *Context) RenderBufferStorage(internalFormat Enum, width, height int) {
+	c.Call("renderbufferStorage", int(c.RENDERBUFFER), int(internalFormat), width, height)
 }
 
 // CreateFrameBuffer creates a FrameBuffer object.
@@ -1522,26 +1428,18 @@ func (c *Context) DeleteFrameBuffer(fb *FrameBuffer) {
 // BindFrameBuffer binds a framebuffer.
 func (c *Context) BindFrameBuffer(fb *FrameBuffer) {
 	if fb != nil {
-		c.Call("bindFramebuffer", c.FRAMEBUFFER, fb.Value)
+		c.Call("bindFramebuffer", int(c.FRAMEBUFFER), fb.Value)
 	} else {
-		c.Call("bindFramebuffer", c.FRAMEBUFFER, nil)
+		c.Call("bindFramebuffer", int(c.FRAMEBUFFER), nil)
 	}
 }
 
 // FrameBufferTexture2D attaches a texture to a FrameBuffer
-func (c *Context) FrameBufferTexture2D(target, attachment, texTarget int, t *Texture, level int) {
-	c.Call("framebufferTexture2D", target, attachment, texTarget, t.Value, level)
+func (c *Context) FrameBufferTexture2D(target, attachment, texTarget Enum, t *Texture, level int) {
+	c.Call("framebufferTexture2D", int(target), int(attachment), int(texTarget), t.Value, level)
 }
 
 // FrameBufferRenderBuffer attaches a RenderBuffer object to a FrameBuffer object.
-func (c *Context) FrameBufferRenderBuffer(target, attachment int, rb *RenderBuffer) {
-	c.Call("framebufferRenderbuffer", target, attachment, c.RENDERBUFFER, rb.Value)
-}
-
-// LineStipple is not supported on webgl platforms. Emulation using small
-// rectangles is easiest method.
-// See: https://stackoverflow.com/questions/6017176/gllinestipple-deprecated-in-opengl-3-1
-// for implementation suggestions.
-func (c *Context) LineStipple(factor int32, pattern uint16) {
-	log.Println("[WARNING!!!] LineStipple is not supported on mobile platoforms!")
+func (c *Context) FrameBufferRenderBuffer(target, attachment Enum, rb *RenderBuffer) {
+	c.Call("framebufferRenderbuffer", int(target), int(attachment), int(c.RENDERBUFFER), rb.Value)
 }
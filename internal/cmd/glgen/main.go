@@ -0,0 +1,322 @@
+// Command glgen generates the Constants struct of package gl and the
+// initializer of each backend from constants.txt, so that a constant is added
+// to every backend by adding a line to that file. It is run by go generate in
+// the root of the repository; see constants.txt for the format.
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// constant is a line of the spec.
+type constant struct {
+	Name    string
+	Value   string
+	Comment string
+
+	// values holds the per-backend values given in the spec; the value of
+	// a backend without one is Name.
+	values map[string]string
+}
+
+// backend describes the generated initializer of a backend.
+type backend struct {
+	Name       string
+	File       string
+	Constraint []string
+	Imports    []string
+	Doc        string
+	Prologue   string
+
+	// expr returns the Go expression for the binding's constant name.
+	expr func(name string) string
+
+	// spec is set for backends without a binding, whose values can only
+	// refer to constants of the spec.
+	spec bool
+}
+
+var backends = []*backend{
+	{
+		Name: "gl2",
+		File: "constants_gl2.go",
+		Constraint: []string{
+			"//go:build (darwin || linux || windows) && !ios && !android && !js && !nogl && !softgl",
+			"// +build darwin linux windows",
+			"// +build !ios",
+			"// +build !android",
+			"// +build !js",
+			"// +build !nogl",
+			"// +build !softgl",
+		},
+		Imports: []string{"github.com/go-gl/gl/v2.1/gl"},
+		Doc:     "newConstants returns the constants of the go-gl binding.",
+		expr:    func(name string) string { return "gl." + name },
+	},
+	{
+		Name: "mobile",
+		File: "constants_mobile.go",
+		Constraint: []string{
+			"//go:build (android || ios) && !nogl && !softgl",
+			"// +build android ios",
+			"// +build !nogl",
+			"// +build !softgl",
+		},
+		Imports: []string{"golang.org/x/mobile/gl"},
+		Doc:     "newConstants returns the constants of the golang.org/x/mobile/gl binding.",
+		expr:    func(name string) string { return "gl." + name },
+	},
+	{
+		Name: "webgl",
+		File: "constants_webgl.go",
+		Constraint: []string{
+			"//go:build js && !nogl && !softgl",
+			"// +build js,!nogl,!softgl",
+		},
+		Imports:  []string{"syscall/js"},
+		Doc:      "newConstants reads the constants from the WebGLRenderingContext prototype.",
+		Prologue: `webCtx := js.Global().Get("WebGLRenderingContext").Get("prototype")`,
+		expr: func(name string) string {
+			return fmt.Sprintf("Enum(webCtx.Get(%q).Int())", name)
+		},
+	},
+	{
+		Name: "softgl",
+		File: "constants_soft.go",
+		Constraint: []string{
+			"//go:build softgl && !nogl",
+			"// +build softgl,!nogl",
+		},
+		Doc:  "newConstants returns the OpenGL values of the constants.",
+		expr: func(name string) string { return specValues[name] },
+		spec: true,
+	},
+	{
+		Name: "nogl",
+		File: "constants_nogl.go",
+		Constraint: []string{
+			"//go:build nogl",
+			"// +build nogl",
+		},
+		Doc:  "newConstants returns the OpenGL values of the constants.",
+		expr: func(name string) string { return specValues[name] },
+		spec: true,
+	},
+}
+
+// specValues maps the name of each constant of the spec to its OpenGL value.
+var specValues = make(map[string]string)
+
+// Value returns the Go expression initializing c on the backend, or "" if the
+// backend doesn't support c.
+func (b *backend) Value(c constant) string {
+	v, ok := c.values[b.Name]
+	switch {
+	case !ok:
+		return b.expr(c.Name)
+	case v == "-":
+		return ""
+	case isNumber(v):
+		return v
+	}
+	return b.expr(v)
+}
+
+func isNumber(s string) bool {
+	_, err := strconv.ParseInt(s, 0, 64)
+	return err == nil
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("glgen: ")
+
+	spec := flag.String("spec", "constants.txt", "path of the constants spec")
+	dir := flag.String("out", ".", "directory to write the generated files to")
+	flag.Parse()
+
+	f, err := os.Open(*spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	constants, err := parse(f, *spec)
+	f.Close()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	source := filepath.Base(*spec)
+	if err := write(filepath.Join(*dir, "constants.go"), structTemplate, map[string]interface{}{
+		"Source":    source,
+		"Constants": constants,
+	}); err != nil {
+		log.Fatal(err)
+	}
+	for _, b := range backends {
+		if err := write(filepath.Join(*dir, b.File), backendTemplate, map[string]interface{}{
+			"Source":    source,
+			"Backend":   b,
+			"Constants": constants,
+		}); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// parse reads the spec, reporting errors at their line.
+func parse(r io.Reader, filename string) ([]constant, error) {
+	var constants []constant
+	s := bufio.NewScanner(r)
+	for line := 1; s.Scan(); line++ {
+		text, comment := s.Text(), ""
+		if i := strings.Index(text, "#"); i >= 0 {
+			text, comment = text[:i], strings.TrimSpace(text[i+1:])
+		}
+		fields := strings.Fields(text)
+		if len(fields) == 0 {
+			continue
+		}
+
+		c := constant{Name: fields[0], Comment: comment, values: make(map[string]string)}
+		if !isIdent(c.Name) {
+			return nil, fmt.Errorf("%s:%d: invalid constant name %q", filename, line, c.Name)
+		}
+		if _, ok := specValues[c.Name]; ok {
+			return nil, fmt.Errorf("%s:%d: duplicate constant %s", filename, line, c.Name)
+		}
+		if len(fields) < 2 || !isNumber(fields[1]) {
+			return nil, fmt.Errorf("%s:%d: missing value of %s", filename, line, c.Name)
+		}
+		c.Value = fields[1]
+		specValues[c.Name] = c.Value
+
+		for _, field := range fields[2:] {
+			name, value := field, ""
+			if i := strings.Index(field, "="); i >= 0 {
+				name, value = field[:i], field[i+1:]
+			}
+			if !isBackend(name) {
+				return nil, fmt.Errorf("%s:%d: unknown backend %q", filename, line, name)
+			}
+			if value != "-" && !isNumber(value) && !isIdent(value) {
+				return nil, fmt.Errorf("%s:%d: invalid value %q for %s", filename, line, value, name)
+			}
+			c.values[name] = value
+		}
+		constants = append(constants, c)
+	}
+	if err := s.Err(); err != nil {
+		return nil, err
+	}
+
+	// Backends without a binding can only refer to constants of the spec.
+	for _, b := range backends {
+		if !b.spec {
+			continue
+		}
+		for _, c := range constants {
+			v, ok := c.values[b.Name]
+			if _, known := specValues[v]; ok && isIdent(v) && !known {
+				return nil, fmt.Errorf("%s: %s value of %s refers to unknown constant %s", filename, b.Name, c.Name, v)
+			}
+		}
+	}
+	return constants, nil
+}
+
+func isBackend(name string) bool {
+	for _, b := range backends {
+		if b.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func isIdent(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i, r := range s {
+		if !(r >= 'A' && r <= 'Z' || r >= 'a' && r <= 'z' || r == '_' || i > 0 && r >= '0' && r <= '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// write executes tmpl with data and writes the formatted result to filename.
+func write(filename string, tmpl *template.Template, data interface{}) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("formatting %s: %v", filename, err)
+	}
+	return ioutil.WriteFile(filename, src, 0644)
+}
+
+var structTemplate = template.Must(template.New("struct").Parse(`// Code generated by glgen from {{.Source}}; DO NOT EDIT.
+
+package gl
+
+// Constants holds the OpenGL constants of a Context, whose fields it promotes.
+// Constants the backend doesn't support are 0.
+type Constants struct {
+{{- range .Constants}}
+	{{.Name}} Enum{{if .Comment}} // {{.Comment}}{{end}}
+{{- end}}
+}
+
+// each calls fn with the name and value of each constant, in declaration
+// order.
+func (c *Constants) each(fn func(name string, value Enum)) {
+{{- range .Constants}}
+	fn("{{.Name}}", c.{{.Name}})
+{{- end}}
+}
+`))
+
+var backendTemplate = template.Must(template.New("backend").Parse(`// Code generated by glgen from {{.Source}}; DO NOT EDIT.
+
+{{range .Backend.Constraint}}{{.}}
+{{end}}
+package gl
+
+{{- with .Backend.Imports}}
+import (
+{{- range .}}
+	"{{.}}"
+{{- end}}
+)
+{{- end}}
+
+// {{.Backend.Doc}}
+func newConstants() Constants {
+{{- with .Backend.Prologue}}
+	{{.}}
+{{- end}}
+	return Constants{
+{{- $b := .Backend}}
+{{- range $c := .Constants}}
+{{- with $b.Value $c}}
+		{{$c.Name}}: {{.}},
+{{- end}}
+{{- end}}
+	}
+}
+`))
@@ -0,0 +1,122 @@
+package gl
+
+// ShaderStage identifies the step of building a program that failed.
+type ShaderStage string
+
+const (
+	VertexShaderStage   ShaderStage = "vertex shader"
+	FragmentShaderStage ShaderStage = "fragment shader"
+	LinkStage           ShaderStage = "program"
+)
+
+// ShaderError is returned when a shader fails to compile or a program fails
+// to link. Log holds the info log written by the driver, and Diagnostics the
+// errors and warnings parsed from it. Source is the shader source for compile
+// errors, and empty for link errors.
+type ShaderError struct {
+	Stage       ShaderStage
+	Log         string
+	Diagnostics []Diagnostic
+	Source      string
+}
+
+func (e *ShaderError) Error() string {
+	verb := "compile"
+	if e.Stage == LinkStage {
+		verb = "link"
+	}
+	return "gl: failed to " + verb + " " + string(e.Stage) + ": " + e.Log
+}
+
+// SourceContext renders the source lines the diagnostics point at, see
+// SourceContext.
+func (e *ShaderError) SourceContext(context int) string {
+	return SourceContext(e.Source, e.Diagnostics, context)
+}
+
+// CompileShaderErr compiles shader and returns a *ShaderError holding the
+// info log if compilation fails.
+func (c *Context) CompileShaderErr(shader *Shader) error {
+	c.CompileShader(shader)
+	if c.GetShaderiv(shader, c.COMPILE_STATUS) {
+		return nil
+	}
+	log := c.GetShaderInfoLog(shader)
+	return &ShaderError{
+		Stage:       c.shaderStage(shader),
+		Log:         log,
+		Diagnostics: ParseDiagnostics(log),
+		Source:      c.GetShaderSource(shader),
+	}
+}
+
+// LinkProgramErr links program and returns a *ShaderError holding the info
+// log if linking fails.
+func (c *Context) LinkProgramErr(program *Program) error {
+	c.LinkProgram(program)
+	if c.GetProgramParameterb(program, c.LINK_STATUS) {
+		return nil
+	}
+	log := c.GetProgramInfoLog(program)
+	return &ShaderError{Stage: LinkStage, Log: log, Diagnostics: ParseDiagnostics(log)}
+}
+
+// BuildProgram compiles the vertex and fragment shader sources and links them
+// into a new program. The shaders are deleted once linked, and nothing is
+// left behind if any step fails.
+func (c *Context) BuildProgram(vertexSrc, fragmentSrc string) (*Program, error) {
+	vs, err := c.buildShader(c.VERTEX_SHADER, vertexSrc)
+	if err != nil {
+		return nil, err
+	}
+	defer c.DeleteShader(vs)
+
+	fs, err := c.buildShader(c.FRAGMENT_SHADER, fragmentSrc)
+	if err != nil {
+		return nil, err
+	}
+	defer c.DeleteShader(fs)
+
+	program := c.CreateProgram()
+	c.AttachShader(program, vs)
+	c.AttachShader(program, fs)
+	if err := c.LinkProgramErr(program); err != nil {
+		c.DeleteProgram(program)
+		return nil, err
+	}
+	c.DetachShader(program, vs)
+	c.DetachShader(program, fs)
+	return program, nil
+}
+
+// buildShader creates and compiles a shader of type typ, deleting it again if
+// compilation fails.
+func (c *Context) buildShader(typ Enum, source string) (*Shader, error) {
+	shader := c.CreateShader(typ)
+	c.ShaderSource(shader, source)
+	if err := c.CompileShaderErr(shader); err != nil {
+		c.DeleteShader(shader)
+		return nil, err
+	}
+	return shader, nil
+}
+
+// shaderStage returns the stage compiling shader belongs to.
+func (c *Context) shaderStage(shader *Shader) ShaderStage {
+	switch Enum(c.GetShaderParameteri(shader, c.SHADER_TYPE)) {
+	case c.VERTEX_SHADER:
+		return VertexShaderStage
+	case c.FRAGMENT_SHADER:
+		return FragmentShaderStage
+	}
+	return ShaderStage("shader")
+}
+
+// infoLogLength returns the length of an info log or source including its
+// terminating null, or 0 if it is empty.
+func infoLogLength(s string) int {
+	if s == "" {
+		return 0
+	}
+	return len(s) + 1
+}
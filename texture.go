@@ -0,0 +1,192 @@
+package gl
+
+import (
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+)
+
+// bytesPerPixel returns the size of one pixel stored with the given format and
+// type, or 0 if the combination isn't known.
+func (c *Context) bytesPerPixel(format, kind Enum) int {
+	switch kind {
+	case c.UNSIGNED_BYTE:
+		switch format {
+		case c.ALPHA, c.LUMINANCE:
+			return 1
+		case c.LUMINANCE_ALPHA:
+			return 2
+		case c.RGB:
+			return 3
+		case c.RGBA:
+			return 4
+		}
+	case c.UNSIGNED_SHORT_4_4_4_4, c.UNSIGNED_SHORT_5_5_5_1, c.UNSIGNED_SHORT_5_6_5:
+		return 2
+	}
+	return 0
+}
+
+// texturePixels returns the pixels of data as tightly packed rows of width
+// pixels, ready to be uploaded to a texture. data is either a []byte, which
+// is used as is, or an image.Image whose top-left width×height rectangle is
+// converted to format and kind.
+func (c *Context) texturePixels(data interface{}, width, height int, format, kind Enum) ([]byte, error) {
+	bpp := c.bytesPerPixel(format, kind)
+	switch d := data.(type) {
+	case []byte:
+		if bpp != 0 && len(d) < bpp*width*height {
+			return nil, fmt.Errorf("gl: data holds %d bytes, %dx%d pixels of %d bytes need %d", len(d), width, height, bpp, bpp*width*height)
+		}
+		return d, nil
+	case image.Image:
+		if b := d.Bounds(); b.Dx() < width || b.Dy() < height {
+			return nil, fmt.Errorf("gl: %T of size %dx%d is smaller than %dx%d", d, b.Dx(), b.Dy(), width, height)
+		}
+		pix, stride, err := c.imagePixels(d, format, kind)
+		if err != nil {
+			return nil, err
+		}
+		return packRows(pix, stride, bpp*width, height), nil
+	}
+	return nil, fmt.Errorf("gl: image type unsupported: %T", data)
+}
+
+// imagePixels returns the pixels of img stored with the given format and type,
+// top row first, along with the number of bytes between the start of two
+// rows. The image's own pixel slice is returned when it already has the
+// requested layout, so stride may be larger than a row for sub-images.
+//
+// Images that store premultiplied alpha (*image.RGBA, *image.RGBA64) keep it,
+// all other images are uploaded with non-premultiplied alpha.
+func (c *Context) imagePixels(img image.Image, format, kind Enum) (pix []byte, stride int, err error) {
+	bpp := c.bytesPerPixel(format, kind)
+	if kind != c.UNSIGNED_BYTE || bpp == 0 {
+		return nil, 0, fmt.Errorf("gl: can't upload %T as format 0x%X, type 0x%X", img, int(format), int(kind))
+	}
+
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	if w <= 0 || h <= 0 {
+		return nil, 0, nil
+	}
+
+	// Layouts that can be uploaded without a copy.
+	switch i := img.(type) {
+	case *image.RGBA:
+		if format == c.RGBA {
+			return i.Pix, i.Stride, nil
+		}
+	case *image.NRGBA:
+		if format == c.RGBA {
+			return i.Pix, i.Stride, nil
+		}
+	case *image.Alpha:
+		if format == c.ALPHA {
+			return i.Pix, i.Stride, nil
+		}
+	case *image.Gray:
+		if format == c.LUMINANCE {
+			return i.Pix, i.Stride, nil
+		}
+	}
+
+	if format == c.RGBA {
+		return rgbaPixels(img), 4 * w, nil
+	}
+
+	pix = make([]byte, bpp*w*h)
+	i := 0
+	for y := b.Min.Y; y < b.Max.Y; y++ {
+		for x := b.Min.X; x < b.Max.X; x++ {
+			p := color.NRGBAModel.Convert(img.At(x, y)).(color.NRGBA)
+			switch format {
+			case c.ALPHA:
+				pix[i] = p.A
+			case c.LUMINANCE:
+				pix[i] = luminance(p)
+			case c.LUMINANCE_ALPHA:
+				pix[i] = luminance(p)
+				pix[i+1] = p.A
+			case c.RGB:
+				pix[i] = p.R
+				pix[i+1] = p.G
+				pix[i+2] = p.B
+			}
+			i += bpp
+		}
+	}
+	return pix, bpp * w, nil
+}
+
+// rgbaPixels converts img to tightly packed 8-bit RGBA.
+func rgbaPixels(img image.Image) []byte {
+	b := img.Bounds()
+	w, h := b.Dx(), b.Dy()
+	pix := make([]byte, 4*w*h)
+
+	switch i := img.(type) {
+	case *image.RGBA64, *image.NRGBA64:
+		// Both store big endian 16-bit channels, keep the high bytes.
+		var src []byte
+		var stride int
+		if r, ok := i.(*image.RGBA64); ok {
+			src, stride = r.Pix, r.Stride
+		} else {
+			n := i.(*image.NRGBA64)
+			src, stride = n.Pix, n.Stride
+		}
+		for y := 0; y < h; y++ {
+			row := src[y*stride:]
+			for x := 0; x < 4*w; x++ {
+				pix[4*w*y+x] = row[2*x]
+			}
+		}
+	case *image.Paletted:
+		palette := make([][4]byte, len(i.Palette))
+		for n, col := range i.Palette {
+			p := color.NRGBAModel.Convert(col).(color.NRGBA)
+			palette[n] = [4]byte{p.R, p.G, p.B, p.A}
+		}
+		for y := 0; y < h; y++ {
+			for x := 0; x < w; x++ {
+				idx := int(i.Pix[y*i.Stride+x])
+				if idx < len(palette) {
+					copy(pix[4*(w*y+x):], palette[idx][:])
+				}
+			}
+		}
+	case *image.YCbCr:
+		// YCbCr is opaque, so there is no difference between premultiplied
+		// and non-premultiplied alpha and draw's fast path can be used.
+		dst := &image.RGBA{Pix: pix, Stride: 4 * w, Rect: image.Rect(0, 0, w, h)}
+		draw.Draw(dst, dst.Rect, i, b.Min, draw.Src)
+	default:
+		dst := &image.NRGBA{Pix: pix, Stride: 4 * w, Rect: image.Rect(0, 0, w, h)}
+		draw.Draw(dst, dst.Rect, img, b.Min, draw.Src)
+	}
+	return pix
+}
+
+// luminance returns the luma of p, weighted like color.GrayModel.
+func luminance(p color.NRGBA) byte {
+	return byte((19595*uint32(p.R) + 38470*uint32(p.G) + 7471*uint32(p.B) + 1<<15) >> 16)
+}
+
+// packRows returns height rows of rowLen bytes, stored stride bytes apart in
+// pix, packed next to each other. pix is returned when no copy is needed.
+func packRows(pix []byte, stride, rowLen, height int) []byte {
+	if stride == rowLen || height <= 1 {
+		return pix[:rowLen*height]
+	}
+	packed := make([]byte, rowLen*height)
+	for y := 0; y < height; y++ {
+		copy(packed[y*rowLen:(y+1)*rowLen], pix[y*stride:y*stride+rowLen])
+	}
+	return packed
+}
+
+func isPowerOfTwo(n int) bool {
+	return n > 0 && n&(n-1) == 0
+}
@@ -0,0 +1,261 @@
+package gl
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"testing"
+)
+
+func TestPackRows(t *testing.T) {
+	for _, test := range []struct {
+		name                   string
+		pix                    []byte
+		stride, rowLen, height int
+		want                   []byte
+	}{
+		{
+			name:   "packed",
+			pix:    []byte{1, 2, 3, 4, 5, 6},
+			stride: 3, rowLen: 3, height: 2,
+			want: []byte{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:   "padded",
+			pix:    []byte{1, 2, 0, 3, 4, 0, 5, 6},
+			stride: 3, rowLen: 2, height: 3,
+			want: []byte{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:   "one row",
+			pix:    []byte{1, 2, 0, 0},
+			stride: 4, rowLen: 2, height: 1,
+			want: []byte{1, 2},
+		},
+		{
+			name:   "trailing bytes",
+			pix:    []byte{1, 2, 3, 4, 9, 9},
+			stride: 2, rowLen: 2, height: 2,
+			want: []byte{1, 2, 3, 4},
+		},
+	} {
+		if got := packRows(test.pix, test.stride, test.rowLen, test.height); !bytes.Equal(got, test.want) {
+			t.Errorf("%s: packRows returned %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestTexturePixels(t *testing.T) {
+	c := newConstantsContext()
+	img := image.NewNRGBA(image.Rect(0, 0, 3, 2))
+	for i := range img.Pix {
+		img.Pix[i] = byte(i)
+	}
+	for _, test := range []struct {
+		name          string
+		data          interface{}
+		width, height int
+		format, kind  Enum
+		want          []byte
+		fails         bool
+	}{
+		{
+			name:  "bytes",
+			data:  []byte{1, 2, 3, 4, 5, 6},
+			width: 3, height: 2,
+			format: c.LUMINANCE, kind: c.UNSIGNED_BYTE,
+			want: []byte{1, 2, 3, 4, 5, 6},
+		},
+		{
+			name:  "short bytes",
+			data:  []byte{1, 2, 3},
+			width: 2, height: 1,
+			format: c.RGB, kind: c.UNSIGNED_BYTE,
+			fails: true,
+		},
+		{
+			name:  "packed 16-bit bytes",
+			data:  []byte{1, 2, 3, 4},
+			width: 2, height: 1,
+			format: c.RGB, kind: c.UNSIGNED_SHORT_5_6_5,
+			want: []byte{1, 2, 3, 4},
+		},
+		{
+			name:  "top-left rectangle",
+			data:  img,
+			width: 2, height: 2,
+			format: c.RGBA, kind: c.UNSIGNED_BYTE,
+			want: []byte{0, 1, 2, 3, 4, 5, 6, 7, 12, 13, 14, 15, 16, 17, 18, 19},
+		},
+		{
+			name:  "image too small",
+			data:  img,
+			width: 4, height: 2,
+			format: c.RGBA, kind: c.UNSIGNED_BYTE,
+			fails: true,
+		},
+		{
+			name:  "unsupported type",
+			data:  "pixels",
+			width: 1, height: 1,
+			format: c.RGBA, kind: c.UNSIGNED_BYTE,
+			fails: true,
+		},
+	} {
+		got, err := c.texturePixels(test.data, test.width, test.height, test.format, test.kind)
+		if test.fails {
+			if err == nil {
+				t.Errorf("%s: texturePixels didn't fail", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+		} else if !bytes.Equal(got, test.want) {
+			t.Errorf("%s: texturePixels returned %v, want %v", test.name, got, test.want)
+		}
+	}
+}
+
+func TestImagePixels(t *testing.T) {
+	c := newConstantsContext()
+
+	rgba := image.NewRGBA(image.Rect(0, 0, 4, 4))
+	for i := range rgba.Pix {
+		rgba.Pix[i] = byte(i)
+	}
+	sub := rgba.SubImage(image.Rect(1, 2, 3, 4))
+
+	paletted := image.NewPaletted(image.Rect(0, 0, 2, 1), color.Palette{
+		color.NRGBA{255, 0, 0, 255},
+		color.NRGBA{0, 0, 255, 128},
+	})
+	paletted.Pix = []byte{1, 0}
+	outOfRange := image.NewPaletted(image.Rect(0, 0, 1, 1), color.Palette{color.Black})
+	outOfRange.Pix = []byte{5}
+
+	alpha := image.NewAlpha(image.Rect(0, 0, 3, 3))
+	gray := image.NewGray(image.Rect(0, 0, 2, 2))
+
+	ycbcr := image.NewYCbCr(image.Rect(0, 0, 2, 1), image.YCbCrSubsampleRatio444)
+	for i := range ycbcr.Y {
+		ycbcr.Y[i], ycbcr.Cb[i], ycbcr.Cr[i] = 128, 128, 128
+	}
+
+	rgba64 := image.NewRGBA64(image.Rect(0, 0, 2, 2))
+	rgba64.SetRGBA64(1, 1, color.RGBA64{0x1234, 0x5678, 0x9ABC, 0xDEF0})
+	nrgba64 := image.NewNRGBA64(image.Rect(0, 0, 1, 1))
+	nrgba64.SetNRGBA64(0, 0, color.NRGBA64{0xFF00, 0x80FF, 0x0001, 0x7F7F})
+
+	nrgba := image.NewNRGBA(image.Rect(0, 0, 1, 1))
+	nrgba.SetNRGBA(0, 0, color.NRGBA{200, 100, 50, 128})
+
+	for _, test := range []struct {
+		name         string
+		img          image.Image
+		format, kind Enum
+		want         []byte
+		stride       int
+		fails        bool
+	}{
+		{
+			name:   "RGBA sub-image in place",
+			img:    sub,
+			format: c.RGBA, kind: c.UNSIGNED_BYTE,
+			want:   rgba.Pix[36:],
+			stride: 16,
+		},
+		{
+			name:   "alpha in place",
+			img:    alpha.SubImage(image.Rect(1, 1, 3, 3)),
+			format: c.ALPHA, kind: c.UNSIGNED_BYTE,
+			stride: 3,
+		},
+		{
+			name:   "gray in place",
+			img:    gray,
+			format: c.LUMINANCE, kind: c.UNSIGNED_BYTE,
+			stride: 2,
+		},
+		{
+			name:   "paletted",
+			img:    paletted,
+			format: c.RGBA, kind: c.UNSIGNED_BYTE,
+			want:   []byte{0, 0, 255, 128, 255, 0, 0, 255},
+			stride: 8,
+		},
+		{
+			name:   "paletted index out of range",
+			img:    outOfRange,
+			format: c.RGBA, kind: c.UNSIGNED_BYTE,
+			want:   []byte{0, 0, 0, 0},
+			stride: 4,
+		},
+		{
+			name:   "YCbCr",
+			img:    ycbcr,
+			format: c.RGBA, kind: c.UNSIGNED_BYTE,
+			want:   []byte{128, 128, 128, 255, 128, 128, 128, 255},
+			stride: 8,
+		},
+		{
+			name:   "RGBA64 sub-image",
+			img:    rgba64.SubImage(image.Rect(1, 1, 2, 2)),
+			format: c.RGBA, kind: c.UNSIGNED_BYTE,
+			want:   []byte{0x12, 0x56, 0x9A, 0xDE},
+			stride: 4,
+		},
+		{
+			name:   "NRGBA64",
+			img:    nrgba64,
+			format: c.RGBA, kind: c.UNSIGNED_BYTE,
+			want:   []byte{0xFF, 0x80, 0x00, 0x7F},
+			stride: 4,
+		},
+		{
+			name:   "RGB",
+			img:    nrgba,
+			format: c.RGB, kind: c.UNSIGNED_BYTE,
+			want:   []byte{200, 100, 50},
+			stride: 3,
+		},
+		{
+			name:   "luminance alpha",
+			img:    nrgba,
+			format: c.LUMINANCE_ALPHA, kind: c.UNSIGNED_BYTE,
+			want:   []byte{124, 128},
+			stride: 2,
+		},
+		{
+			name:   "alpha",
+			img:    nrgba,
+			format: c.ALPHA, kind: c.UNSIGNED_BYTE,
+			want:   []byte{128},
+			stride: 1,
+		},
+		{
+			name:   "packed 16-bit type",
+			img:    nrgba,
+			format: c.RGBA, kind: c.UNSIGNED_SHORT_4_4_4_4,
+			fails: true,
+		},
+	} {
+		pix, stride, err := c.imagePixels(test.img, test.format, test.kind)
+		if test.fails {
+			if err == nil {
+				t.Errorf("%s: imagePixels didn't fail", test.name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", test.name, err)
+			continue
+		}
+		if stride != test.stride {
+			t.Errorf("%s: stride is %d, want %d", test.name, stride, test.stride)
+		}
+		if n := len(test.want); len(pix) < n || !bytes.Equal(pix[:n], test.want) {
+			t.Errorf("%s: imagePixels returned %v, want %v", test.name, pix, test.want)
+		}
+	}
+}
@@ -0,0 +1,1094 @@
+package gl
+
+import (
+	"image"
+)
+
+// Call is a call of the Renderer interface recorded by a TraceContext, or the
+// end of a frame recorded by EndFrame. The Mock of a nogl Context records
+// Calls too, holding the values passed and returned as they are.
+//
+// Args and Results hold the arguments and return values of the call, with
+// the following types: nil, bool, int, float32, string, []byte, []bool,
+// []float32, []int32, []string, Handle, []Handle and *TraceImage. Enums and
+// other integers are recorded as int, objects as their Handle, errors as
+// their message, and slices are copied. Data uploaded by BufferData and
+// BufferSubData is recorded as its little endian bytes, the int size
+// BufferData may be given excepted. An image.Image passed to TexImage2D is
+// recorded as a *TraceImage, the data of TexSubImage2D as the pixels the
+// backend uploads. Data that can't be recorded, such as a js.Value, is
+// recorded as nil.
+type Call struct {
+	Method  string
+	Args    []interface{}
+	Results []interface{}
+}
+
+// Handle identifies an object (a texture, buffer, framebuffer, renderbuffer,
+// shader, program or uniform location) in a trace. Handles are numbered by
+// the TraceContext in the order it first sees the objects, independently of
+// the names the backend gives them, and 0 stands for nil.
+type Handle uint32
+
+// TraceImage is the image.Image passed to TexImage2D, recorded as 8-bit RGBA
+// pixels, top row first and tightly packed. Premultiplied tells whether the
+// colors have been multiplied by alpha, as in an *image.RGBA, or not, as in an
+// *image.NRGBA. Image returns an image the backends upload the same way as
+// the original.
+type TraceImage struct {
+	Width         int
+	Height        int
+	Pix           []byte
+	Premultiplied bool
+}
+
+// newTraceImage records img.
+func newTraceImage(img image.Image) *TraceImage {
+	b := img.Bounds()
+	t := &TraceImage{Width: b.Dx(), Height: b.Dy()}
+	switch i := img.(type) {
+	case *image.RGBA:
+		t.Pix = append([]byte(nil), packRows(i.Pix, i.Stride, 4*t.Width, t.Height)...)
+		t.Premultiplied = true
+	case *image.RGBA64:
+		t.Pix, t.Premultiplied = rgbaPixels(i), true
+	default:
+		t.Pix = rgbaPixels(img)
+	}
+	return t
+}
+
+// Image returns the recorded image as an *image.RGBA or *image.NRGBA.
+func (t *TraceImage) Image() image.Image {
+	r := image.Rect(0, 0, t.Width, t.Height)
+	if t.Premultiplied {
+		return &image.RGBA{Pix: t.Pix, Stride: 4 * t.Width, Rect: r}
+	}
+	return &image.NRGBA{Pix: t.Pix, Stride: 4 * t.Width, Rect: r}
+}
+
+// CallWriter is where a TraceContext records calls.
+type CallWriter interface {
+	WriteCall(call *Call) error
+}
+
+// CallLog is a CallWriter that keeps the calls in memory.
+type CallLog []*Call
+
+func (l *CallLog) WriteCall(call *Call) error {
+	*l = append(*l, call)
+	return nil
+}
+
+// TraceContext wraps a Context and records every call of the Renderer
+// interface, with its arguments and results, to a CallWriter after
+// forwarding it to the wrapped Context. The trace lets a bug report be
+// reproduced, frames be compared between releases and shows what was sent to
+// the driver, on every backend including WebGL and mobile.
+//
+// Calls are only recorded when made through the TraceContext: methods that
+// aren't part of the Renderer interface, and the calls Renderer methods such
+// as BuildProgram make on the wrapped Context, are not. Lookups that don't
+// reach the driver, such as EnumName, are not recorded either.
+//
+// Objects are told apart by what they refer to in the backend rather than by
+// pointer, so an object gets the same Handle however many times it was looked
+// up, e.g. with GetUniformLocation or GetAttachedShaders.
+type TraceContext struct {
+	*Context
+	w   CallWriter
+	err error
+
+	// handles holds the Handles of the objects by objectKey, except for
+	// uniform locations, which are only unique within their program and are
+	// keyed by a traceLocation. uniforms holds the Handles of the uniform
+	// locations by program and name, as WebGL returns a new object for every
+	// lookup.
+	handles    map[interface{}]Handle
+	uniforms   map[traceUniform]Handle
+	lastHandle Handle
+
+	// program is the Handle of the program in use, which uniform locations
+	// passed to the Uniform methods belong to.
+	program Handle
+}
+
+// traceLocation is the key of a uniform location in TraceContext.handles.
+type traceLocation struct {
+	program  Handle
+	location interface{}
+}
+
+// traceUniform identifies a uniform by program and name.
+type traceUniform struct {
+	program Handle
+	name    string
+}
+
+var _ Renderer = (*TraceContext)(nil)
+
+// NewTraceContext returns a TraceContext wrapping c that records the calls
+// to w.
+func NewTraceContext(c *Context, w CallWriter) *TraceContext {
+	return &TraceContext{
+		Context:  c,
+		w:        w,
+		handles:  make(map[interface{}]Handle),
+		uniforms: make(map[traceUniform]Handle),
+	}
+}
+
+// Err returns the first error returned by the CallWriter. Calls are no
+// longer recorded after an error, but still forwarded.
+func (t *TraceContext) Err() error {
+	return t.err
+}
+
+// EndFrame records the end of a frame, which lets the calls of each frame be
+// told apart in the trace. It doesn't call the wrapped Context.
+func (t *TraceContext) EndFrame() {
+	t.record(endFrame, nil)
+}
+
+// endFrame is the Method of the Call recorded by EndFrame.
+const endFrame = "EndFrame"
+
+// args returns its arguments, for recording them.
+func args(v ...interface{}) []interface{} {
+	return v
+}
+
+// record writes the call method(args...) returning results to the trace.
+func (t *TraceContext) record(method string, args []interface{}, results ...interface{}) {
+	if t.err != nil {
+		return
+	}
+	call := &Call{Method: method}
+	if len(args) > 0 {
+		call.Args = make([]interface{}, len(args))
+		for i, arg := range args {
+			call.Args[i] = t.value(arg)
+		}
+	}
+	if len(results) > 0 {
+		call.Results = make([]interface{}, len(results))
+		for i, result := range results {
+			call.Results[i] = t.value(result)
+		}
+	}
+	t.err = t.w.WriteCall(call)
+}
+
+// value converts an argument or result to the type it is recorded as.
+func (t *TraceContext) value(v interface{}) interface{} {
+	switch v := v.(type) {
+	case Enum:
+		return int(v)
+	case int32:
+		return int(v)
+	case []byte:
+		return append([]byte(nil), v...)
+	case []bool:
+		return append([]bool(nil), v...)
+	case []float32:
+		return append([]float32(nil), v...)
+	case []int32:
+		return append([]int32(nil), v...)
+	case []string:
+		return append([]string(nil), v...)
+	case [4]int32:
+		return append([]int32(nil), v[:]...)
+	case error:
+		return v.Error()
+	case *UniformLocation:
+		return t.location(t.program, v)
+	case *Texture, *Buffer, *FrameBuffer, *RenderBuffer, *Shader, *Program:
+		return t.handle(v)
+	case []*Shader:
+		handles := make([]Handle, len(v))
+		for i, shader := range v {
+			handles[i] = t.handle(shader)
+		}
+		return handles
+	}
+	return v
+}
+
+// handle returns the Handle of the object pointer obj, numbering it if it is
+// new.
+func (t *TraceContext) handle(obj interface{}) Handle {
+	if isNilObject(obj) {
+		return 0
+	}
+	return t.handleOf(objectKey(obj))
+}
+
+// created numbers the object obj returned by a Create method afresh, as the
+// backend may give it the name of an object deleted before.
+func (t *TraceContext) created(obj interface{}) {
+	if !isNilObject(obj) {
+		t.lastHandle++
+		t.handles[objectKey(obj)] = t.lastHandle
+	}
+}
+
+// location returns the Handle of the location of a uniform of program,
+// numbering it if it is new.
+func (t *TraceContext) location(program Handle, location *UniformLocation) Handle {
+	if location == nil {
+		return 0
+	}
+	return t.handleOf(traceLocation{program, objectKey(location)})
+}
+
+// handleOf returns the Handle of the object with the given key, numbering it
+// if it is new.
+func (t *TraceContext) handleOf(key interface{}) Handle {
+	h, ok := t.handles[key]
+	if !ok {
+		t.lastHandle++
+		h = t.lastHandle
+		t.handles[key] = h
+	}
+	return h
+}
+
+// isNilObject reports whether obj is a nil object pointer.
+func isNilObject(obj interface{}) bool {
+	switch o := obj.(type) {
+	case *Texture:
+		return o == nil
+	case *Buffer:
+		return o == nil
+	case *FrameBuffer:
+		return o == nil
+	case *RenderBuffer:
+		return o == nil
+	case *Shader:
+		return o == nil
+	case *Program:
+		return o == nil
+	case *UniformLocation:
+		return o == nil
+	}
+	return obj == nil
+}
+
+// bufferData returns how the data of BufferData or BufferSubData is recorded.
+func (t *TraceContext) bufferData(data interface{}) interface{} {
+	if size, ok := data.(int); ok {
+		return size
+	}
+	p, err := bufferBytes(data)
+	if err != nil {
+		return nil
+	}
+	return p
+}
+
+// textureImage returns how the data of TexImage2D is recorded.
+func (t *TraceContext) textureImage(data interface{}) interface{} {
+	if img, ok := data.(image.Image); ok {
+		return newTraceImage(img)
+	}
+	return nil
+}
+
+// texturePixels returns how the data of TexSubImage2D is recorded.
+func (t *TraceContext) texturePixels(data interface{}, width, height int, format, kind Enum) interface{} {
+	pix, err := t.Context.texturePixels(data, width, height, format, kind)
+	if err != nil {
+		return nil
+	}
+	return pix
+}
+
+func (t *TraceContext) BlendColor(r, g, b, a float32) {
+	t.Context.BlendColor(r, g, b, a)
+	t.record("BlendColor", args(r, g, b, a))
+}
+
+func (t *TraceContext) BlendEquation(mode Enum) {
+	t.Context.BlendEquation(mode)
+	t.record("BlendEquation", args(mode))
+}
+
+func (t *TraceContext) BlendEquationSeparate(modeRGB, modeAlpha Enum) {
+	t.Context.BlendEquationSeparate(modeRGB, modeAlpha)
+	t.record("BlendEquationSeparate", args(modeRGB, modeAlpha))
+}
+
+func (t *TraceContext) BlendFunc(sfactor, dfactor Enum) {
+	t.Context.BlendFunc(sfactor, dfactor)
+	t.record("BlendFunc", args(sfactor, dfactor))
+}
+
+func (t *TraceContext) BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha Enum) {
+	t.Context.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+	t.record("BlendFuncSeparate", args(srcRGB, dstRGB, srcAlpha, dstAlpha))
+}
+
+func (t *TraceContext) DepthFunc(fun Enum) {
+	t.Context.DepthFunc(fun)
+	t.record("DepthFunc", args(fun))
+}
+
+func (t *TraceContext) SampleCoverage(value float32, invert bool) {
+	t.Context.SampleCoverage(value, invert)
+	t.record("SampleCoverage", args(value, invert))
+}
+
+func (t *TraceContext) StencilFunc(function Enum, ref, mask int) {
+	t.Context.StencilFunc(function, ref, mask)
+	t.record("StencilFunc", args(function, ref, mask))
+}
+
+func (t *TraceContext) StencilFuncSeparate(face, function Enum, ref, mask int) {
+	t.Context.StencilFuncSeparate(face, function, ref, mask)
+	t.record("StencilFuncSeparate", args(face, function, ref, mask))
+}
+
+func (t *TraceContext) StencilOp(fail, zfail, zpass Enum) {
+	t.Context.StencilOp(fail, zfail, zpass)
+	t.record("StencilOp", args(fail, zfail, zpass))
+}
+
+func (t *TraceContext) StencilOpSeparate(face, fail, zfail, zpass Enum) {
+	t.Context.StencilOpSeparate(face, fail, zfail, zpass)
+	t.record("StencilOpSeparate", args(face, fail, zfail, zpass))
+}
+
+func (t *TraceContext) Clear(flags Enum) {
+	t.Context.Clear(flags)
+	t.record("Clear", args(flags))
+}
+
+func (t *TraceContext) ClearColor(r, g, b, a float32) {
+	t.Context.ClearColor(r, g, b, a)
+	t.record("ClearColor", args(r, g, b, a))
+}
+
+func (t *TraceContext) ClearDepth(depth float32) {
+	t.Context.ClearDepth(depth)
+	t.record("ClearDepth", args(depth))
+}
+
+func (t *TraceContext) ClearStencil(s int) {
+	t.Context.ClearStencil(s)
+	t.record("ClearStencil", args(s))
+}
+
+func (t *TraceContext) ColorMask(r, g, b, a bool) {
+	t.Context.ColorMask(r, g, b, a)
+	t.record("ColorMask", args(r, g, b, a))
+}
+
+func (t *TraceContext) DepthMask(flag bool) {
+	t.Context.DepthMask(flag)
+	t.record("DepthMask", args(flag))
+}
+
+func (t *TraceContext) StencilMask(mask int) {
+	t.Context.StencilMask(mask)
+	t.record("StencilMask", args(mask))
+}
+
+func (t *TraceContext) StencilMaskSeparate(face Enum, mask int) {
+	t.Context.StencilMaskSeparate(face, mask)
+	t.record("StencilMaskSeparate", args(face, mask))
+}
+
+func (t *TraceContext) BindFrameBuffer(fb *FrameBuffer) {
+	t.Context.BindFrameBuffer(fb)
+	t.record("BindFrameBuffer", args(fb))
+}
+
+func (t *TraceContext) CheckFramebufferStatus(target Enum) Enum {
+	r := t.Context.CheckFramebufferStatus(target)
+	t.record("CheckFramebufferStatus", args(target), r)
+	return r
+}
+
+func (t *TraceContext) CreateFrameBuffer() *FrameBuffer {
+	r := t.Context.CreateFrameBuffer()
+	t.created(r)
+	t.record("CreateFrameBuffer", nil, r)
+	return r
+}
+
+func (t *TraceContext) DeleteFrameBuffer(fb *FrameBuffer) {
+	t.Context.DeleteFrameBuffer(fb)
+	t.record("DeleteFrameBuffer", args(fb))
+}
+
+func (t *TraceContext) FrameBufferRenderBuffer(target, attachment Enum, rb *RenderBuffer) {
+	t.Context.FrameBufferRenderBuffer(target, attachment, rb)
+	t.record("FrameBufferRenderBuffer", args(target, attachment, rb))
+}
+
+func (t *TraceContext) FrameBufferTexture2D(target, attachment, texTarget Enum, texture *Texture, level int) {
+	t.Context.FrameBufferTexture2D(target, attachment, texTarget, texture, level)
+	t.record("FrameBufferTexture2D", args(target, attachment, texTarget, texture, level))
+}
+
+func (t *TraceContext) IsFramebuffer(fb *FrameBuffer) bool {
+	r := t.Context.IsFramebuffer(fb)
+	t.record("IsFramebuffer", args(fb), r)
+	return r
+}
+
+func (t *TraceContext) BindBuffer(target Enum, buffer *Buffer) {
+	t.Context.BindBuffer(target, buffer)
+	t.record("BindBuffer", args(target, buffer))
+}
+
+func (t *TraceContext) BufferData(target Enum, data interface{}, usage Enum) {
+	t.Context.BufferData(target, data, usage)
+	t.record("BufferData", args(target, t.bufferData(data), usage))
+}
+
+func (t *TraceContext) BufferSubData(target Enum, offset int, data interface{}) {
+	t.Context.BufferSubData(target, offset, data)
+	t.record("BufferSubData", args(target, offset, t.bufferData(data)))
+}
+
+func (t *TraceContext) CreateBuffer() *Buffer {
+	r := t.Context.CreateBuffer()
+	t.created(r)
+	t.record("CreateBuffer", nil, r)
+	return r
+}
+
+func (t *TraceContext) DeleteBuffer(buffer *Buffer) {
+	t.Context.DeleteBuffer(buffer)
+	t.record("DeleteBuffer", args(buffer))
+}
+
+func (t *TraceContext) GetBufferParameter(target, pname Enum) int {
+	r := t.Context.GetBufferParameter(target, pname)
+	t.record("GetBufferParameter", args(target, pname), r)
+	return r
+}
+
+func (t *TraceContext) IsBuffer(buffer *Buffer) bool {
+	r := t.Context.IsBuffer(buffer)
+	t.record("IsBuffer", args(buffer), r)
+	return r
+}
+
+func (t *TraceContext) DepthRange(zNear, zFar float32) {
+	t.Context.DepthRange(zNear, zFar)
+	t.record("DepthRange", args(zNear, zFar))
+}
+
+func (t *TraceContext) Scissor(x, y, width, height int) {
+	t.Context.Scissor(x, y, width, height)
+	t.record("Scissor", args(x, y, width, height))
+}
+
+func (t *TraceContext) Viewport(x, y, width, height int) {
+	t.Context.Viewport(x, y, width, height)
+	t.record("Viewport", args(x, y, width, height))
+}
+
+func (t *TraceContext) GetViewport() [4]int32 {
+	r := t.Context.GetViewport()
+	t.record("GetViewport", nil, r)
+	return r
+}
+
+func (t *TraceContext) CullFace(mode Enum) {
+	t.Context.CullFace(mode)
+	t.record("CullFace", args(mode))
+}
+
+func (t *TraceContext) FrontFace(mode Enum) {
+	t.Context.FrontFace(mode)
+	t.record("FrontFace", args(mode))
+}
+
+func (t *TraceContext) LineWidth(width float32) {
+	t.Context.LineWidth(width)
+	t.record("LineWidth", args(width))
+}
+
+func (t *TraceContext) PolygonOffset(factor, units float32) {
+	t.Context.PolygonOffset(factor, units)
+	t.record("PolygonOffset", args(factor, units))
+}
+
+func (t *TraceContext) AttachShader(program *Program, shader *Shader) {
+	t.Context.AttachShader(program, shader)
+	t.record("AttachShader", args(program, shader))
+}
+
+func (t *TraceContext) BindAttribLocation(program *Program, index int, name string) {
+	t.Context.BindAttribLocation(program, index, name)
+	t.record("BindAttribLocation", args(program, index, name))
+}
+
+func (t *TraceContext) BuildProgram(vertexSrc, fragmentSrc string) (*Program, error) {
+	r, err := t.Context.BuildProgram(vertexSrc, fragmentSrc)
+	t.record("BuildProgram", args(vertexSrc, fragmentSrc), r, err)
+	return r, err
+}
+
+func (t *TraceContext) CompileShader(shader *Shader) {
+	t.Context.CompileShader(shader)
+	t.record("CompileShader", args(shader))
+}
+
+func (t *TraceContext) CompileShaderErr(shader *Shader) error {
+	r := t.Context.CompileShaderErr(shader)
+	t.record("CompileShaderErr", args(shader), r)
+	return r
+}
+
+func (t *TraceContext) CreateProgram() *Program {
+	r := t.Context.CreateProgram()
+	t.created(r)
+	t.record("CreateProgram", nil, r)
+	return r
+}
+
+func (t *TraceContext) CreateShader(typ Enum) *Shader {
+	r := t.Context.CreateShader(typ)
+	t.created(r)
+	t.record("CreateShader", args(typ), r)
+	return r
+}
+
+func (t *TraceContext) DeleteProgram(program *Program) {
+	t.Context.DeleteProgram(program)
+	t.record("DeleteProgram", args(program))
+}
+
+func (t *TraceContext) DeleteShader(shader *Shader) {
+	t.Context.DeleteShader(shader)
+	t.record("DeleteShader", args(shader))
+}
+
+func (t *TraceContext) DetachShader(program *Program, shader *Shader) {
+	t.Context.DetachShader(program, shader)
+	t.record("DetachShader", args(program, shader))
+}
+
+func (t *TraceContext) GetAttachedShaders(program *Program) []*Shader {
+	r := t.Context.GetAttachedShaders(program)
+	t.record("GetAttachedShaders", args(program), r)
+	return r
+}
+
+func (t *TraceContext) GetProgramParameteri(program *Program, pname Enum) int {
+	r := t.Context.GetProgramParameteri(program, pname)
+	t.record("GetProgramParameteri", args(program, pname), r)
+	return r
+}
+
+func (t *TraceContext) GetProgramParameterb(program *Program, pname Enum) bool {
+	r := t.Context.GetProgramParameterb(program, pname)
+	t.record("GetProgramParameterb", args(program, pname), r)
+	return r
+}
+
+func (t *TraceContext) GetProgramInfoLog(program *Program) string {
+	r := t.Context.GetProgramInfoLog(program)
+	t.record("GetProgramInfoLog", args(program), r)
+	return r
+}
+
+func (t *TraceContext) GetShaderiv(shader *Shader, pname Enum) bool {
+	r := t.Context.GetShaderiv(shader, pname)
+	t.record("GetShaderiv", args(shader, pname), r)
+	return r
+}
+
+func (t *TraceContext) GetShaderParameteri(shader *Shader, pname Enum) int {
+	r := t.Context.GetShaderParameteri(shader, pname)
+	t.record("GetShaderParameteri", args(shader, pname), r)
+	return r
+}
+
+func (t *TraceContext) GetShaderInfoLog(shader *Shader) string {
+	r := t.Context.GetShaderInfoLog(shader)
+	t.record("GetShaderInfoLog", args(shader), r)
+	return r
+}
+
+func (t *TraceContext) GetShaderSource(shader *Shader) string {
+	r := t.Context.GetShaderSource(shader)
+	t.record("GetShaderSource", args(shader), r)
+	return r
+}
+
+func (t *TraceContext) IsProgram(program *Program) bool {
+	r := t.Context.IsProgram(program)
+	t.record("IsProgram", args(program), r)
+	return r
+}
+
+func (t *TraceContext) IsShader(shader *Shader) bool {
+	r := t.Context.IsShader(shader)
+	t.record("IsShader", args(shader), r)
+	return r
+}
+
+func (t *TraceContext) LinkProgram(program *Program) {
+	t.Context.LinkProgram(program)
+	t.record("LinkProgram", args(program))
+}
+
+func (t *TraceContext) LinkProgramErr(program *Program) error {
+	r := t.Context.LinkProgramErr(program)
+	t.record("LinkProgramErr", args(program), r)
+	return r
+}
+
+func (t *TraceContext) ShaderSource(shader *Shader, source string) {
+	t.Context.ShaderSource(shader, source)
+	t.record("ShaderSource", args(shader, source))
+}
+
+func (t *TraceContext) UseProgram(program *Program) {
+	t.Context.UseProgram(program)
+	t.program = t.handle(program)
+	t.record("UseProgram", args(program))
+}
+
+func (t *TraceContext) ValidateProgram(program *Program) {
+	t.Context.ValidateProgram(program)
+	t.record("ValidateProgram", args(program))
+}
+
+func (t *TraceContext) ActiveTexture(target Enum) {
+	t.Context.ActiveTexture(target)
+	t.record("ActiveTexture", args(target))
+}
+
+func (t *TraceContext) BindTexture(target Enum, texture *Texture) {
+	t.Context.BindTexture(target, texture)
+	t.record("BindTexture", args(target, texture))
+}
+
+func (t *TraceContext) CopyTexImage2D(target Enum, level int, internal Enum, x, y, w, h, border int) {
+	t.Context.CopyTexImage2D(target, level, internal, x, y, w, h, border)
+	t.record("CopyTexImage2D", args(target, level, internal, x, y, w, h, border))
+}
+
+func (t *TraceContext) CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, w, h int) {
+	t.Context.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, w, h)
+	t.record("CopyTexSubImage2D", args(target, level, xoffset, yoffset, x, y, w, h))
+}
+
+func (t *TraceContext) CreateTexture() *Texture {
+	r := t.Context.CreateTexture()
+	t.created(r)
+	t.record("CreateTexture", nil, r)
+	return r
+}
+
+func (t *TraceContext) DeleteTexture(texture *Texture) {
+	t.Context.DeleteTexture(texture)
+	t.record("DeleteTexture", args(texture))
+}
+
+func (t *TraceContext) GenerateMipmap(target Enum) {
+	t.Context.GenerateMipmap(target)
+	t.record("GenerateMipmap", args(target))
+}
+
+func (t *TraceContext) IsTexture(texture *Texture) bool {
+	r := t.Context.IsTexture(texture)
+	t.record("IsTexture", args(texture), r)
+	return r
+}
+
+func (t *TraceContext) TexImage2D(target Enum, level int, internalFormat, format, kind Enum, data interface{}) error {
+	r := t.Context.TexImage2D(target, level, internalFormat, format, kind, data)
+	t.record("TexImage2D", args(target, level, internalFormat, format, kind, t.textureImage(data)), r)
+	return r
+}
+
+func (t *TraceContext) TexImage2DEmpty(target Enum, level int, internalFormat, format, kind Enum, width, height int) {
+	t.Context.TexImage2DEmpty(target, level, internalFormat, format, kind, width, height)
+	t.record("TexImage2DEmpty", args(target, level, internalFormat, format, kind, width, height))
+}
+
+func (t *TraceContext) TexParameteri(target, pname, param Enum) {
+	t.Context.TexParameteri(target, pname, param)
+	t.record("TexParameteri", args(target, pname, param))
+}
+
+func (t *TraceContext) TexSubImage2D(target Enum, level, xoffset, yoffset, width, height int, format, kind Enum, data interface{}) error {
+	r := t.Context.TexSubImage2D(target, level, xoffset, yoffset, width, height, format, kind, data)
+	t.record("TexSubImage2D", args(target, level, xoffset, yoffset, width, height, format, kind, t.texturePixels(data, width, height, format, kind)), r)
+	return r
+}
+
+func (t *TraceContext) Disable(cap Enum) {
+	t.Context.Disable(cap)
+	t.record("Disable", args(cap))
+}
+
+func (t *TraceContext) Enable(cap Enum) {
+	t.Context.Enable(cap)
+	t.record("Enable", args(cap))
+}
+
+func (t *TraceContext) Finish() {
+	t.Context.Finish()
+	t.record("Finish", nil)
+}
+
+func (t *TraceContext) Flush() {
+	t.Context.Flush()
+	t.record("Flush", nil)
+}
+
+func (t *TraceContext) GetError() Enum {
+	r := t.Context.GetError()
+	t.record("GetError", nil, r)
+	return r
+}
+
+func (t *TraceContext) IsContextLost() bool {
+	r := t.Context.IsContextLost()
+	t.record("IsContextLost", nil, r)
+	return r
+}
+
+func (t *TraceContext) IsEnabled(cap Enum) bool {
+	r := t.Context.IsEnabled(cap)
+	t.record("IsEnabled", args(cap), r)
+	return r
+}
+
+func (t *TraceContext) PixelStorei(pname Enum, param int) {
+	t.Context.PixelStorei(pname, param)
+	t.record("PixelStorei", args(pname, param))
+}
+
+func (t *TraceContext) Capabilities() Capabilities {
+	r := t.Context.Capabilities()
+	t.record("Capabilities", nil)
+	return r
+}
+
+func (t *TraceContext) GetParameterBool(pname Enum) bool {
+	r := t.Context.GetParameterBool(pname)
+	t.record("GetParameterBool", args(pname), r)
+	return r
+}
+
+func (t *TraceContext) GetParameterBools(pname Enum) []bool {
+	r := t.Context.GetParameterBools(pname)
+	t.record("GetParameterBools", args(pname), r)
+	return r
+}
+
+func (t *TraceContext) GetParameterFloat(pname Enum) float32 {
+	r := t.Context.GetParameterFloat(pname)
+	t.record("GetParameterFloat", args(pname), r)
+	return r
+}
+
+func (t *TraceContext) GetParameterFloats(pname Enum) []float32 {
+	r := t.Context.GetParameterFloats(pname)
+	t.record("GetParameterFloats", args(pname), r)
+	return r
+}
+
+func (t *TraceContext) GetParameterInt(pname Enum) int {
+	r := t.Context.GetParameterInt(pname)
+	t.record("GetParameterInt", args(pname), r)
+	return r
+}
+
+func (t *TraceContext) GetParameterInts(pname Enum) []int32 {
+	r := t.Context.GetParameterInts(pname)
+	t.record("GetParameterInts", args(pname), r)
+	return r
+}
+
+func (t *TraceContext) GetParameterString(pname Enum) string {
+	r := t.Context.GetParameterString(pname)
+	t.record("GetParameterString", args(pname), r)
+	return r
+}
+
+func (t *TraceContext) Extensions() []string {
+	r := t.Context.Extensions()
+	t.record("Extensions", nil, r)
+	return r
+}
+
+func (t *TraceContext) GetSupportedExtensions() []string {
+	r := t.Context.GetSupportedExtensions()
+	t.record("GetSupportedExtensions", nil, r)
+	return r
+}
+
+func (t *TraceContext) HasExtension(name string) bool {
+	r := t.Context.HasExtension(name)
+	t.record("HasExtension", args(name), r)
+	return r
+}
+
+func (t *TraceContext) DisableVertexAttribArray(index int) {
+	t.Context.DisableVertexAttribArray(index)
+	t.record("DisableVertexAttribArray", args(index))
+}
+
+func (t *TraceContext) EnableVertexAttribArray(index int) {
+	t.Context.EnableVertexAttribArray(index)
+	t.record("EnableVertexAttribArray", args(index))
+}
+
+func (t *TraceContext) GetActiveAttrib(program *Program, index int) (name string, size int, typ Enum) {
+	name, size, typ = t.Context.GetActiveAttrib(program, index)
+	t.record("GetActiveAttrib", args(program, index), name, size, typ)
+	return name, size, typ
+}
+
+func (t *TraceContext) GetActiveUniform(program *Program, index int) (name string, size int, typ Enum) {
+	name, size, typ = t.Context.GetActiveUniform(program, index)
+	t.record("GetActiveUniform", args(program, index), name, size, typ)
+	return name, size, typ
+}
+
+func (t *TraceContext) GetAttribLocation(program *Program, name string) int {
+	r := t.Context.GetAttribLocation(program, name)
+	t.record("GetAttribLocation", args(program, name), r)
+	return r
+}
+
+func (t *TraceContext) GetUniformLocation(program *Program, name string) *UniformLocation {
+	r := t.Context.GetUniformLocation(program, name)
+	var h Handle
+	if r != nil {
+		p := t.handle(program)
+		u, key := traceUniform{p, name}, traceLocation{p, objectKey(r)}
+		var ok bool
+		if h, ok = t.uniforms[u]; ok {
+			t.handles[key] = h
+		} else {
+			h = t.handleOf(key)
+			t.uniforms[u] = h
+		}
+	}
+	t.record("GetUniformLocation", args(program, name), h)
+	return r
+}
+
+func (t *TraceContext) Uniform1f(location *UniformLocation, x float32) {
+	t.Context.Uniform1f(location, x)
+	t.record("Uniform1f", args(location, x))
+}
+
+func (t *TraceContext) Uniform1i(location *UniformLocation, x int) {
+	t.Context.Uniform1i(location, x)
+	t.record("Uniform1i", args(location, x))
+}
+
+func (t *TraceContext) Uniform1iTexture(location *UniformLocation, tex *Texture) {
+	t.Context.Uniform1iTexture(location, tex)
+	t.record("Uniform1iTexture", args(location, tex))
+}
+
+func (t *TraceContext) Uniform2f(location *UniformLocation, x, y float32) {
+	t.Context.Uniform2f(location, x, y)
+	t.record("Uniform2f", args(location, x, y))
+}
+
+func (t *TraceContext) Uniform2i(location *UniformLocation, x, y int) {
+	t.Context.Uniform2i(location, x, y)
+	t.record("Uniform2i", args(location, x, y))
+}
+
+func (t *TraceContext) Uniform3f(location *UniformLocation, x, y, z float32) {
+	t.Context.Uniform3f(location, x, y, z)
+	t.record("Uniform3f", args(location, x, y, z))
+}
+
+func (t *TraceContext) Uniform3i(location *UniformLocation, x, y, z int) {
+	t.Context.Uniform3i(location, x, y, z)
+	t.record("Uniform3i", args(location, x, y, z))
+}
+
+func (t *TraceContext) Uniform4f(location *UniformLocation, x, y, z, w float32) {
+	t.Context.Uniform4f(location, x, y, z, w)
+	t.record("Uniform4f", args(location, x, y, z, w))
+}
+
+func (t *TraceContext) Uniform4i(location *UniformLocation, x, y, z, w int) {
+	t.Context.Uniform4i(location, x, y, z, w)
+	t.record("Uniform4i", args(location, x, y, z, w))
+}
+
+func (t *TraceContext) Uniform1fv(location *UniformLocation, value []float32) {
+	t.Context.Uniform1fv(location, value)
+	t.record("Uniform1fv", args(location, value))
+}
+
+func (t *TraceContext) Uniform1iv(location *UniformLocation, value []int32) {
+	t.Context.Uniform1iv(location, value)
+	t.record("Uniform1iv", args(location, value))
+}
+
+func (t *TraceContext) Uniform2fv(location *UniformLocation, value []float32) {
+	t.Context.Uniform2fv(location, value)
+	t.record("Uniform2fv", args(location, value))
+}
+
+func (t *TraceContext) Uniform2iv(location *UniformLocation, value []int32) {
+	t.Context.Uniform2iv(location, value)
+	t.record("Uniform2iv", args(location, value))
+}
+
+func (t *TraceContext) Uniform3fv(location *UniformLocation, value []float32) {
+	t.Context.Uniform3fv(location, value)
+	t.record("Uniform3fv", args(location, value))
+}
+
+func (t *TraceContext) Uniform3iv(location *UniformLocation, value []int32) {
+	t.Context.Uniform3iv(location, value)
+	t.record("Uniform3iv", args(location, value))
+}
+
+func (t *TraceContext) Uniform4fv(location *UniformLocation, value []float32) {
+	t.Context.Uniform4fv(location, value)
+	t.record("Uniform4fv", args(location, value))
+}
+
+func (t *TraceContext) Uniform4iv(location *UniformLocation, value []int32) {
+	t.Context.Uniform4iv(location, value)
+	t.record("Uniform4iv", args(location, value))
+}
+
+func (t *TraceContext) UniformMatrix2fv(location *UniformLocation, transpose bool, value []float32) {
+	t.Context.UniformMatrix2fv(location, transpose, value)
+	t.record("UniformMatrix2fv", args(location, transpose, value))
+}
+
+func (t *TraceContext) UniformMatrix3fv(location *UniformLocation, transpose bool, value []float32) {
+	t.Context.UniformMatrix3fv(location, transpose, value)
+	t.record("UniformMatrix3fv", args(location, transpose, value))
+}
+
+func (t *TraceContext) UniformMatrix4fv(location *UniformLocation, transpose bool, value []float32) {
+	t.Context.UniformMatrix4fv(location, transpose, value)
+	t.record("UniformMatrix4fv", args(location, transpose, value))
+}
+
+func (t *TraceContext) VertexAttribPointer(index, size int, typ Enum, normal bool, stride, offset int) {
+	t.Context.VertexAttribPointer(index, size, typ, normal, stride, offset)
+	t.record("VertexAttribPointer", args(index, size, typ, normal, stride, offset))
+}
+
+func (t *TraceContext) VertexAttrib1f(index int, x float32) {
+	t.Context.VertexAttrib1f(index, x)
+	t.record("VertexAttrib1f", args(index, x))
+}
+
+func (t *TraceContext) VertexAttrib2f(index int, x, y float32) {
+	t.Context.VertexAttrib2f(index, x, y)
+	t.record("VertexAttrib2f", args(index, x, y))
+}
+
+func (t *TraceContext) VertexAttrib3f(index int, x, y, z float32) {
+	t.Context.VertexAttrib3f(index, x, y, z)
+	t.record("VertexAttrib3f", args(index, x, y, z))
+}
+
+func (t *TraceContext) VertexAttrib4f(index int, x, y, z, w float32) {
+	t.Context.VertexAttrib4f(index, x, y, z, w)
+	t.record("VertexAttrib4f", args(index, x, y, z, w))
+}
+
+func (t *TraceContext) VertexAttrib1fv(index int, value []float32) {
+	t.Context.VertexAttrib1fv(index, value)
+	t.record("VertexAttrib1fv", args(index, value))
+}
+
+func (t *TraceContext) VertexAttrib2fv(index int, value []float32) {
+	t.Context.VertexAttrib2fv(index, value)
+	t.record("VertexAttrib2fv", args(index, value))
+}
+
+func (t *TraceContext) VertexAttrib3fv(index int, value []float32) {
+	t.Context.VertexAttrib3fv(index, value)
+	t.record("VertexAttrib3fv", args(index, value))
+}
+
+func (t *TraceContext) VertexAttrib4fv(index int, value []float32) {
+	t.Context.VertexAttrib4fv(index, value)
+	t.record("VertexAttrib4fv", args(index, value))
+}
+
+func (t *TraceContext) GetVertexAttribi(index int, pname Enum) int {
+	r := t.Context.GetVertexAttribi(index, pname)
+	t.record("GetVertexAttribi", args(index, pname), r)
+	return r
+}
+
+func (t *TraceContext) GetVertexAttribfv(index int, pname Enum) []float32 {
+	r := t.Context.GetVertexAttribfv(index, pname)
+	t.record("GetVertexAttribfv", args(index, pname), r)
+	return r
+}
+
+func (t *TraceContext) BindRenderBuffer(rb *RenderBuffer) {
+	t.Context.BindRenderBuffer(rb)
+	t.record("BindRenderBuffer", args(rb))
+}
+
+func (t *TraceContext) CreateRenderBuffer() *RenderBuffer {
+	r := t.Context.CreateRenderBuffer()
+	t.created(r)
+	t.record("CreateRenderBuffer", nil, r)
+	return r
+}
+
+func (t *TraceContext) DeleteRenderBuffer(rb *RenderBuffer) {
+	t.Context.DeleteRenderBuffer(rb)
+	t.record("DeleteRenderBuffer", args(rb))
+}
+
+func (t *TraceContext) GetRenderbufferParameter(target, pname Enum) int {
+	r := t.Context.GetRenderbufferParameter(target, pname)
+	t.record("GetRenderbufferParameter", args(target, pname), r)
+	return r
+}
+
+func (t *TraceContext) IsRenderbuffer(rb *RenderBuffer) bool {
+	r := t.Context.IsRenderbuffer(rb)
+	t.record("IsRenderbuffer", args(rb), r)
+	return r
+}
+
+func (t *TraceContext) RenderBufferStorage(internalFormat Enum, width, height int) {
+	t.Context.RenderBufferStorage(internalFormat, width, height)
+	t.record("RenderBufferStorage", args(internalFormat, width, height))
+}
+
+func (t *TraceContext) DrawArrays(mode Enum, first, count int) {
+	t.Context.DrawArrays(mode, first, count)
+	t.record("DrawArrays", args(mode, first, count))
+}
+
+func (t *TraceContext) DrawElements(mode Enum, count int, typ Enum, offset int) {
+	t.Context.DrawElements(mode, count, typ, offset)
+	t.record("DrawElements", args(mode, count, typ, offset))
+}
+
+func (t *TraceContext) ReadPixels(x, y, width, height int) (*image.RGBA, error) {
+	r, err := t.Context.ReadPixels(x, y, width, height)
+	t.record("ReadPixels", args(x, y, width, height), nil, err)
+	return r, err
+}
+
+func (t *TraceContext) ReadPixelsInto(x, y, width, height int, pixels []byte) error {
+	r := t.Context.ReadPixelsInto(x, y, width, height, pixels)
+	t.record("ReadPixelsInto", args(x, y, width, height, len(pixels)), r)
+	return r
+}
@@ -0,0 +1,526 @@
+package gl
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+)
+
+// A trace file starts with traceMagic and the version of the format as a
+// little endian uint16, followed by the calls. Each call is its method, its
+// arguments and its results, where a string is its uvarint length followed by
+// its bytes and a list is its uvarint length followed by its elements. Each
+// value is a tag byte followed by its encoding:
+//
+//	traceNil, traceFalse, traceTrue   nothing
+//	traceInt                          varint
+//	traceFloat                        float32 bits, little endian
+//	traceString, traceBytes           uvarint length, bytes
+//	traceBools                        uvarint length, a byte per element
+//	traceFloats                       uvarint length, float32 bits per element
+//	traceInts                         uvarint length, varint per element
+//	traceStrings                      uvarint length, string per element
+//	traceHandle                       uvarint
+//	traceHandles                      uvarint length, uvarint per element
+//	traceImage                        uvarint width and height, a premultiplied
+//	                                  byte, uvarint length and pixels
+//
+// Versions only ever add tags, so readers accept traces of older versions.
+const (
+	traceMagic   = "GLTRACE\x00"
+	TraceVersion = 1
+)
+
+const (
+	traceNil byte = iota
+	traceFalse
+	traceTrue
+	traceInt
+	traceFloat
+	traceString
+	traceBytes
+	traceBools
+	traceFloats
+	traceInts
+	traceStrings
+	traceHandle
+	traceHandles
+	traceImage
+)
+
+// maxTraceLength bounds the length of the strings and lists read from a
+// trace. Memory is only allocated as their elements are read, so that a
+// corrupt length fails at the end of the input instead of exhausting memory.
+const maxTraceLength = 1 << 30
+
+// traceChunk is the number of elements of a list allocated before they are
+// read.
+const traceChunk = 1024
+
+// listCap returns the capacity to allocate for a list of n elements.
+func listCap(n int) int {
+	if n > traceChunk {
+		return traceChunk
+	}
+	return n
+}
+
+// TraceWriter is a CallWriter encoding the calls to a trace file.
+type TraceWriter struct {
+	w   *bufio.Writer
+	buf [binary.MaxVarintLen64]byte
+}
+
+// NewTraceWriter writes the header of a trace to w and returns a TraceWriter
+// writing the calls after it. Flush must be called once done.
+func NewTraceWriter(w io.Writer) (*TraceWriter, error) {
+	t := &TraceWriter{w: bufio.NewWriter(w)}
+	t.w.WriteString(traceMagic)
+	binary.LittleEndian.PutUint16(t.buf[:], TraceVersion)
+	if _, err := t.w.Write(t.buf[:2]); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Flush writes buffered calls to the underlying io.Writer.
+func (t *TraceWriter) Flush() error {
+	return t.w.Flush()
+}
+
+func (t *TraceWriter) WriteCall(call *Call) error {
+	t.string(call.Method)
+	if err := t.values(call.Args); err != nil {
+		return fmt.Errorf("gl: %s: %v", call.Method, err)
+	}
+	if err := t.values(call.Results); err != nil {
+		return fmt.Errorf("gl: %s: %v", call.Method, err)
+	}
+	// bufio.Writer keeps the first error and returns it from every Write.
+	_, err := t.w.Write(nil)
+	return err
+}
+
+func (t *TraceWriter) uvarint(v uint64) {
+	n := binary.PutUvarint(t.buf[:], v)
+	t.w.Write(t.buf[:n])
+}
+
+func (t *TraceWriter) varint(v int64) {
+	n := binary.PutVarint(t.buf[:], v)
+	t.w.Write(t.buf[:n])
+}
+
+func (t *TraceWriter) float(v float32) {
+	binary.LittleEndian.PutUint32(t.buf[:], math.Float32bits(v))
+	t.w.Write(t.buf[:4])
+}
+
+func (t *TraceWriter) string(s string) {
+	t.uvarint(uint64(len(s)))
+	t.w.WriteString(s)
+}
+
+func (t *TraceWriter) values(values []interface{}) error {
+	t.uvarint(uint64(len(values)))
+	for _, v := range values {
+		if err := t.value(v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (t *TraceWriter) value(v interface{}) error {
+	switch v := v.(type) {
+	case nil:
+		t.w.WriteByte(traceNil)
+	case bool:
+		if v {
+			t.w.WriteByte(traceTrue)
+		} else {
+			t.w.WriteByte(traceFalse)
+		}
+	case int:
+		t.w.WriteByte(traceInt)
+		t.varint(int64(v))
+	case float32:
+		t.w.WriteByte(traceFloat)
+		t.float(v)
+	case string:
+		t.w.WriteByte(traceString)
+		t.string(v)
+	case []byte:
+		t.w.WriteByte(traceBytes)
+		t.uvarint(uint64(len(v)))
+		t.w.Write(v)
+	case []bool:
+		t.w.WriteByte(traceBools)
+		t.uvarint(uint64(len(v)))
+		for _, b := range v {
+			if b {
+				t.w.WriteByte(1)
+			} else {
+				t.w.WriteByte(0)
+			}
+		}
+	case []float32:
+		t.w.WriteByte(traceFloats)
+		t.uvarint(uint64(len(v)))
+		for _, f := range v {
+			t.float(f)
+		}
+	case []int32:
+		t.w.WriteByte(traceInts)
+		t.uvarint(uint64(len(v)))
+		for _, i := range v {
+			t.varint(int64(i))
+		}
+	case []string:
+		t.w.WriteByte(traceStrings)
+		t.uvarint(uint64(len(v)))
+		for _, s := range v {
+			t.string(s)
+		}
+	case Handle:
+		t.w.WriteByte(traceHandle)
+		t.uvarint(uint64(v))
+	case []Handle:
+		t.w.WriteByte(traceHandles)
+		t.uvarint(uint64(len(v)))
+		for _, h := range v {
+			t.uvarint(uint64(h))
+		}
+	case *TraceImage:
+		t.w.WriteByte(traceImage)
+		t.uvarint(uint64(v.Width))
+		t.uvarint(uint64(v.Height))
+		if v.Premultiplied {
+			t.w.WriteByte(1)
+		} else {
+			t.w.WriteByte(0)
+		}
+		t.uvarint(uint64(len(v.Pix)))
+		t.w.Write(v.Pix)
+	default:
+		return fmt.Errorf("can't record a %T", v)
+	}
+	return nil
+}
+
+// ErrCorruptTrace is returned when reading a trace that isn't well-formed.
+var ErrCorruptTrace = errors.New("gl: corrupt trace")
+
+// TraceReader decodes the calls of a trace file.
+type TraceReader struct {
+	r       *bufio.Reader
+	version int
+}
+
+// NewTraceReader reads the header of the trace r and returns a TraceReader
+// decoding the calls after it.
+func NewTraceReader(r io.Reader) (*TraceReader, error) {
+	t := &TraceReader{r: bufio.NewReader(r)}
+	var header [len(traceMagic) + 2]byte
+	if _, err := io.ReadFull(t.r, header[:]); err != nil || string(header[:len(traceMagic)]) != traceMagic {
+		return nil, errors.New("gl: not a trace")
+	}
+	t.version = int(binary.LittleEndian.Uint16(header[len(traceMagic):]))
+	if t.version == 0 || t.version > TraceVersion {
+		return nil, fmt.Errorf("gl: unsupported trace version %d", t.version)
+	}
+	return t, nil
+}
+
+// Version returns the version of the format of the trace.
+func (t *TraceReader) Version() int {
+	return t.version
+}
+
+// ReadCall returns the next call of the trace, or io.EOF after the last.
+func (t *TraceReader) ReadCall() (*Call, error) {
+	if _, err := t.r.Peek(1); err == io.EOF {
+		return nil, io.EOF
+	}
+	method, err := t.string()
+	if err != nil {
+		return nil, err
+	}
+	call := &Call{Method: method}
+	if call.Args, err = t.values(); err != nil {
+		return nil, err
+	}
+	if call.Results, err = t.values(); err != nil {
+		return nil, err
+	}
+	return call, nil
+}
+
+// corrupt turns the errors of a truncated trace into ErrCorruptTrace.
+func corrupt(err error) error {
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return ErrCorruptTrace
+	}
+	return err
+}
+
+func (t *TraceReader) uvarint() (uint64, error) {
+	v, err := binary.ReadUvarint(t.r)
+	return v, corrupt(err)
+}
+
+func (t *TraceReader) varint() (int64, error) {
+	v, err := binary.ReadVarint(t.r)
+	return v, corrupt(err)
+}
+
+// length reads the length of a string or list.
+func (t *TraceReader) length() (int, error) {
+	n, err := t.uvarint()
+	if err == nil && n > maxTraceLength {
+		err = ErrCorruptTrace
+	}
+	return int(n), err
+}
+
+func (t *TraceReader) bytes() ([]byte, error) {
+	n, err := t.length()
+	if err != nil {
+		return nil, err
+	}
+	if n <= traceChunk {
+		p := make([]byte, n)
+		_, err = io.ReadFull(t.r, p)
+		return p, corrupt(err)
+	}
+	var b bytes.Buffer
+	if _, err := io.CopyN(&b, t.r, int64(n)); err != nil {
+		return nil, corrupt(err)
+	}
+	return b.Bytes(), nil
+}
+
+func (t *TraceReader) string() (string, error) {
+	p, err := t.bytes()
+	return string(p), err
+}
+
+func (t *TraceReader) float() (float32, error) {
+	var p [4]byte
+	_, err := io.ReadFull(t.r, p[:])
+	return math.Float32frombits(binary.LittleEndian.Uint32(p[:])), corrupt(err)
+}
+
+func (t *TraceReader) byte() (byte, error) {
+	b, err := t.r.ReadByte()
+	return b, corrupt(err)
+}
+
+func (t *TraceReader) values() ([]interface{}, error) {
+	n, err := t.length()
+	if err != nil || n == 0 {
+		return nil, err
+	}
+	values := make([]interface{}, 0, listCap(n))
+	for i := 0; i < n; i++ {
+		v, err := t.value()
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func (t *TraceReader) value() (interface{}, error) {
+	tag, err := t.byte()
+	if err != nil {
+		return nil, err
+	}
+	switch tag {
+	case traceNil:
+		return nil, nil
+	case traceFalse, traceTrue:
+		return tag == traceTrue, nil
+	case traceInt:
+		v, err := t.varint()
+		return int(v), err
+	case traceFloat:
+		return t.float()
+	case traceString:
+		return t.string()
+	case traceBytes:
+		return t.bytes()
+	case traceHandle:
+		v, err := t.uvarint()
+		return Handle(v), err
+	case traceImage:
+		return t.image()
+	}
+
+	n, err := t.length()
+	if err != nil {
+		return nil, err
+	}
+	switch tag {
+	case traceBools:
+		v := make([]bool, 0, listCap(n))
+		for i := 0; i < n; i++ {
+			b, err := t.byte()
+			if err != nil {
+				return nil, err
+			}
+			v = append(v, b != 0)
+		}
+		return v, nil
+	case traceFloats:
+		v := make([]float32, 0, listCap(n))
+		for i := 0; i < n; i++ {
+			f, err := t.float()
+			if err != nil {
+				return nil, err
+			}
+			v = append(v, f)
+		}
+		return v, nil
+	case traceInts:
+		v := make([]int32, 0, listCap(n))
+		for i := 0; i < n; i++ {
+			x, err := t.varint()
+			if err != nil {
+				return nil, err
+			}
+			v = append(v, int32(x))
+		}
+		return v, nil
+	case traceStrings:
+		v := make([]string, 0, listCap(n))
+		for i := 0; i < n; i++ {
+			s, err := t.string()
+			if err != nil {
+				return nil, err
+			}
+			v = append(v, s)
+		}
+		return v, nil
+	case traceHandles:
+		v := make([]Handle, 0, listCap(n))
+		for i := 0; i < n; i++ {
+			h, err := t.uvarint()
+			if err != nil {
+				return nil, err
+			}
+			v = append(v, Handle(h))
+		}
+		return v, nil
+	}
+	return nil, ErrCorruptTrace
+}
+
+func (t *TraceReader) image() (*TraceImage, error) {
+	width, err := t.length()
+	if err != nil {
+		return nil, err
+	}
+	height, err := t.length()
+	if err != nil {
+		return nil, err
+	}
+	premultiplied, err := t.byte()
+	if err != nil {
+		return nil, err
+	}
+	pix, err := t.bytes()
+	if err != nil {
+		return nil, err
+	}
+	if len(pix) != 4*width*height {
+		return nil, ErrCorruptTrace
+	}
+	return &TraceImage{Width: width, Height: height, Pix: pix, Premultiplied: premultiplied != 0}, nil
+}
+
+// WriteTraceJSON writes the calls of r to w as JSON, one object per line with
+// the fields "method", "args" and "results". Handles are written as objects
+// {"handle": n}, images as objects with their "width", "height",
+// "premultiplied" and base64 "pix", and byte slices as base64 strings.
+func WriteTraceJSON(w io.Writer, r *TraceReader) error {
+	enc := json.NewEncoder(w)
+	for {
+		call, err := r.ReadCall()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if err := enc.Encode(jsonCall{call.Method, jsonValues(call.Args), jsonValues(call.Results)}); err != nil {
+			return err
+		}
+	}
+}
+
+type jsonCall struct {
+	Method  string        `json:"method"`
+	Args    []interface{} `json:"args,omitempty"`
+	Results []interface{} `json:"results,omitempty"`
+}
+
+type jsonHandle struct {
+	Handle Handle `json:"handle"`
+}
+
+type jsonImage struct {
+	Width         int    `json:"width"`
+	Height        int    `json:"height"`
+	Premultiplied bool   `json:"premultiplied"`
+	Pix           []byte `json:"pix"`
+}
+
+// jsonValues returns the values with handles and images replaced by their JSON
+// representation.
+func jsonValues(values []interface{}) []interface{} {
+	if len(values) == 0 {
+		return nil
+	}
+	out := make([]interface{}, len(values))
+	for i, v := range values {
+		switch v := v.(type) {
+		case Handle:
+			out[i] = jsonHandle{v}
+		case []Handle:
+			handles := make([]jsonHandle, len(v))
+			for j, h := range v {
+				handles[j] = jsonHandle{h}
+			}
+			out[i] = handles
+		case *TraceImage:
+			out[i] = jsonImage{v.Width, v.Height, v.Premultiplied, v.Pix}
+		case float32:
+			out[i] = jsonFloat(v)
+		case []float32:
+			floats := make([]interface{}, len(v))
+			for j, f := range v {
+				floats[j] = jsonFloat(f)
+			}
+			out[i] = floats
+		default:
+			out[i] = v
+		}
+	}
+	return out
+}
+
+// jsonFloat returns v, or its string representation if it is NaN or an
+// infinity, which can't be represented in JSON.
+func jsonFloat(v float32) interface{} {
+	if math.IsNaN(float64(v)) || math.IsInf(float64(v), 0) {
+		return fmt.Sprint(v)
+	}
+	return v
+}
@@ -0,0 +1,28 @@
+package gl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestTraceReaderCorrupt(t *testing.T) {
+	header := traceMagic + "\x01\x00"
+	for _, test := range []struct {
+		name, trace string
+	}{
+		{"method", header + "\x05Clea"},
+		{"long method", header + "\x80\x80\x80\x80\x04"},
+		{"args", header + "\x00\x80\x80\x80\x80\x04"},
+		{"bytes", header + "\x00\x01\x06\x80\x80\x80\x80\x04"},
+		{"floats", header + "\x00\x01\x08\x80\x80\x80\x80\x04\x00\x00"},
+		{"strings", header + "\x00\x01\x0a\x80\x80\x80\x80\x04\x01"},
+	} {
+		r, err := NewTraceReader(strings.NewReader(test.trace))
+		if err != nil {
+			t.Fatalf("%s: NewTraceReader: %v", test.name, err)
+		}
+		if _, err := r.ReadCall(); err != ErrCorruptTrace {
+			t.Errorf("%s: ReadCall returned %v, want ErrCorruptTrace", test.name, err)
+		}
+	}
+}
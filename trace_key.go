@@ -0,0 +1,27 @@
+//go:build !js || nogl || softgl
+// +build !js nogl softgl
+
+package gl
+
+// objectKey returns the object the object pointer obj points to, which tells
+// it apart from other objects in a map, as backends may return a new pointer
+// every time an object is looked up.
+func objectKey(obj interface{}) interface{} {
+	switch o := obj.(type) {
+	case *Texture:
+		return *o
+	case *Buffer:
+		return *o
+	case *FrameBuffer:
+		return *o
+	case *RenderBuffer:
+		return *o
+	case *Shader:
+		return *o
+	case *Program:
+		return *o
+	case *UniformLocation:
+		return *o
+	}
+	return obj
+}
@@ -0,0 +1,1240 @@
+package gl
+
+import (
+	"fmt"
+	"io"
+)
+
+// FrameStats counts the calls of a replayed frame.
+type FrameStats struct {
+	// Calls is the number of calls of the frame, and Methods the number of
+	// calls of each method.
+	Calls   int
+	Methods map[string]int
+}
+
+// Replayer replays recorded calls against a Renderer of any backend. Objects
+// are created anew by the replayed calls, and the handles of the trace are
+// mapped to them.
+//
+// Replay checks that each call is well-formed: that its method exists, that
+// its arguments have the recorded types, and that the objects it uses were
+// created by an earlier call. Replaying against the nogl backend thus checks a
+// trace without a GPU. Other values, such as attribute locations, are replayed
+// as recorded.
+type Replayer struct {
+	ctx     Renderer
+	objects map[Handle]interface{}
+	frames  []FrameStats
+	calls   int
+}
+
+// NewReplayer returns a Replayer replaying calls against ctx.
+func NewReplayer(ctx Renderer) *Replayer {
+	return &Replayer{ctx: ctx, objects: make(map[Handle]interface{})}
+}
+
+// ReplayTrace replays all the calls of r against ctx and returns the stats of
+// each frame.
+func ReplayTrace(ctx Renderer, r *TraceReader) ([]FrameStats, error) {
+	p := NewReplayer(ctx)
+	for {
+		call, err := r.ReadCall()
+		if err == io.EOF {
+			return p.Frames(), nil
+		}
+		if err != nil {
+			return p.Frames(), err
+		}
+		if err := p.Replay(call); err != nil {
+			return p.Frames(), err
+		}
+	}
+}
+
+// Frames returns the stats of the frames replayed so far, ended by an
+// EndFrame call, followed by those of the current frame if it has calls.
+func (p *Replayer) Frames() []FrameStats {
+	frames := p.frames
+	if n := len(frames); n > 0 && frames[n-1].Calls == 0 {
+		frames = frames[:n-1]
+	}
+	return frames
+}
+
+// Replay replays a call. It returns an error, and doesn't make the call, if
+// the call isn't well-formed.
+func (p *Replayer) Replay(call *Call) error {
+	p.calls++
+	if len(p.frames) == 0 {
+		p.frames = append(p.frames, FrameStats{Methods: make(map[string]int)})
+	}
+	if call.Method == endFrame {
+		p.frames = append(p.frames, FrameStats{Methods: make(map[string]int)})
+		return nil
+	}
+	if err := p.replay(call); err != nil {
+		return fmt.Errorf("gl: call %d, %s: %v", p.calls, call.Method, err)
+	}
+	frame := &p.frames[len(p.frames)-1]
+	frame.Calls++
+	frame.Methods[call.Method]++
+	return nil
+}
+
+// create maps the handle returned by call to obj, created by replaying it.
+func (p *Replayer) create(call *Call, obj interface{}) error {
+	if len(call.Results) == 0 {
+		return fmt.Errorf("missing result")
+	}
+	h, ok := call.Results[0].(Handle)
+	if !ok {
+		return fmt.Errorf("result is a %T, not a Handle", call.Results[0])
+	}
+	if _, ok := p.objects[h]; ok {
+		return fmt.Errorf("handle %d is created twice", h)
+	}
+	// An object the backend failed to create is mapped to nil, so the calls
+	// using it are still made and fail like they would have.
+	if h != 0 {
+		p.objects[h] = obj
+	}
+	return nil
+}
+
+// bind maps the handles returned by call, which may have been returned
+// before, to obj: a *UniformLocation or []*Shader.
+func (p *Replayer) bind(call *Call, obj interface{}) {
+	if len(call.Results) == 0 {
+		return
+	}
+	switch h := call.Results[0].(type) {
+	case Handle:
+		if h != 0 {
+			p.objects[h] = obj
+		}
+	case []Handle:
+		shaders, _ := obj.([]*Shader)
+		for i := 0; i < len(h) && i < len(shaders); i++ {
+			if h[i] != 0 && shaders[i] != nil {
+				p.objects[h[i]] = shaders[i]
+			}
+		}
+	}
+}
+
+// replayArgs decodes the arguments of a call. Decoding methods return the zero
+// value and record an error when the argument is missing or has the wrong
+// type; check reports it.
+type replayArgs struct {
+	p    *Replayer
+	args []interface{}
+	err  error
+}
+
+// arg returns argument i, or nil and false if there is no such argument.
+func (in *replayArgs) arg(i int) (interface{}, bool) {
+	if i >= len(in.args) {
+		in.fail(fmt.Errorf("missing argument %d", i))
+		return nil, false
+	}
+	return in.args[i], true
+}
+
+func (in *replayArgs) fail(err error) {
+	if in.err == nil {
+		in.err = err
+	}
+}
+
+// typeError records that argument i doesn't have the type want.
+func (in *replayArgs) typeError(i int, want string) {
+	in.fail(fmt.Errorf("argument %d is a %T, not a %s", i, in.args[i], want))
+}
+
+// check returns the first decoding error, or an error if the call doesn't have
+// n arguments.
+func (in *replayArgs) check(n int) error {
+	if in.err == nil && len(in.args) != n {
+		in.err = fmt.Errorf("%d arguments, want %d", len(in.args), n)
+	}
+	return in.err
+}
+
+func (in *replayArgs) integer(i int) int {
+	v, ok := in.arg(i)
+	n, isInt := v.(int)
+	if ok && !isInt {
+		in.typeError(i, "int")
+	}
+	return n
+}
+
+func (in *replayArgs) enum(i int) Enum {
+	return Enum(in.integer(i))
+}
+
+func (in *replayArgs) float(i int) float32 {
+	v, ok := in.arg(i)
+	f, isFloat := v.(float32)
+	if ok && !isFloat {
+		in.typeError(i, "float32")
+	}
+	return f
+}
+
+func (in *replayArgs) boolean(i int) bool {
+	v, ok := in.arg(i)
+	b, isBool := v.(bool)
+	if ok && !isBool {
+		in.typeError(i, "bool")
+	}
+	return b
+}
+
+func (in *replayArgs) str(i int) string {
+	v, ok := in.arg(i)
+	s, isString := v.(string)
+	if ok && !isString {
+		in.typeError(i, "string")
+	}
+	return s
+}
+
+func (in *replayArgs) floats(i int) []float32 {
+	v, ok := in.arg(i)
+	f, isFloats := v.([]float32)
+	if ok && v != nil && !isFloats {
+		in.typeError(i, "[]float32")
+	}
+	return f
+}
+
+func (in *replayArgs) ints(i int) []int32 {
+	v, ok := in.arg(i)
+	n, isInts := v.([]int32)
+	if ok && v != nil && !isInts {
+		in.typeError(i, "[]int32")
+	}
+	return n
+}
+
+// bufferData decodes the data of BufferData and BufferSubData. A size is
+// replayed as that many zero bytes, which all backends accept.
+func (in *replayArgs) bufferData(i int) interface{} {
+	v, _ := in.arg(i)
+	switch v := v.(type) {
+	case int:
+		if v < 0 {
+			in.fail(fmt.Errorf("negative buffer size %d", v))
+			return nil
+		}
+		return in.allocate(v)
+	case []byte, nil:
+		return v
+	}
+	in.typeError(i, "[]byte")
+	return nil
+}
+
+// image decodes the data of TexImage2D.
+func (in *replayArgs) image(i int) interface{} {
+	v, _ := in.arg(i)
+	switch v := v.(type) {
+	case *TraceImage:
+		return v.Image()
+	case nil:
+		return nil
+	}
+	in.typeError(i, "*TraceImage")
+	return nil
+}
+
+// pixels decodes the data of TexSubImage2D.
+func (in *replayArgs) pixels(i int) interface{} {
+	v, _ := in.arg(i)
+	switch v := v.(type) {
+	case []byte:
+		return v
+	case nil:
+		return nil
+	}
+	in.typeError(i, "[]byte")
+	return nil
+}
+
+// pixelBuffer decodes the length of the buffer passed to ReadPixelsInto.
+func (in *replayArgs) pixelBuffer(i int) []byte {
+	n := in.integer(i)
+	if n < 0 {
+		in.fail(fmt.Errorf("negative length %d", n))
+		return nil
+	}
+	return in.allocate(n)
+}
+
+// maxReplayLength bounds the size of the buffers allocated for the sizes
+// recorded in a trace, so that a corrupt size doesn't exhaust memory.
+const maxReplayLength = 1 << 28
+
+// allocate returns n zero bytes, failing if n is too large.
+func (in *replayArgs) allocate(n int) []byte {
+	if n > maxReplayLength {
+		in.fail(fmt.Errorf("buffer size %d too large", n))
+		return nil
+	}
+	return make([]byte, n)
+}
+
+// object returns the object of the handle argument i, or nil for handle 0.
+func (in *replayArgs) object(i int) interface{} {
+	v, ok := in.arg(i)
+	if !ok {
+		return nil
+	}
+	h, isHandle := v.(Handle)
+	if !isHandle {
+		in.typeError(i, "Handle")
+		return nil
+	}
+	if h == 0 {
+		return nil
+	}
+	obj, ok := in.p.objects[h]
+	if !ok {
+		in.fail(fmt.Errorf("handle %d wasn't created by an earlier call", h))
+	}
+	return obj
+}
+
+// objectError records that the object of argument i isn't of type want.
+func (in *replayArgs) objectError(i int, obj interface{}, want string) {
+	if obj != nil {
+		in.fail(fmt.Errorf("handle %v is a %T, not a %s", in.args[i], obj, want))
+	}
+}
+
+func (in *replayArgs) texture(i int) *Texture {
+	obj := in.object(i)
+	t, ok := obj.(*Texture)
+	if !ok {
+		in.objectError(i, obj, "*Texture")
+	}
+	return t
+}
+
+func (in *replayArgs) buffer(i int) *Buffer {
+	obj := in.object(i)
+	b, ok := obj.(*Buffer)
+	if !ok {
+		in.objectError(i, obj, "*Buffer")
+	}
+	return b
+}
+
+func (in *replayArgs) framebuffer(i int) *FrameBuffer {
+	obj := in.object(i)
+	fb, ok := obj.(*FrameBuffer)
+	if !ok {
+		in.objectError(i, obj, "*FrameBuffer")
+	}
+	return fb
+}
+
+func (in *replayArgs) renderbuffer(i int) *RenderBuffer {
+	obj := in.object(i)
+	rb, ok := obj.(*RenderBuffer)
+	if !ok {
+		in.objectError(i, obj, "*RenderBuffer")
+	}
+	return rb
+}
+
+func (in *replayArgs) shader(i int) *Shader {
+	obj := in.object(i)
+	s, ok := obj.(*Shader)
+	if !ok {
+		in.objectError(i, obj, "*Shader")
+	}
+	return s
+}
+
+func (in *replayArgs) program(i int) *Program {
+	obj := in.object(i)
+	p, ok := obj.(*Program)
+	if !ok {
+		in.objectError(i, obj, "*Program")
+	}
+	return p
+}
+
+func (in *replayArgs) location(i int) *UniformLocation {
+	obj := in.object(i)
+	l, ok := obj.(*UniformLocation)
+	if !ok {
+		in.objectError(i, obj, "*UniformLocation")
+	}
+	return l
+}
+
+// replay decodes the arguments of call and makes it.
+func (p *Replayer) replay(call *Call) error {
+	ctx, in := p.ctx, &replayArgs{p: p, args: call.Args}
+	switch call.Method {
+	case "BlendColor":
+		r, g, b, a := in.float(0), in.float(1), in.float(2), in.float(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.BlendColor(r, g, b, a)
+	case "BlendEquation":
+		mode := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.BlendEquation(mode)
+	case "BlendEquationSeparate":
+		modeRGB, modeAlpha := in.enum(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.BlendEquationSeparate(modeRGB, modeAlpha)
+	case "BlendFunc":
+		sfactor, dfactor := in.enum(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.BlendFunc(sfactor, dfactor)
+	case "BlendFuncSeparate":
+		srcRGB, dstRGB, srcAlpha, dstAlpha := in.enum(0), in.enum(1), in.enum(2), in.enum(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.BlendFuncSeparate(srcRGB, dstRGB, srcAlpha, dstAlpha)
+	case "DepthFunc":
+		fun := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.DepthFunc(fun)
+	case "SampleCoverage":
+		value, invert := in.float(0), in.boolean(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.SampleCoverage(value, invert)
+	case "StencilFunc":
+		function, ref, mask := in.enum(0), in.integer(1), in.integer(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.StencilFunc(function, ref, mask)
+	case "StencilFuncSeparate":
+		face, function, ref, mask := in.enum(0), in.enum(1), in.integer(2), in.integer(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.StencilFuncSeparate(face, function, ref, mask)
+	case "StencilOp":
+		fail, zfail, zpass := in.enum(0), in.enum(1), in.enum(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.StencilOp(fail, zfail, zpass)
+	case "StencilOpSeparate":
+		face, fail, zfail, zpass := in.enum(0), in.enum(1), in.enum(2), in.enum(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.StencilOpSeparate(face, fail, zfail, zpass)
+	case "Clear":
+		flags := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.Clear(flags)
+	case "ClearColor":
+		r, g, b, a := in.float(0), in.float(1), in.float(2), in.float(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.ClearColor(r, g, b, a)
+	case "ClearDepth":
+		depth := in.float(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.ClearDepth(depth)
+	case "ClearStencil":
+		s := in.integer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.ClearStencil(s)
+	case "ColorMask":
+		r, g, b, a := in.boolean(0), in.boolean(1), in.boolean(2), in.boolean(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.ColorMask(r, g, b, a)
+	case "DepthMask":
+		flag := in.boolean(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.DepthMask(flag)
+	case "StencilMask":
+		mask := in.integer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.StencilMask(mask)
+	case "StencilMaskSeparate":
+		face, mask := in.enum(0), in.integer(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.StencilMaskSeparate(face, mask)
+	case "BindFrameBuffer":
+		fb := in.framebuffer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.BindFrameBuffer(fb)
+	case "CheckFramebufferStatus":
+		target := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.CheckFramebufferStatus(target)
+	case "CreateFrameBuffer":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		return p.create(call, ctx.CreateFrameBuffer())
+	case "DeleteFrameBuffer":
+		fb := in.framebuffer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.DeleteFrameBuffer(fb)
+	case "FrameBufferRenderBuffer":
+		target, attachment, rb := in.enum(0), in.enum(1), in.renderbuffer(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.FrameBufferRenderBuffer(target, attachment, rb)
+	case "FrameBufferTexture2D":
+		target, attachment, texTarget, t, level := in.enum(0), in.enum(1), in.enum(2), in.texture(3), in.integer(4)
+		if err := in.check(5); err != nil {
+			return err
+		}
+		ctx.FrameBufferTexture2D(target, attachment, texTarget, t, level)
+	case "IsFramebuffer":
+		fb := in.framebuffer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.IsFramebuffer(fb)
+	case "BindBuffer":
+		target, buffer := in.enum(0), in.buffer(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.BindBuffer(target, buffer)
+	case "BufferData":
+		target, data, usage := in.enum(0), in.bufferData(1), in.enum(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.BufferData(target, data, usage)
+	case "BufferSubData":
+		target, offset, data := in.enum(0), in.integer(1), in.bufferData(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.BufferSubData(target, offset, data)
+	case "CreateBuffer":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		return p.create(call, ctx.CreateBuffer())
+	case "DeleteBuffer":
+		buffer := in.buffer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.DeleteBuffer(buffer)
+	case "GetBufferParameter":
+		target, pname := in.enum(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetBufferParameter(target, pname)
+	case "IsBuffer":
+		buffer := in.buffer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.IsBuffer(buffer)
+	case "DepthRange":
+		zNear, zFar := in.float(0), in.float(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.DepthRange(zNear, zFar)
+	case "Scissor":
+		x, y, width, height := in.integer(0), in.integer(1), in.integer(2), in.integer(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.Scissor(x, y, width, height)
+	case "Viewport":
+		x, y, width, height := in.integer(0), in.integer(1), in.integer(2), in.integer(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.Viewport(x, y, width, height)
+	case "GetViewport":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		ctx.GetViewport()
+	case "CullFace":
+		mode := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.CullFace(mode)
+	case "FrontFace":
+		mode := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.FrontFace(mode)
+	case "LineWidth":
+		width := in.float(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.LineWidth(width)
+	case "PolygonOffset":
+		factor, units := in.float(0), in.float(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.PolygonOffset(factor, units)
+	case "AttachShader":
+		program, shader := in.program(0), in.shader(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.AttachShader(program, shader)
+	case "BindAttribLocation":
+		program, index, name := in.program(0), in.integer(1), in.str(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.BindAttribLocation(program, index, name)
+	case "BuildProgram":
+		vertexSrc, fragmentSrc := in.str(0), in.str(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		program, _ := ctx.BuildProgram(vertexSrc, fragmentSrc)
+		return p.create(call, program)
+	case "CompileShader":
+		shader := in.shader(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.CompileShader(shader)
+	case "CompileShaderErr":
+		shader := in.shader(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.CompileShaderErr(shader)
+	case "CreateProgram":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		return p.create(call, ctx.CreateProgram())
+	case "CreateShader":
+		typ := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		return p.create(call, ctx.CreateShader(typ))
+	case "DeleteProgram":
+		program := in.program(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.DeleteProgram(program)
+	case "DeleteShader":
+		shader := in.shader(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.DeleteShader(shader)
+	case "DetachShader":
+		program, shader := in.program(0), in.shader(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.DetachShader(program, shader)
+	case "GetAttachedShaders":
+		program := in.program(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		p.bind(call, ctx.GetAttachedShaders(program))
+	case "GetProgramParameteri":
+		program, pname := in.program(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetProgramParameteri(program, pname)
+	case "GetProgramParameterb":
+		program, pname := in.program(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetProgramParameterb(program, pname)
+	case "GetProgramInfoLog":
+		program := in.program(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetProgramInfoLog(program)
+	case "GetShaderiv":
+		shader, pname := in.shader(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetShaderiv(shader, pname)
+	case "GetShaderParameteri":
+		shader, pname := in.shader(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetShaderParameteri(shader, pname)
+	case "GetShaderInfoLog":
+		shader := in.shader(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetShaderInfoLog(shader)
+	case "GetShaderSource":
+		shader := in.shader(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetShaderSource(shader)
+	case "IsProgram":
+		program := in.program(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.IsProgram(program)
+	case "IsShader":
+		shader := in.shader(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.IsShader(shader)
+	case "LinkProgram":
+		program := in.program(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.LinkProgram(program)
+	case "LinkProgramErr":
+		program := in.program(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.LinkProgramErr(program)
+	case "ShaderSource":
+		shader, source := in.shader(0), in.str(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.ShaderSource(shader, source)
+	case "UseProgram":
+		program := in.program(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.UseProgram(program)
+	case "ValidateProgram":
+		program := in.program(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.ValidateProgram(program)
+	case "ActiveTexture":
+		target := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.ActiveTexture(target)
+	case "BindTexture":
+		target, texture := in.enum(0), in.texture(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.BindTexture(target, texture)
+	case "CopyTexImage2D":
+		target, level, internal, x, y, w, h, border := in.enum(0), in.integer(1), in.enum(2), in.integer(3), in.integer(4), in.integer(5), in.integer(6), in.integer(7)
+		if err := in.check(8); err != nil {
+			return err
+		}
+		ctx.CopyTexImage2D(target, level, internal, x, y, w, h, border)
+	case "CopyTexSubImage2D":
+		target, level, xoffset, yoffset, x, y, w, h := in.enum(0), in.integer(1), in.integer(2), in.integer(3), in.integer(4), in.integer(5), in.integer(6), in.integer(7)
+		if err := in.check(8); err != nil {
+			return err
+		}
+		ctx.CopyTexSubImage2D(target, level, xoffset, yoffset, x, y, w, h)
+	case "CreateTexture":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		return p.create(call, ctx.CreateTexture())
+	case "DeleteTexture":
+		texture := in.texture(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.DeleteTexture(texture)
+	case "GenerateMipmap":
+		target := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GenerateMipmap(target)
+	case "IsTexture":
+		texture := in.texture(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.IsTexture(texture)
+	case "TexImage2D":
+		target, level, internalFormat, format, kind, data := in.enum(0), in.integer(1), in.enum(2), in.enum(3), in.enum(4), in.image(5)
+		if err := in.check(6); err != nil {
+			return err
+		}
+		ctx.TexImage2D(target, level, internalFormat, format, kind, data)
+	case "TexImage2DEmpty":
+		target, level, internalFormat, format, kind, width, height := in.enum(0), in.integer(1), in.enum(2), in.enum(3), in.enum(4), in.integer(5), in.integer(6)
+		if err := in.check(7); err != nil {
+			return err
+		}
+		ctx.TexImage2DEmpty(target, level, internalFormat, format, kind, width, height)
+	case "TexParameteri":
+		target, pname, param := in.enum(0), in.enum(1), in.enum(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.TexParameteri(target, pname, param)
+	case "TexSubImage2D":
+		target, level, xoffset, yoffset, width, height, format, kind, data := in.enum(0), in.integer(1), in.integer(2), in.integer(3), in.integer(4), in.integer(5), in.enum(6), in.enum(7), in.pixels(8)
+		if err := in.check(9); err != nil {
+			return err
+		}
+		ctx.TexSubImage2D(target, level, xoffset, yoffset, width, height, format, kind, data)
+	case "Disable":
+		cap := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.Disable(cap)
+	case "Enable":
+		cap := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.Enable(cap)
+	case "Finish":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		ctx.Finish()
+	case "Flush":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		ctx.Flush()
+	case "GetError":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		ctx.GetError()
+	case "IsContextLost":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		ctx.IsContextLost()
+	case "IsEnabled":
+		cap := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.IsEnabled(cap)
+	case "PixelStorei":
+		pname, param := in.enum(0), in.integer(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.PixelStorei(pname, param)
+	case "Capabilities":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		ctx.Capabilities()
+	case "GetParameterBool":
+		pname := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetParameterBool(pname)
+	case "GetParameterBools":
+		pname := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetParameterBools(pname)
+	case "GetParameterFloat":
+		pname := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetParameterFloat(pname)
+	case "GetParameterFloats":
+		pname := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetParameterFloats(pname)
+	case "GetParameterInt":
+		pname := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetParameterInt(pname)
+	case "GetParameterInts":
+		pname := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetParameterInts(pname)
+	case "GetParameterString":
+		pname := in.enum(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.GetParameterString(pname)
+	case "Extensions":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		ctx.Extensions()
+	case "GetSupportedExtensions":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		ctx.GetSupportedExtensions()
+	case "HasExtension":
+		name := in.str(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.HasExtension(name)
+	case "DisableVertexAttribArray":
+		index := in.integer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.DisableVertexAttribArray(index)
+	case "EnableVertexAttribArray":
+		index := in.integer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.EnableVertexAttribArray(index)
+	case "GetActiveAttrib":
+		program, index := in.program(0), in.integer(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetActiveAttrib(program, index)
+	case "GetActiveUniform":
+		program, index := in.program(0), in.integer(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetActiveUniform(program, index)
+	case "GetAttribLocation":
+		program, name := in.program(0), in.str(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetAttribLocation(program, name)
+	case "GetUniformLocation":
+		program, name := in.program(0), in.str(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		p.bind(call, ctx.GetUniformLocation(program, name))
+	case "Uniform1f":
+		location, x := in.location(0), in.float(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform1f(location, x)
+	case "Uniform1i":
+		location, x := in.location(0), in.integer(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform1i(location, x)
+	case "Uniform1iTexture":
+		location, tex := in.location(0), in.texture(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform1iTexture(location, tex)
+	case "Uniform2f":
+		location, x, y := in.location(0), in.float(1), in.float(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.Uniform2f(location, x, y)
+	case "Uniform2i":
+		location, x, y := in.location(0), in.integer(1), in.integer(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.Uniform2i(location, x, y)
+	case "Uniform3f":
+		location, x, y, z := in.location(0), in.float(1), in.float(2), in.float(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.Uniform3f(location, x, y, z)
+	case "Uniform3i":
+		location, x, y, z := in.location(0), in.integer(1), in.integer(2), in.integer(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.Uniform3i(location, x, y, z)
+	case "Uniform4f":
+		location, x, y, z, w := in.location(0), in.float(1), in.float(2), in.float(3), in.float(4)
+		if err := in.check(5); err != nil {
+			return err
+		}
+		ctx.Uniform4f(location, x, y, z, w)
+	case "Uniform4i":
+		location, x, y, z, w := in.location(0), in.integer(1), in.integer(2), in.integer(3), in.integer(4)
+		if err := in.check(5); err != nil {
+			return err
+		}
+		ctx.Uniform4i(location, x, y, z, w)
+	case "Uniform1fv":
+		location, value := in.location(0), in.floats(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform1fv(location, value)
+	case "Uniform1iv":
+		location, value := in.location(0), in.ints(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform1iv(location, value)
+	case "Uniform2fv":
+		location, value := in.location(0), in.floats(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform2fv(location, value)
+	case "Uniform2iv":
+		location, value := in.location(0), in.ints(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform2iv(location, value)
+	case "Uniform3fv":
+		location, value := in.location(0), in.floats(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform3fv(location, value)
+	case "Uniform3iv":
+		location, value := in.location(0), in.ints(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform3iv(location, value)
+	case "Uniform4fv":
+		location, value := in.location(0), in.floats(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform4fv(location, value)
+	case "Uniform4iv":
+		location, value := in.location(0), in.ints(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.Uniform4iv(location, value)
+	case "UniformMatrix2fv":
+		location, transpose, value := in.location(0), in.boolean(1), in.floats(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.UniformMatrix2fv(location, transpose, value)
+	case "UniformMatrix3fv":
+		location, transpose, value := in.location(0), in.boolean(1), in.floats(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.UniformMatrix3fv(location, transpose, value)
+	case "UniformMatrix4fv":
+		location, transpose, value := in.location(0), in.boolean(1), in.floats(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.UniformMatrix4fv(location, transpose, value)
+	case "VertexAttribPointer":
+		index, size, typ, normal, stride, offset := in.integer(0), in.integer(1), in.enum(2), in.boolean(3), in.integer(4), in.integer(5)
+		if err := in.check(6); err != nil {
+			return err
+		}
+		ctx.VertexAttribPointer(index, size, typ, normal, stride, offset)
+	case "VertexAttrib1f":
+		index, x := in.integer(0), in.float(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.VertexAttrib1f(index, x)
+	case "VertexAttrib2f":
+		index, x, y := in.integer(0), in.float(1), in.float(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.VertexAttrib2f(index, x, y)
+	case "VertexAttrib3f":
+		index, x, y, z := in.integer(0), in.float(1), in.float(2), in.float(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.VertexAttrib3f(index, x, y, z)
+	case "VertexAttrib4f":
+		index, x, y, z, w := in.integer(0), in.float(1), in.float(2), in.float(3), in.float(4)
+		if err := in.check(5); err != nil {
+			return err
+		}
+		ctx.VertexAttrib4f(index, x, y, z, w)
+	case "VertexAttrib1fv":
+		index, value := in.integer(0), in.floats(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.VertexAttrib1fv(index, value)
+	case "VertexAttrib2fv":
+		index, value := in.integer(0), in.floats(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.VertexAttrib2fv(index, value)
+	case "VertexAttrib3fv":
+		index, value := in.integer(0), in.floats(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.VertexAttrib3fv(index, value)
+	case "VertexAttrib4fv":
+		index, value := in.integer(0), in.floats(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.VertexAttrib4fv(index, value)
+	case "GetVertexAttribi":
+		index, pname := in.integer(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetVertexAttribi(index, pname)
+	case "GetVertexAttribfv":
+		index, pname := in.integer(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetVertexAttribfv(index, pname)
+	case "BindRenderBuffer":
+		rb := in.renderbuffer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.BindRenderBuffer(rb)
+	case "CreateRenderBuffer":
+		if err := in.check(0); err != nil {
+			return err
+		}
+		return p.create(call, ctx.CreateRenderBuffer())
+	case "DeleteRenderBuffer":
+		rb := in.renderbuffer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.DeleteRenderBuffer(rb)
+	case "GetRenderbufferParameter":
+		target, pname := in.enum(0), in.enum(1)
+		if err := in.check(2); err != nil {
+			return err
+		}
+		ctx.GetRenderbufferParameter(target, pname)
+	case "IsRenderbuffer":
+		rb := in.renderbuffer(0)
+		if err := in.check(1); err != nil {
+			return err
+		}
+		ctx.IsRenderbuffer(rb)
+	case "RenderBufferStorage":
+		internalFormat, width, height := in.enum(0), in.integer(1), in.integer(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.RenderBufferStorage(internalFormat, width, height)
+	case "DrawArrays":
+		mode, first, count := in.enum(0), in.integer(1), in.integer(2)
+		if err := in.check(3); err != nil {
+			return err
+		}
+		ctx.DrawArrays(mode, first, count)
+	case "DrawElements":
+		mode, count, typ, offset := in.enum(0), in.integer(1), in.enum(2), in.integer(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.DrawElements(mode, count, typ, offset)
+	case "ReadPixels":
+		x, y, width, height := in.integer(0), in.integer(1), in.integer(2), in.integer(3)
+		if err := in.check(4); err != nil {
+			return err
+		}
+		ctx.ReadPixels(x, y, width, height)
+	case "ReadPixelsInto":
+		x, y, width, height, pixels := in.integer(0), in.integer(1), in.integer(2), in.integer(3), in.pixelBuffer(4)
+		if err := in.check(5); err != nil {
+			return err
+		}
+		ctx.ReadPixelsInto(x, y, width, height, pixels)
+	default:
+		return fmt.Errorf("unknown method")
+	}
+	return nil
+}
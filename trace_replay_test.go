@@ -0,0 +1,132 @@
+//go:build nogl
+// +build nogl
+
+package gl
+
+import (
+	"bytes"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// recordScene records a frame drawing a triangle with a shader program, and a
+// frame setting an attribute to values JSON can't represent and clearing the
+// screen, to a trace file.
+func recordScene(t *testing.T) []byte {
+	var buf bytes.Buffer
+	w, err := NewTraceWriter(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewContext()
+	tc := NewTraceContext(c, w)
+
+	vs := tc.CreateShader(c.VERTEX_SHADER)
+	tc.ShaderSource(vs, "void main() {}")
+	tc.CompileShader(vs)
+	fs := tc.CreateShader(c.FRAGMENT_SHADER)
+	tc.ShaderSource(fs, "void main() {}")
+	tc.CompileShader(fs)
+	program := tc.CreateProgram()
+	tc.AttachShader(program, vs)
+	tc.AttachShader(program, fs)
+	tc.LinkProgram(program)
+	tc.UseProgram(program)
+	tc.Uniform1f(tc.GetUniformLocation(program, "scale"), 2)
+	tc.BindBuffer(c.ARRAY_BUFFER, tc.CreateBuffer())
+	tc.BufferData(c.ARRAY_BUFFER, []float32{-1, -1, 1, -1, 0, 1}, c.STATIC_DRAW)
+	position := tc.GetAttribLocation(program, "position")
+	tc.EnableVertexAttribArray(position)
+	tc.VertexAttribPointer(position, 2, c.FLOAT, false, 0, 0)
+	tc.DrawArrays(c.TRIANGLES, 0, 3)
+	tc.EndFrame()
+	tc.VertexAttrib4fv(position, []float32{float32(math.NaN()), float32(math.Inf(1)), 0.5, 1})
+	tc.Clear(c.COLOR_BUFFER_BIT)
+	tc.GetError()
+	tc.EndFrame()
+
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestTraceRoundTrip(t *testing.T) {
+	trace := recordScene(t)
+	r, err := NewTraceReader(bytes.NewReader(trace))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var log CallLog
+	c := NewContext()
+	frames, err := ReplayTrace(NewTraceContext(c, &log), r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var methods []string
+	for _, call := range log {
+		methods = append(methods, call.Method)
+	}
+	want := []string{
+		"CreateShader", "ShaderSource", "CompileShader",
+		"CreateShader", "ShaderSource", "CompileShader",
+		"CreateProgram", "AttachShader", "AttachShader", "LinkProgram", "UseProgram",
+		"GetUniformLocation", "Uniform1f",
+		"CreateBuffer", "BindBuffer", "BufferData",
+		"GetAttribLocation", "EnableVertexAttribArray", "VertexAttribPointer", "DrawArrays",
+		"VertexAttrib4fv", "Clear", "GetError",
+	}
+	if !reflect.DeepEqual(methods, want) {
+		t.Errorf("replayed %v, want %v", methods, want)
+	}
+	if len(frames) != 2 {
+		t.Fatalf("replayed %d frames, want 2", len(frames))
+	}
+	if frames[0].Calls != 20 || frames[0].Methods["CreateShader"] != 2 || frames[1].Calls != 3 {
+		t.Errorf("frame stats are %+v", frames)
+	}
+	if err := c.GetError(); err != c.NO_ERROR {
+		t.Errorf("replaying raised %s", c.ErrorName(err))
+	}
+}
+
+func TestWriteTraceJSON(t *testing.T) {
+	r, err := NewTraceReader(bytes.NewReader(recordScene(t)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out strings.Builder
+	if err := WriteTraceJSON(&out, r); err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	for i, want := range map[int]string{
+		0:  `{"method":"CreateShader","args":[35633],"results":[{"handle":1}]}`,
+		1:  `{"method":"ShaderSource","args":[{"handle":1},"void main() {}"]}`,
+		8:  `{"method":"AttachShader","args":[{"handle":3},{"handle":2}]}`,
+		12: `{"method":"Uniform1f","args":[{"handle":4},2]}`,
+		15: `{"method":"BufferData","args":[34962,"AACAvwAAgL8AAIA/AACAvwAAAAAAAIA/",35044]}`,
+		20: `{"method":"EndFrame"}`,
+		21: `{"method":"VertexAttrib4fv","args":[0,["NaN","+Inf",0.5,1]]}`,
+	} {
+		if i >= len(lines) {
+			t.Errorf("line %d is missing", i)
+		} else if lines[i] != want {
+			t.Errorf("line %d is %s, want %s", i, lines[i], want)
+		}
+	}
+}
+
+func TestReplayBufferTooLarge(t *testing.T) {
+	c := NewContext()
+	p := NewReplayer(c)
+	if err := p.Replay(&Call{Method: "BufferData", Args: []interface{}{int(c.ARRAY_BUFFER), maxReplayLength + 1, int(c.STATIC_DRAW)}}); err == nil {
+		t.Error("replaying BufferData of a huge size didn't fail")
+	}
+	if err := p.Replay(&Call{Method: "BindTexture", Args: []interface{}{int(c.TEXTURE_2D), Handle(1)}}); err == nil {
+		t.Error("replaying BindTexture of an unknown handle didn't fail")
+	}
+}
@@ -0,0 +1,106 @@
+//go:build nogl
+// +build nogl
+
+package gl
+
+import (
+	"image"
+	"reflect"
+	"testing"
+)
+
+func TestTraceContextRecord(t *testing.T) {
+	var log CallLog
+	c := NewContext()
+	tc := NewTraceContext(c, &log)
+
+	tex := tc.CreateTexture()
+	tc.BindTexture(c.TEXTURE_2D, tex)
+	tc.TexImage2D(c.TEXTURE_2D, 0, c.RGBA, c.RGBA, c.UNSIGNED_BYTE, image.NewNRGBA(image.Rect(0, 0, 1, 1)))
+	tc.BindTexture(c.TEXTURE_2D, nil)
+	buf := tc.CreateBuffer()
+	tc.BindBuffer(c.ARRAY_BUFFER, buf)
+	tc.BufferData(c.ARRAY_BUFFER, []float32{1}, c.STATIC_DRAW)
+	tc.GetError()
+	tc.EndFrame()
+
+	want := CallLog{
+		{Method: "CreateTexture", Results: []interface{}{Handle(1)}},
+		{Method: "BindTexture", Args: []interface{}{int(c.TEXTURE_2D), Handle(1)}},
+		{Method: "TexImage2D", Args: []interface{}{int(c.TEXTURE_2D), 0, int(c.RGBA), int(c.RGBA), int(c.UNSIGNED_BYTE), &TraceImage{Width: 1, Height: 1, Pix: []byte{0, 0, 0, 0}}}, Results: []interface{}{nil}},
+		{Method: "BindTexture", Args: []interface{}{int(c.TEXTURE_2D), Handle(0)}},
+		{Method: "CreateBuffer", Results: []interface{}{Handle(2)}},
+		{Method: "BindBuffer", Args: []interface{}{int(c.ARRAY_BUFFER), Handle(2)}},
+		{Method: "BufferData", Args: []interface{}{int(c.ARRAY_BUFFER), []byte{0, 0, 0x80, 0x3f}, int(c.STATIC_DRAW)}},
+		{Method: "GetError", Results: []interface{}{int(c.NO_ERROR)}},
+		{Method: "EndFrame"},
+	}
+	if len(log) != len(want) {
+		t.Fatalf("recorded %d calls, want %d", len(log), len(want))
+	}
+	for i := range want {
+		if !reflect.DeepEqual(log[i], want[i]) {
+			t.Errorf("call %d is %+v, want %+v", i, log[i], want[i])
+		}
+	}
+}
+
+// traceResult returns the first result of the i-th call to method in log.
+func traceResult(t *testing.T, log CallLog, method string, i int) interface{} {
+	t.Helper()
+	for _, call := range log {
+		if call.Method != method {
+			continue
+		}
+		if i == 0 {
+			return call.Results[0]
+		}
+		i--
+	}
+	t.Fatalf("%s wasn't called", method)
+	return nil
+}
+
+func TestTraceContextHandles(t *testing.T) {
+	var log CallLog
+	c := NewContext()
+	tc := NewTraceContext(c, &log)
+
+	vs, fs := tc.CreateShader(c.VERTEX_SHADER), tc.CreateShader(c.FRAGMENT_SHADER)
+	tc.CompileShader(vs)
+	tc.CompileShader(fs)
+	program := tc.CreateProgram()
+	tc.AttachShader(program, vs)
+	tc.AttachShader(program, fs)
+	tc.LinkProgram(program)
+	tc.UseProgram(program)
+
+	// Lookups return new pointers to the same objects.
+	tc.GetUniformLocation(program, "a")
+	tc.Uniform1f(tc.GetUniformLocation(program, "a"), 1)
+	tc.GetAttachedShaders(program)
+	tc.GetAttachedShaders(&Program{program.uint32})
+
+	first, second := traceResult(t, log, "GetUniformLocation", 0), traceResult(t, log, "GetUniformLocation", 1)
+	if first != second {
+		t.Errorf("GetUniformLocation returned handles %v and %v for the same uniform", first, second)
+	}
+	if set := log[len(log)-3].Args[0]; set != first {
+		t.Errorf("Uniform1f was passed handle %v, want %v", set, first)
+	}
+	shaders := []Handle{traceResult(t, log, "CreateShader", 0).(Handle), traceResult(t, log, "CreateShader", 1).(Handle)}
+	for i := 0; i < 2; i++ {
+		if got := traceResult(t, log, "GetAttachedShaders", i); !reflect.DeepEqual(got, shaders) {
+			t.Errorf("GetAttachedShaders returned %v, want %v", got, shaders)
+		}
+	}
+
+	// A backend may give a new object the name of a deleted one.
+	tex := tc.CreateTexture()
+	tc.DeleteTexture(tex)
+	c.Mock().ReturnOnce("CreateTexture", &Texture{tex.uint32})
+	tc.CreateTexture()
+	if first, second := traceResult(t, log, "CreateTexture", 0), traceResult(t, log, "CreateTexture", 1); first == second {
+		t.Errorf("textures created with the same name got the same handle %v", first)
+	}
+}